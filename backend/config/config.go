@@ -23,6 +23,20 @@ type Config struct {
 
 	// Frontend
 	FrontendURL string
+
+	// Affiliate click tracking
+	ClickHashSecret string
+
+	// Affiliate IDs injected into store links
+	AmazonAssociateTag string
+	RakutenAffiliateID string
+
+	// SMTP (email notifications; disabled when SMTPHost is empty)
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
 }
 
 // Load - 設定を読み込む
@@ -42,6 +56,17 @@ func Load() *Config {
 
 		JWTSecret:   getEnv("JWT_SECRET", "default-secret-change-me"),
 		FrontendURL: getEnv("FRONTEND_URL", "http://localhost:5173"),
+
+		ClickHashSecret: getEnv("CLICK_HASH_SECRET", "default-click-secret-change-me"),
+
+		AmazonAssociateTag: os.Getenv("AMAZON_ASSOCIATE_TAG"),
+		RakutenAffiliateID: os.Getenv("RAKUTEN_AFFILIATE_ID"),
+
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     getEnv("SMTP_PORT", "587"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:     getEnv("SMTP_FROM", "noreply@veganbite.local"),
 	}
 }
 
@@ -1,6 +1,11 @@
 package admin
 
-import "time"
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
 
 // ロール名の定数
 const (
@@ -9,12 +14,16 @@ const (
 	RoleModerator  = "moderator"
 )
 
+var ErrInvalidEmail = errors.New("a valid email is required")
+
 // Role - 管理者ロール
 type Role struct {
 	ID          int64     `json:"id" gorm:"primaryKey;autoIncrement"`
 	Name        string    `json:"name" gorm:"uniqueIndex"`
 	NameJa      string    `json:"nameJa" gorm:"column:name_ja"`
 	Description string    `json:"description"`
+	IsBuiltIn   bool      `json:"isBuiltIn"`
+	Permissions []string  `json:"permissions" gorm:"-"` // role_permissions から読み込む
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
@@ -24,17 +33,67 @@ func (Role) TableName() string {
 	return "admin_roles"
 }
 
+// Allows - 権限を持つか（スーパー管理者は常に全権限）
+func (r *Role) Allows(permission string) bool {
+	if r.Name == RoleSuperAdmin {
+		return true
+	}
+	for _, p := range r.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
 // Admin - 管理者
 type Admin struct {
-	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
-	GoogleID  string    `json:"googleId" gorm:"uniqueIndex"`
-	Email     string    `json:"email" gorm:"uniqueIndex"`
-	Name      string    `json:"name"`
-	Avatar    string    `json:"avatar"`
-	RoleID    int64     `json:"roleId" gorm:"column:role_id"`
-	Role      *Role     `json:"role" gorm:"foreignKey:RoleID"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID               int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	GoogleID         string     `json:"googleId" gorm:"uniqueIndex"`
+	Email            string     `json:"email" gorm:"uniqueIndex"`
+	Name             string     `json:"name"`
+	Avatar           string     `json:"avatar"`
+	RoleID           int64      `json:"roleId" gorm:"column:role_id"`
+	Role             *Role      `json:"role" gorm:"foreignKey:RoleID"`
+	IsActive         bool       `json:"isActive" gorm:"default:true"` // false なら無効化済み（ログイン不可）
+	DeactivatedAt    *time.Time `json:"deactivatedAt"`
+	LastLoginAt      *time.Time `json:"lastLoginAt"`
+	InvitedByAdminID *int64     `json:"invitedByAdminId"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+// NewInvitation - メールアドレスで管理者を招待（初回の Google ログイン時にメールアドレスで紐付く）
+func NewInvitation(email, name string, role *Role, invitedBy int64) (*Admin, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || addr.Address != strings.TrimSpace(email) {
+		return nil, ErrInvalidEmail
+	}
+	email = strings.ToLower(addr.Address)
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = email[:strings.Index(email, "@")]
+	}
+	return &Admin{
+		Email:            email,
+		Name:             name,
+		RoleID:           role.ID,
+		Role:             role,
+		IsActive:         true,
+		InvitedByAdminID: &invitedBy,
+	}, nil
+}
+
+// Deactivate - 管理者を無効化
+func (a *Admin) Deactivate(now time.Time) {
+	a.IsActive = false
+	a.DeactivatedAt = &now
+}
+
+// Reactivate - 無効化を取り消す
+func (a *Admin) Reactivate() {
+	a.IsActive = true
+	a.DeactivatedAt = nil
 }
 
 // IsSuperAdmin - スーパー管理者かどうか
@@ -42,18 +101,7 @@ func (a *Admin) IsSuperAdmin() bool {
 	return a.Role != nil && a.Role.Name == RoleSuperAdmin
 }
 
-// CanManageProducts - 商品管理権限があるか
-func (a *Admin) CanManageProducts() bool {
-	if a.Role == nil {
-		return false
-	}
-	return a.Role.Name == RoleSuperAdmin || a.Role.Name == RoleAdmin
-}
-
-// CanManageReviews - レビュー管理権限があるか
-func (a *Admin) CanManageReviews() bool {
-	if a.Role == nil {
-		return false
-	}
-	return a.Role.Name == RoleSuperAdmin || a.Role.Name == RoleAdmin || a.Role.Name == RoleModerator
+// Can - 権限を持つか（無効化済みの管理者は常に false）
+func (a *Admin) Can(permission string) bool {
+	return a.IsActive && a.Role != nil && a.Role.Allows(permission)
 }
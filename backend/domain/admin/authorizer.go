@@ -0,0 +1,23 @@
+package admin
+
+// Authorizer - 管理者の権限チェック（ロールは JWT ではなく DB の現在値を使う）
+type Authorizer struct {
+	adminRepo AdminRepository
+}
+
+// NewAuthorizer - Authorizer の生成
+func NewAuthorizer(adminRepo AdminRepository) *Authorizer {
+	return &Authorizer{adminRepo: adminRepo}
+}
+
+// Authorize - 権限がなければ ErrPermissionDenied（無効化済み・存在しない管理者を含む）
+func (a *Authorizer) Authorize(adminID int64, permission string) error {
+	ad, err := a.adminRepo.FindByID(adminID)
+	if err != nil {
+		return ErrPermissionDenied
+	}
+	if !ad.Can(permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// 権限（"<対象>:<操作>"）
+const (
+	PermProductWrite     = "product:write"
+	PermCategoryWrite    = "category:write"
+	PermStoreWrite       = "store:write"
+	PermOfferWrite       = "offer:write"
+	PermReviewModerate   = "review:moderate"
+	PermReviewReply      = "review:reply"
+	PermQuestionAnswer   = "question:answer"
+	PermQuestionModerate = "question:moderate"
+	PermCustomerRead     = "customer:read"
+	PermCustomerBan      = "customer:ban"
+	PermWebhookManage    = "webhook:manage"
+	PermReportRead       = "report:read"
+	PermAuditRead        = "audit:read"
+	// PermAdminManage - 管理者・ロールの管理。権限昇格を防ぐためスーパー管理者専用でロールには付与できない
+	PermAdminManage = "admin:manage"
+)
+
+var (
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrUnknownPermission  = errors.New("unknown permission")
+	ErrRoleNameInvalid    = errors.New("role name must be 2-50 lowercase letters, digits or underscores")
+	ErrRoleNameJaRequired = errors.New("nameJa is required")
+	ErrRoleBuiltIn        = errors.New("built-in roles cannot be renamed or deleted")
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// Permissions - ロールに付与できる権限の一覧
+func Permissions() []string {
+	return []string{
+		PermProductWrite,
+		PermCategoryWrite,
+		PermStoreWrite,
+		PermOfferWrite,
+		PermReviewModerate,
+		PermReviewReply,
+		PermQuestionAnswer,
+		PermQuestionModerate,
+		PermCustomerRead,
+		PermCustomerBan,
+		PermWebhookManage,
+		PermReportRead,
+		PermAuditRead,
+	}
+}
+
+// NewPermissions - 権限一覧を検証（重複を除いて並べ替える）
+func NewPermissions(permissions []string) ([]string, error) {
+	known := make(map[string]bool)
+	for _, p := range Permissions() {
+		known[p] = true
+	}
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		p = strings.TrimSpace(p)
+		if !known[p] {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownPermission, p)
+		}
+		if !seen[p] {
+			seen[p] = true
+			result = append(result, p)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// NewRole - カスタムロールを作成
+func NewRole(name, nameJa, description string, permissions []string) (*Role, error) {
+	r := &Role{}
+	if err := r.Rename(name, nameJa, description); err != nil {
+		return nil, err
+	}
+	if err := r.Grant(permissions); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Rename - ロール名・説明を変更（組み込みロールの name は変更不可）
+func (r *Role) Rename(name, nameJa, description string) error {
+	name = strings.TrimSpace(name)
+	if r.IsBuiltIn && name != r.Name {
+		return ErrRoleBuiltIn
+	}
+	if !roleNamePattern.MatchString(name) {
+		return ErrRoleNameInvalid
+	}
+	nameJa = strings.TrimSpace(nameJa)
+	if nameJa == "" {
+		return ErrRoleNameJaRequired
+	}
+	r.Name = name
+	r.NameJa = nameJa
+	r.Description = strings.TrimSpace(description)
+	return nil
+}
+
+// Grant - 権限を置き換える（スーパー管理者は常に全権限のため変更不可）
+func (r *Role) Grant(permissions []string) error {
+	if r.Name == RoleSuperAdmin {
+		return ErrRoleBuiltIn
+	}
+	perms, err := NewPermissions(permissions)
+	if err != nil {
+		return err
+	}
+	r.Permissions = perms
+	return nil
+}
@@ -1,15 +1,36 @@
 package admin
 
+import "errors"
+
+var (
+	// ErrAdminNotFound - 該当する管理者がない
+	ErrAdminNotFound = errors.New("admin not found")
+	// ErrRoleNotFound - 該当するロールがない
+	ErrRoleNotFound = errors.New("role not found")
+)
+
 // AdminRepository - 管理者リポジトリインターフェース
 type AdminRepository interface {
+	FindAll() ([]Admin, error)
+	// FindByID / FindByEmail / FindByGoogleIDOrEmail - 該当する管理者がなければ ErrAdminNotFound
 	FindByID(id int64) (*Admin, error)
+	FindByEmail(email string) (*Admin, error)
 	FindByGoogleIDOrEmail(googleID, email string) (*Admin, error)
+	// LockActiveByRole - 指定ロールの有効な管理者を行ロックして数える（トランザクション内で使い、同時の降格・無効化を直列化する）
+	LockActiveByRole(roleID int64) (int64, error)
+	// CountByRole - 指定ロールの管理者数（無効化済みを含む）
+	CountByRole(roleID int64) (int64, error)
+	Create(admin *Admin) error
 	Update(admin *Admin) error
 }
 
-// RoleRepository - 管理者ロールリポジトリインターフェース
+// RoleRepository - 管理者ロールリポジトリインターフェース（Permissions も読み書きする）
 type RoleRepository interface {
 	FindAll() ([]Role, error)
+	// FindByID / FindByName - 該当するロールがなければ ErrRoleNotFound
 	FindByID(id int64) (*Role, error)
 	FindByName(name string) (*Role, error)
+	Create(role *Role) error
+	Update(role *Role) error
+	Delete(id int64) error
 }
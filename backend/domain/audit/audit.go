@@ -0,0 +1,207 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// 操作名（"<対象>.<操作>"）
+const (
+	ActionProductCreate     = "product.create"
+	ActionProductUpdate     = "product.update"
+	ActionProductDelete     = "product.delete"
+	ActionCategoryCreate    = "category.create"
+	ActionCategoryUpdate    = "category.update"
+	ActionCategoryDelete    = "category.delete"
+	ActionCustomerBan       = "customer.ban"
+	ActionCustomerSuspend   = "customer.suspend"
+	ActionCustomerUnban     = "customer.unban"
+	ActionReviewDelete      = "review.delete"
+	ActionReplyCreate       = "reply.create"
+	ActionReplyDelete       = "reply.delete"
+	ActionQuestionDelete    = "question.delete"
+	ActionAnswerCreate      = "answer.create"
+	ActionAnswerDelete      = "answer.delete"
+	ActionSubmissionApprove = "submission.approve"
+	ActionSubmissionReject  = "submission.reject"
+	ActionSuggestionApprove = "suggestion.approve"
+	ActionSuggestionReject  = "suggestion.reject"
+	ActionOfferUpsert       = "offer.upsert"
+	ActionStoreCreate       = "store.create"
+	ActionStoreUpdate       = "store.update"
+	ActionStoreDelete       = "store.delete"
+	ActionWebhookCreate     = "webhook.create"
+	ActionWebhookUpdate     = "webhook.update"
+	ActionWebhookDelete     = "webhook.delete"
+	ActionWebhookReplay     = "webhook.replay"
+	ActionAdminInvite       = "admin.invite"
+	ActionAdminRoleChange   = "admin.role_change"
+	ActionAdminDeactivate   = "admin.deactivate"
+	ActionAdminReactivate   = "admin.reactivate"
+	ActionRoleCreate        = "role.create"
+	ActionRoleUpdate        = "role.update"
+	ActionRoleDelete        = "role.delete"
+)
+
+// 操作対象の種類
+const (
+	TargetProduct         = "product"
+	TargetCategory        = "category"
+	TargetCustomer        = "customer"
+	TargetReview          = "review"
+	TargetReply           = "review_reply"
+	TargetQuestion        = "product_question"
+	TargetAnswer          = "product_answer"
+	TargetSubmission      = "product_submission"
+	TargetSuggestion      = "product_edit_suggestion"
+	TargetOffer           = "offer"
+	TargetStore           = "store"
+	TargetWebhook         = "webhook"
+	TargetWebhookDelivery = "webhook_delivery"
+	TargetAdmin           = "admin"
+	TargetRole            = "role"
+)
+
+// ignoredFields - 差分に含めないフィールド（保存のたびに変わるもの）
+var ignoredFields = map[string]bool{"updatedAt": true}
+
+// Actor - 操作した管理者
+type Actor struct {
+	AdminID int64
+	Role    string
+	IP      string
+}
+
+// Entry - 管理者操作の監査ログ（追記のみ）
+type Entry struct {
+	ID         int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	AdminID    int64     `json:"adminId"`
+	AdminRole  string    `json:"adminRole"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"targetType"`
+	TargetID   string    `json:"targetId"`
+	Before     *string   `json:"before" gorm:"type:jsonb"`
+	After      *string   `json:"after" gorm:"type:jsonb"`
+	Changes    *string   `json:"changes" gorm:"type:jsonb"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (Entry) TableName() string {
+	return "admin_audit_logs"
+}
+
+// Change - フィールドの変更前後
+type Change struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// NewEntry - 監査ログを作成（before / after は操作前後のスナップショット、作成・削除では片方が nil）
+func NewEntry(actor Actor, action, targetType string, targetID int64, before, after any) (*Entry, error) {
+	e := &Entry{
+		AdminID:    actor.AdminID,
+		AdminRole:  actor.Role,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   strconv.FormatInt(targetID, 10),
+		IPAddress:  actor.IP,
+	}
+
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return nil, err
+	}
+	e.Before = beforeJSON
+	e.After = afterJSON
+
+	changes, err := diff(beforeJSON, afterJSON)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		raw, err := json.Marshal(changes)
+		if err != nil {
+			return nil, err
+		}
+		s := string(raw)
+		e.Changes = &s
+	}
+	return e, nil
+}
+
+func snapshot(v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if string(raw) == "null" {
+		return nil, nil
+	}
+	s := string(raw)
+	return &s, nil
+}
+
+// diff - オブジェクトのトップレベルのフィールドごとの差分（オブジェクトでなければ差分なし）
+func diff(before, after *string) (map[string]Change, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]Change{}
+	for name, value := range afterFields {
+		if ignoredFields[name] {
+			continue
+		}
+		if prev, ok := beforeFields[name]; !ok || !bytes.Equal(prev, value) {
+			changes[name] = Change{Before: orNull(prev), After: value}
+		}
+	}
+	for name, prev := range beforeFields {
+		if _, ok := afterFields[name]; !ok && !ignoredFields[name] {
+			changes[name] = Change{Before: prev, After: orNull(nil)}
+		}
+	}
+	return changes, nil
+}
+
+func fields(raw *string) (map[string]json.RawMessage, error) {
+	result := map[string]json.RawMessage{}
+	if raw == nil {
+		return result, nil
+	}
+	if err := json.Unmarshal([]byte(*raw), &result); err != nil {
+		return map[string]json.RawMessage{}, nil
+	}
+	// 比較できるよう空白を詰める
+	for name, value := range result {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, value); err != nil {
+			return nil, err
+		}
+		result[name] = buf.Bytes()
+	}
+	return result, nil
+}
+
+func orNull(v json.RawMessage) json.RawMessage {
+	if v == nil {
+		return json.RawMessage("null")
+	}
+	return v
+}
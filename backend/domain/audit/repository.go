@@ -0,0 +1,21 @@
+package audit
+
+import "time"
+
+// Filter - 監査ログの絞り込み条件（ゼロ値は条件なし）
+type Filter struct {
+	AdminID    int64
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+}
+
+// AuditLogRepository - 監査ログリポジトリインターフェース（更新・削除は持たない）
+type AuditLogRepository interface {
+	Create(e *Entry) error
+	// Find - 条件に合う監査ログ（新しい順）
+	Find(filter Filter) ([]Entry, error)
+}
@@ -0,0 +1,77 @@
+package click
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+	"unicode/utf8"
+)
+
+// StoreAffiliate - 商品の汎用アフィリエイトURL（AffiliateURL）へのクリックを表すストアキー
+const StoreAffiliate = "affiliate"
+
+const (
+	// ReferrerMaxLength - 保存するリファラーの最大文字数
+	ReferrerMaxLength = 500
+	// visitorIDLength - 匿名化した訪問者IDの長さ（16進数）
+	visitorIDLength = 32
+)
+
+// Click - アフィリエイトリンクのクリックログ
+type Click struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	ProductID int64     `json:"productId"`
+	Store     string    `json:"store"`
+	VisitorID string    `json:"visitorId"`
+	Referrer  *string   `json:"referrer"`
+	ClickedAt time.Time `json:"clickedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Click) TableName() string {
+	return "affiliate_clicks"
+}
+
+// DailyCount - 商品・ストア・日ごとのクリック集計
+type DailyCount struct {
+	Date           string `json:"date"`
+	ProductID      int64  `json:"productId"`
+	ProductName    string `json:"productName"`
+	Store          string `json:"store"`
+	Clicks         int64  `json:"clicks"`
+	UniqueVisitors int64  `json:"uniqueVisitors"`
+}
+
+// NewVisitorID - IPアドレスとUser-Agentから匿名化した訪問者IDを生成
+// 日付をキーに含めるため、同じ訪問者でも日をまたぐと別IDになり長期追跡はできない
+func NewVisitorID(secret, ipAddress, userAgent string, at time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(at.UTC().Format("2006-01-02")))
+	mac.Write([]byte{0})
+	mac.Write([]byte(ipAddress))
+	mac.Write([]byte{0})
+	mac.Write([]byte(userAgent))
+	return hex.EncodeToString(mac.Sum(nil))[:visitorIDLength]
+}
+
+// NormalizeReferrer - リファラーからクエリ・フラグメントを除いて保存用に整形（不正・空ならnil）
+func NormalizeReferrer(value string) *string {
+	if value == "" {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.User = nil
+	normalized := u.String()
+	// VARCHAR(500) は文字数で数えるため文字の境界で切り詰める（バイト位置で切ると多バイト文字が壊れる）
+	if utf8.RuneCountInString(normalized) > ReferrerMaxLength {
+		normalized = string([]rune(normalized)[:ReferrerMaxLength])
+	}
+	return &normalized
+}
@@ -0,0 +1,10 @@
+package click
+
+import "time"
+
+// ClickRepository - クリックログリポジトリインターフェース
+type ClickRepository interface {
+	Create(click *Click) error
+	// CountDaily - 期間内のクリック数を商品・ストア・日（UTC）ごとに集計（productID 指定時はその商品のみ）
+	CountDaily(from, to time.Time, productID *int64) ([]DailyCount, error)
+}
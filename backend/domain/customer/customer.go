@@ -11,17 +11,26 @@ const (
 
 // Customer - 一般カスタマー
 type Customer struct {
-	ID             int64      `json:"id" gorm:"primaryKey;autoIncrement"`
-	GoogleID       string     `json:"googleId" gorm:"uniqueIndex"`
-	Email          string     `json:"email" gorm:"uniqueIndex"`
-	Name           string     `json:"name"`
-	Avatar         string     `json:"avatar"`
-	MemberSince    time.Time  `json:"memberSince" gorm:"type:date;default:CURRENT_DATE"`
-	Status         int        `json:"status" gorm:"default:0"`
-	StatusReason   *string    `json:"statusReason"`
-	SuspendedUntil *time.Time `json:"suspendedUntil"`
-	CreatedAt      time.Time  `json:"createdAt"`
-	UpdatedAt      time.Time  `json:"updatedAt"`
+	ID                  int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	GoogleID            string     `json:"googleId" gorm:"uniqueIndex"`
+	Email               string     `json:"email" gorm:"uniqueIndex"`
+	Name                string     `json:"name"`
+	Avatar              string     `json:"avatar"`
+	NameCustomized      bool       `json:"-"` // 本人が編集済みなら Google ログイン時に上書きしない
+	AvatarCustomized    bool       `json:"-"`
+	Bio                 string     `json:"bio"`
+	PreferredLanguage   string     `json:"preferredLanguage" gorm:"default:ja"`
+	DietaryPreference   string     `json:"dietaryPreference"`
+	ProfileVisibility   string     `json:"profileVisibility" gorm:"default:public"`
+	MemberSince         time.Time  `json:"memberSince" gorm:"type:date;default:CURRENT_DATE"`
+	Status              int        `json:"status" gorm:"default:0"`
+	StatusReason        *string    `json:"statusReason"`
+	SuspendedUntil      *time.Time `json:"suspendedUntil"`
+	DeletionRequestedAt *time.Time `json:"deletionRequestedAt"`
+	DeletionScheduledAt *time.Time `json:"deletionScheduledAt"` // 退会申請中の匿名化予定日時
+	AnonymizedAt        *time.Time `json:"-"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
 }
 
 // TableName - GORMテーブル名
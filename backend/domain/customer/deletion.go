@@ -0,0 +1,62 @@
+package customer
+
+import (
+	"errors"
+	"time"
+)
+
+// DeletionGracePeriod - 退会申請から匿名化までの猶予期間（この間は取り消せる）
+const DeletionGracePeriod = 30 * 24 * time.Hour
+
+// DeletedName - 匿名化後の表示名
+const DeletedName = "退会したユーザー"
+
+var (
+	ErrDeletionAlreadyRequested = errors.New("account deletion has already been requested")
+	ErrDeletionNotRequested     = errors.New("account deletion has not been requested")
+)
+
+// RequestDeletion - 退会を申請（猶予期間後に匿名化される）
+func (c *Customer) RequestDeletion(now time.Time) error {
+	if c.DeletionScheduledAt != nil {
+		return ErrDeletionAlreadyRequested
+	}
+	scheduled := now.Add(DeletionGracePeriod)
+	c.DeletionRequestedAt = &now
+	c.DeletionScheduledAt = &scheduled
+	return nil
+}
+
+// CancelDeletion - 猶予期間中の退会申請を取り消す
+func (c *Customer) CancelDeletion() error {
+	if c.DeletionScheduledAt == nil {
+		return ErrDeletionNotRequested
+	}
+	c.DeletionRequestedAt = nil
+	c.DeletionScheduledAt = nil
+	return nil
+}
+
+// IsDeletionDue - 猶予期間が過ぎて匿名化すべきか
+func (c *Customer) IsDeletionDue(now time.Time) bool {
+	return !c.IsAnonymized() && c.DeletionScheduledAt != nil && !now.Before(*c.DeletionScheduledAt)
+}
+
+// IsAnonymized - 退会済み（匿名化済み）か
+func (c *Customer) IsAnonymized() bool {
+	return c.AnonymizedAt != nil
+}
+
+// Anonymize - 個人を特定できる項目を消去（行はレビューの評価を残すために残す）
+func (c *Customer) Anonymize(now time.Time) {
+	c.GoogleID = ""
+	c.Email = ""
+	c.Name = DeletedName
+	c.Avatar = ""
+	c.NameCustomized = false
+	c.AvatarCustomized = false
+	c.Bio = ""
+	c.DietaryPreference = ""
+	c.DeletionScheduledAt = nil
+	c.AnonymizedAt = &now
+}
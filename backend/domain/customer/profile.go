@@ -0,0 +1,261 @@
+package customer
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	DisplayNameMaxLength = 50
+	BioMaxLength         = 500
+	AvatarURLMaxLength   = 2048
+)
+
+// 表示言語
+const (
+	LanguageJa = "ja"
+	LanguageEn = "en"
+)
+
+// 食生活の志向
+const (
+	DietVegan       = "vegan"
+	DietVegetarian  = "vegetarian"
+	DietFlexitarian = "flexitarian"
+)
+
+// プロフィールの公開範囲
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+var (
+	ErrDisplayNameEmpty       = errors.New("display name is required")
+	ErrDisplayNameTooLong     = fmt.Errorf("display name must be at most %d characters", DisplayNameMaxLength)
+	ErrDisplayNameInvalid     = errors.New("display name must not contain control characters")
+	ErrBioTooLong             = fmt.Errorf("bio must be at most %d characters", BioMaxLength)
+	ErrAvatarURLInvalid       = errors.New("avatar must be an http(s) URL")
+	ErrLanguageInvalid        = errors.New("preferred language must be ja or en")
+	ErrDietaryPreferenceValue = errors.New("dietary preference must be vegan, vegetarian or flexitarian")
+	ErrVisibilityInvalid      = errors.New("profile visibility must be public or private")
+)
+
+// DisplayName - 表示名のValue Object
+type DisplayName string
+
+// NewDisplayName - DisplayName を生成（バリデーション付き）
+func NewDisplayName(value string) (DisplayName, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrDisplayNameEmpty
+	}
+	if utf8.RuneCountInString(trimmed) > DisplayNameMaxLength {
+		return "", ErrDisplayNameTooLong
+	}
+	for _, r := range trimmed {
+		if unicode.IsControl(r) {
+			return "", ErrDisplayNameInvalid
+		}
+	}
+	return DisplayName(trimmed), nil
+}
+
+// Bio - 自己紹介のValue Object（空文字可）
+type Bio string
+
+// NewBio - Bio を生成（バリデーション付き）
+func NewBio(value string) (Bio, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > BioMaxLength {
+		return "", ErrBioTooLong
+	}
+	return Bio(trimmed), nil
+}
+
+// AvatarURL - アバター画像URLのValue Object（空文字は Google のアバターに戻す。次回ログイン時に反映）
+type AvatarURL string
+
+// NewAvatarURL - AvatarURL を生成（バリデーション付き）
+func NewAvatarURL(value string) (AvatarURL, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", nil
+	}
+	u, err := url.Parse(trimmed)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(trimmed) > AvatarURLMaxLength {
+		return "", ErrAvatarURLInvalid
+	}
+	return AvatarURL(trimmed), nil
+}
+
+// Language - 表示言語のValue Object
+type Language string
+
+// NewLanguage - Language を生成（バリデーション付き）
+func NewLanguage(value string) (Language, error) {
+	switch lang := strings.ToLower(strings.TrimSpace(value)); lang {
+	case LanguageJa, LanguageEn:
+		return Language(lang), nil
+	default:
+		return "", ErrLanguageInvalid
+	}
+}
+
+// DietaryPreference - 食生活の志向のValue Object（空文字は未設定）
+type DietaryPreference string
+
+// NewDietaryPreference - DietaryPreference を生成（バリデーション付き）
+func NewDietaryPreference(value string) (DietaryPreference, error) {
+	switch pref := strings.ToLower(strings.TrimSpace(value)); pref {
+	case "", DietVegan, DietVegetarian, DietFlexitarian:
+		return DietaryPreference(pref), nil
+	default:
+		return "", ErrDietaryPreferenceValue
+	}
+}
+
+// Visibility - プロフィール公開範囲のValue Object
+type Visibility string
+
+// NewVisibility - Visibility を生成（バリデーション付き）
+func NewVisibility(value string) (Visibility, error) {
+	switch v := strings.ToLower(strings.TrimSpace(value)); v {
+	case VisibilityPublic, VisibilityPrivate:
+		return Visibility(v), nil
+	default:
+		return "", ErrVisibilityInvalid
+	}
+}
+
+// ProfileChanges - プロフィールの部分更新（nil の項目は変更しない）
+type ProfileChanges struct {
+	Name              *string
+	Avatar            *string
+	Bio               *string
+	PreferredLanguage *string
+	DietaryPreference *string
+	ProfileVisibility *string
+}
+
+// ApplyProfile - プロフィールを検証して反映（名前・アバターを編集すると Google ログイン時に上書きされない）
+func (c *Customer) ApplyProfile(changes ProfileChanges) error {
+	updated := *c
+	if changes.Name != nil {
+		name, err := NewDisplayName(*changes.Name)
+		if err != nil {
+			return err
+		}
+		updated.Name = string(name)
+		updated.NameCustomized = true
+	}
+	if changes.Avatar != nil {
+		avatar, err := NewAvatarURL(*changes.Avatar)
+		if err != nil {
+			return err
+		}
+		updated.AvatarCustomized = avatar != ""
+		if avatar != "" {
+			updated.Avatar = string(avatar)
+		}
+	}
+	if changes.Bio != nil {
+		bio, err := NewBio(*changes.Bio)
+		if err != nil {
+			return err
+		}
+		updated.Bio = string(bio)
+	}
+	if changes.PreferredLanguage != nil {
+		lang, err := NewLanguage(*changes.PreferredLanguage)
+		if err != nil {
+			return err
+		}
+		updated.PreferredLanguage = string(lang)
+	}
+	if changes.DietaryPreference != nil {
+		pref, err := NewDietaryPreference(*changes.DietaryPreference)
+		if err != nil {
+			return err
+		}
+		updated.DietaryPreference = string(pref)
+	}
+	if changes.ProfileVisibility != nil {
+		visibility, err := NewVisibility(*changes.ProfileVisibility)
+		if err != nil {
+			return err
+		}
+		updated.ProfileVisibility = string(visibility)
+	}
+	*c = updated
+	return nil
+}
+
+// SyncGoogleProfile - Google ログイン時に名前・アバターを同期（編集済みの項目は上書きしない）
+func (c *Customer) SyncGoogleProfile(name, avatar string) {
+	if !c.NameCustomized {
+		c.Name = name
+	}
+	if !c.AvatarCustomized {
+		c.Avatar = avatar
+	}
+}
+
+// PublicProfile - 公開プロフィール（メールアドレス・Google ID などの個人情報は含めない）
+type PublicProfile struct {
+	ID             int64     `json:"id"`
+	Name           string    `json:"name"`
+	Avatar         string    `json:"avatar"`
+	MemberSince    time.Time `json:"memberSince"`
+	ReviewCount    int64     `json:"reviewCount"`
+	FollowerCount  int64     `json:"followerCount"`
+	FollowingCount int64     `json:"followingCount"`
+	IsFollowing    bool      `json:"isFollowing"` // 閲覧者がフォロー中か（未ログインなら false）
+}
+
+// ProfileStats - 公開プロフィールに表示する集計値
+type ProfileStats struct {
+	ReviewCount    int64
+	FollowerCount  int64
+	FollowingCount int64
+	IsFollowing    bool
+}
+
+// Reviewer - レビューなど公開データに表示する投稿者
+type Reviewer struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Avatar string `json:"avatar"`
+}
+
+// IsProfileVisibleTo - viewerID（未ログイン・管理者は 0）に公開プロフィールを見せてよいか（本人には常に見える）
+func (c *Customer) IsProfileVisibleTo(viewerID int64) bool {
+	if c.IsAnonymized() {
+		return false
+	}
+	return c.ProfileVisibility != VisibilityPrivate || c.ID == viewerID
+}
+
+// PublicProfile - 公開プロフィールへの射影
+func (c *Customer) PublicProfile(stats ProfileStats) *PublicProfile {
+	return &PublicProfile{
+		ID:             c.ID,
+		Name:           c.Name,
+		Avatar:         c.Avatar,
+		MemberSince:    c.MemberSince,
+		ReviewCount:    stats.ReviewCount,
+		FollowerCount:  stats.FollowerCount,
+		FollowingCount: stats.FollowingCount,
+		IsFollowing:    stats.IsFollowing,
+	}
+}
+
+// Reviewer - 投稿者表示への射影
+func (c *Customer) Reviewer() *Reviewer {
+	return &Reviewer{ID: c.ID, Name: c.Name, Avatar: c.Avatar}
+}
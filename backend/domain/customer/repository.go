@@ -1,10 +1,43 @@
 package customer
 
+import (
+	"errors"
+	"time"
+)
+
+// ErrCustomerNotFound - 該当するカスタマーがない
+var ErrCustomerNotFound = errors.New("customer not found")
+
 // CustomerRepository - カスタマーリポジトリインターフェース
 type CustomerRepository interface {
+	// FindByID - 該当するカスタマーがなければ ErrCustomerNotFound
 	FindByID(id int64) (*Customer, error)
+	// FindByIDForUpdate - 行ロックして取得（トランザクション内で使い、管理者の制裁と本人の更新を直列化する）
+	FindByIDForUpdate(id int64) (*Customer, error)
 	FindByGoogleID(googleID string) (*Customer, error)
 	FindAllWithReviewCount() ([]Customer, map[int64]int, error)
+	// FindSuspensionExpired - 一時停止期限を過ぎたのにステータスが一時停止のままのカスタマー
+	FindSuspensionExpired(now time.Time) ([]Customer, error)
 	Create(customer *Customer) error
 	Update(customer *Customer) error
 }
+
+// SanctionRepository - 制裁履歴リポジトリインターフェース
+type SanctionRepository interface {
+	// FindByCustomerID - カスタマーの制裁履歴（新しい順）
+	FindByCustomerID(customerID int64) ([]Sanction, error)
+	// FindActive - 指定時刻に有効な全カスタマーの制裁
+	FindActive(now time.Time) ([]Sanction, error)
+	// CountByCustomer - カスタマーごとの制裁回数（解除済みを含む）
+	CountByCustomer() (map[int64]int, error)
+	Create(s *Sanction) error
+	Update(s *Sanction) error
+}
+
+// ErasureRepository - 退会（匿名化）リポジトリインターフェース
+type ErasureRepository interface {
+	// FindDueForErasure - 匿名化予定日時を過ぎた退会申請中のカスタマー
+	FindDueForErasure(now time.Time) ([]Customer, error)
+	// Erase - 匿名化済みのカスタマーを保存し、レビュー本文を消去、お気に入り・リスト・通知・推薦・フォロー・審査待ちの提案を削除（1トランザクション、評価は残す）
+	Erase(c *Customer) error
+}
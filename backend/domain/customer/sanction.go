@@ -0,0 +1,131 @@
+package customer
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// 制裁の種類
+const (
+	SanctionBan        = "ban"
+	SanctionSuspension = "suspension"
+)
+
+var ErrSanctionReasonRequired = errors.New("reason is required")
+
+// Sanction - カスタマーへの制裁（BAN・一時停止）の履歴。解除しても行は残す
+type Sanction struct {
+	ID              int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	CustomerID      int64      `json:"customerId"`
+	Type            string     `json:"type"`
+	Reason          string     `json:"reason"`
+	IssuedByAdminID *int64     `json:"issuedByAdminId"`
+	StartsAt        time.Time  `json:"startsAt"`
+	EndsAt          *time.Time `json:"endsAt"` // BAN は nil（無期限）
+	LiftedAt        *time.Time `json:"liftedAt"`
+	LiftedByAdminID *int64     `json:"liftedByAdminId"`
+	LiftReason      *string    `json:"liftReason"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (Sanction) TableName() string {
+	return "customer_sanctions"
+}
+
+// NewBan - BANを作成
+func NewBan(customerID int64, reason string, issuedBy *int64, now time.Time) (*Sanction, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrSanctionReasonRequired
+	}
+	return &Sanction{
+		CustomerID:      customerID,
+		Type:            SanctionBan,
+		Reason:          reason,
+		IssuedByAdminID: issuedBy,
+		StartsAt:        now,
+	}, nil
+}
+
+// NewSuspension - 一時停止を作成（durationDays 日後に自動で終了）
+func NewSuspension(customerID int64, durationDays int, reason string, issuedBy *int64, now time.Time) (*Sanction, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrSanctionReasonRequired
+	}
+	if durationDays <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+	endsAt := now.AddDate(0, 0, durationDays)
+	return &Sanction{
+		CustomerID:      customerID,
+		Type:            SanctionSuspension,
+		Reason:          reason,
+		IssuedByAdminID: issuedBy,
+		StartsAt:        now,
+		EndsAt:          &endsAt,
+	}, nil
+}
+
+// IsActiveAt - 指定時刻に有効か（解除済み・期限切れは無効）
+func (s *Sanction) IsActiveAt(now time.Time) bool {
+	if s.LiftedAt != nil || now.Before(s.StartsAt) {
+		return false
+	}
+	return s.EndsAt == nil || now.Before(*s.EndsAt)
+}
+
+// Lift - 制裁を解除（理由は任意）
+func (s *Sanction) Lift(reason string, liftedBy *int64, now time.Time) {
+	s.LiftedAt = &now
+	s.LiftedByAdminID = liftedBy
+	if trimmed := strings.TrimSpace(reason); trimmed != "" {
+		s.LiftReason = &trimmed
+	}
+}
+
+// CurrentSanction - 有効な制裁のうち現在の状態を決めるもの（BANを優先し、同種なら新しいもの。無ければ nil）
+func CurrentSanction(sanctions []Sanction, now time.Time) *Sanction {
+	var current *Sanction
+	for i := range sanctions {
+		s := &sanctions[i]
+		if !s.IsActiveAt(now) {
+			continue
+		}
+		if current == nil || outranks(s, current) {
+			current = s
+		}
+	}
+	return current
+}
+
+func outranks(s, other *Sanction) bool {
+	if s.Type != other.Type {
+		return s.Type == SanctionBan
+	}
+	return s.StartsAt.After(other.StartsAt)
+}
+
+// ApplySanctions - 制裁履歴から現在のステータスを求めて反映（Status 等のカラムは履歴から導出したキャッシュ）
+func (c *Customer) ApplySanctions(sanctions []Sanction, now time.Time) {
+	current := CurrentSanction(sanctions, now)
+	switch {
+	case current == nil:
+		c.Status = StatusActive
+		c.StatusReason = nil
+		c.SuspendedUntil = nil
+	case current.Type == SanctionBan:
+		reason := current.Reason
+		c.Status = StatusBanned
+		c.StatusReason = &reason
+		c.SuspendedUntil = nil
+	default:
+		reason := current.Reason
+		endsAt := *current.EndsAt
+		c.Status = StatusSuspended
+		c.StatusReason = &reason
+		c.SuspendedUntil = &endsAt
+	}
+}
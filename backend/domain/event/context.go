@@ -0,0 +1,16 @@
+package event
+
+import "context"
+
+type idContextKey struct{}
+
+// WithID - 配信中のイベントの outbox ID を context に載せる
+func WithID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, idContextKey{}, id)
+}
+
+// IDFromContext - 配信中のイベントの outbox ID（購読者の冪等化に使う、無ければ 0）
+func IDFromContext(ctx context.Context) int64 {
+	id, _ := ctx.Value(idContextKey{}).(int64)
+	return id
+}
@@ -0,0 +1,264 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// イベント名
+const (
+	NameReviewCreated     = "review.created"
+	NameReviewUpdated     = "review.updated"
+	NameReviewDeleted     = "review.deleted"
+	NameReplyCreated      = "reply.created"
+	NameReplyDeleted      = "reply.deleted"
+	NameQuestionCreated   = "question.created"
+	NameQuestionDeleted   = "question.deleted"
+	NameAnswerCreated     = "answer.created"
+	NameAnswerDeleted     = "answer.deleted"
+	NameCustomerBanned    = "customer.banned"
+	NameCustomerSuspended = "customer.suspended"
+	NameCustomerUnbanned  = "customer.unbanned"
+	NameProductCreated    = "product.created"
+	NameProductUpdated    = "product.updated"
+	NameProductDeleted    = "product.deleted"
+	NameOfferPriceDropped = "offer.price_dropped"
+	NameOfferBackInStock  = "offer.back_in_stock"
+)
+
+// Event - ドメインイベント（outbox にJSONで保存され、ワーカーが購読者へ配信する）
+type Event interface {
+	EventName() string
+}
+
+// ReviewCreated - レビューが投稿された
+type ReviewCreated struct {
+	ReviewID   int64 `json:"reviewId"`
+	ProductID  int64 `json:"productId"`
+	CustomerID int64 `json:"customerId"`
+	Rating     int   `json:"rating"`
+}
+
+// ReviewUpdated - レビューが編集された
+type ReviewUpdated struct {
+	ReviewID   int64 `json:"reviewId"`
+	ProductID  int64 `json:"productId"`
+	CustomerID int64 `json:"customerId"`
+	Rating     int   `json:"rating"`
+}
+
+// ReviewDeleted - レビューが削除された（ByAdmin は管理者による削除）
+type ReviewDeleted struct {
+	ReviewID   int64 `json:"reviewId"`
+	ProductID  int64 `json:"productId"`
+	CustomerID int64 `json:"customerId"`
+	ByAdmin    bool  `json:"byAdmin"`
+}
+
+// ReplyCreated - レビューに返信が投稿された（IsOfficial は管理者による公式返信）
+type ReplyCreated struct {
+	ReplyID    int64  `json:"replyId"`
+	ReviewID   int64  `json:"reviewId"`
+	ProductID  int64  `json:"productId"`
+	CustomerID *int64 `json:"customerId"`
+	IsOfficial bool   `json:"isOfficial"`
+}
+
+// ReplyDeleted - レビューへの返信が削除された（ByAdmin は管理者による削除）
+type ReplyDeleted struct {
+	ReplyID   int64 `json:"replyId"`
+	ReviewID  int64 `json:"reviewId"`
+	ProductID int64 `json:"productId"`
+	ByAdmin   bool  `json:"byAdmin"`
+}
+
+// QuestionCreated - 商品に質問が投稿された
+type QuestionCreated struct {
+	QuestionID int64 `json:"questionId"`
+	ProductID  int64 `json:"productId"`
+	CustomerID int64 `json:"customerId"`
+}
+
+// QuestionDeleted - 商品への質問が削除された（ByAdmin は管理者による削除）
+type QuestionDeleted struct {
+	QuestionID int64 `json:"questionId"`
+	ProductID  int64 `json:"productId"`
+	ByAdmin    bool  `json:"byAdmin"`
+}
+
+// AnswerCreated - 質問に回答が投稿された（IsOfficial は管理者による公式回答）
+type AnswerCreated struct {
+	AnswerID   int64  `json:"answerId"`
+	QuestionID int64  `json:"questionId"`
+	ProductID  int64  `json:"productId"`
+	CustomerID *int64 `json:"customerId"`
+	IsOfficial bool   `json:"isOfficial"`
+}
+
+// AnswerDeleted - 質問への回答が削除された（ByAdmin は管理者による削除）
+type AnswerDeleted struct {
+	AnswerID   int64 `json:"answerId"`
+	QuestionID int64 `json:"questionId"`
+	ProductID  int64 `json:"productId"`
+	ByAdmin    bool  `json:"byAdmin"`
+}
+
+// CustomerBanned - カスタマーがBANされた
+type CustomerBanned struct {
+	CustomerID int64  `json:"customerId"`
+	Reason     string `json:"reason"`
+}
+
+// CustomerSuspended - カスタマーが一時停止された
+type CustomerSuspended struct {
+	CustomerID     int64  `json:"customerId"`
+	Reason         string `json:"reason"`
+	SuspendedUntil string `json:"suspendedUntil"`
+}
+
+// CustomerUnbanned - カスタマーのBAN/停止が解除された
+type CustomerUnbanned struct {
+	CustomerID int64 `json:"customerId"`
+}
+
+// ProductCreated - 商品が登録された
+type ProductCreated struct {
+	ProductID int64  `json:"productId"`
+	AdminID   *int64 `json:"adminId"`
+}
+
+// ProductUpdated - 商品が更新された
+type ProductUpdated struct {
+	ProductID             int64  `json:"productId"`
+	AdminID               *int64 `json:"adminId"`
+	SuggestionID          *int64 `json:"suggestionId,omitempty"`          // 修正提案の承認による更新
+	SuggestedByCustomerID *int64 `json:"suggestedByCustomerId,omitempty"` // 修正を提案したカスタマー
+}
+
+// ProductDeleted - 商品が削除された
+type ProductDeleted struct {
+	ProductID int64 `json:"productId"`
+}
+
+// OfferPriceDropped - ストア価格が値下がりした（金額は補助単位）
+type OfferPriceDropped struct {
+	ProductID int64  `json:"productId"`
+	Store     string `json:"store"`
+	OldAmount int64  `json:"oldAmount"`
+	NewAmount int64  `json:"newAmount"`
+	Currency  string `json:"currency"`
+}
+
+// OfferBackInStock - ストアで在庫が復活した
+type OfferBackInStock struct {
+	ProductID int64  `json:"productId"`
+	Store     string `json:"store"`
+}
+
+func (ReviewCreated) EventName() string     { return NameReviewCreated }
+func (ReviewUpdated) EventName() string     { return NameReviewUpdated }
+func (ReviewDeleted) EventName() string     { return NameReviewDeleted }
+func (ReplyCreated) EventName() string      { return NameReplyCreated }
+func (ReplyDeleted) EventName() string      { return NameReplyDeleted }
+func (QuestionCreated) EventName() string   { return NameQuestionCreated }
+func (QuestionDeleted) EventName() string   { return NameQuestionDeleted }
+func (AnswerCreated) EventName() string     { return NameAnswerCreated }
+func (AnswerDeleted) EventName() string     { return NameAnswerDeleted }
+func (CustomerBanned) EventName() string    { return NameCustomerBanned }
+func (CustomerSuspended) EventName() string { return NameCustomerSuspended }
+func (CustomerUnbanned) EventName() string  { return NameCustomerUnbanned }
+func (ProductCreated) EventName() string    { return NameProductCreated }
+func (ProductUpdated) EventName() string    { return NameProductUpdated }
+func (ProductDeleted) EventName() string    { return NameProductDeleted }
+func (OfferPriceDropped) EventName() string { return NameOfferPriceDropped }
+func (OfferBackInStock) EventName() string  { return NameOfferBackInStock }
+
+// Decode - 保存済みのイベントを型付きの値に復元
+func Decode(name string, payload []byte) (Event, error) {
+	var e Event
+	switch name {
+	case NameReviewCreated:
+		e = decodeAs[ReviewCreated](payload)
+	case NameReviewUpdated:
+		e = decodeAs[ReviewUpdated](payload)
+	case NameReviewDeleted:
+		e = decodeAs[ReviewDeleted](payload)
+	case NameReplyCreated:
+		e = decodeAs[ReplyCreated](payload)
+	case NameReplyDeleted:
+		e = decodeAs[ReplyDeleted](payload)
+	case NameQuestionCreated:
+		e = decodeAs[QuestionCreated](payload)
+	case NameQuestionDeleted:
+		e = decodeAs[QuestionDeleted](payload)
+	case NameAnswerCreated:
+		e = decodeAs[AnswerCreated](payload)
+	case NameAnswerDeleted:
+		e = decodeAs[AnswerDeleted](payload)
+	case NameCustomerBanned:
+		e = decodeAs[CustomerBanned](payload)
+	case NameCustomerSuspended:
+		e = decodeAs[CustomerSuspended](payload)
+	case NameCustomerUnbanned:
+		e = decodeAs[CustomerUnbanned](payload)
+	case NameProductCreated:
+		e = decodeAs[ProductCreated](payload)
+	case NameProductUpdated:
+		e = decodeAs[ProductUpdated](payload)
+	case NameProductDeleted:
+		e = decodeAs[ProductDeleted](payload)
+	case NameOfferPriceDropped:
+		e = decodeAs[OfferPriceDropped](payload)
+	case NameOfferBackInStock:
+		e = decodeAs[OfferBackInStock](payload)
+	default:
+		return nil, fmt.Errorf("unknown event %q", name)
+	}
+	if e == nil {
+		return nil, fmt.Errorf("invalid payload for event %q", name)
+	}
+	return e, nil
+}
+
+func decodeAs[T Event](payload []byte) Event {
+	var v T
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return nil
+	}
+	return v
+}
+
+// ProductIDOf - 商品に関するイベントなら対象の商品IDを返す
+func ProductIDOf(e Event) (int64, bool) {
+	switch e := e.(type) {
+	case ReviewCreated:
+		return e.ProductID, true
+	case ReviewUpdated:
+		return e.ProductID, true
+	case ReviewDeleted:
+		return e.ProductID, true
+	case ReplyCreated:
+		return e.ProductID, true
+	case ReplyDeleted:
+		return e.ProductID, true
+	case QuestionCreated:
+		return e.ProductID, true
+	case QuestionDeleted:
+		return e.ProductID, true
+	case AnswerCreated:
+		return e.ProductID, true
+	case AnswerDeleted:
+		return e.ProductID, true
+	case ProductCreated:
+		return e.ProductID, true
+	case ProductUpdated:
+		return e.ProductID, true
+	case ProductDeleted:
+		return e.ProductID, true
+	case OfferPriceDropped:
+		return e.ProductID, true
+	case OfferBackInStock:
+		return e.ProductID, true
+	}
+	return 0, false
+}
@@ -0,0 +1,86 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 配信状態
+const (
+	StatusPending   = "pending"
+	StatusProcessed = "processed"
+	StatusDead      = "dead"
+)
+
+const (
+	// MaxAttempts - この回数失敗したら配信を諦める（dead）
+	MaxAttempts = 8
+	// 再試行間隔（失敗ごとに倍、上限あり）
+	initialRetryDelay = 30 * time.Second
+	maxRetryDelay     = 6 * time.Hour
+	lastErrorMaxBytes = 1000
+)
+
+// OutboxMessage - 配信待ちのイベント（業務データと同一トランザクションで保存）
+type OutboxMessage struct {
+	ID            int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventName     string     `json:"eventName"`
+	Payload       string     `json:"payload" gorm:"type:jsonb"`
+	Status        string     `json:"status" gorm:"default:pending"`
+	Attempts      int        `json:"attempts" gorm:"default:0"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	LastError     *string    `json:"lastError"`
+	ProcessedAt   *time.Time `json:"processedAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (OutboxMessage) TableName() string {
+	return "outbox_events"
+}
+
+// NewOutboxMessage - イベントを outbox 行に変換
+func NewOutboxMessage(e Event, now time.Time) (*OutboxMessage, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{
+		EventName:     e.EventName(),
+		Payload:       string(payload),
+		Status:        StatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// Event - 保存済みのイベントを復元
+func (m *OutboxMessage) Event() (Event, error) {
+	return Decode(m.EventName, []byte(m.Payload))
+}
+
+// MarkProcessed - 配信完了
+func (m *OutboxMessage) MarkProcessed(now time.Time) {
+	m.Status = StatusProcessed
+	m.ProcessedAt = &now
+	m.LastError = nil
+}
+
+// MarkFailed - 配信失敗を記録し、次回の再試行時刻を決める（上限回数で dead）
+func (m *OutboxMessage) MarkFailed(err error, now time.Time) {
+	m.Attempts++
+	msg := err.Error()
+	if len(msg) > lastErrorMaxBytes {
+		msg = msg[:lastErrorMaxBytes]
+	}
+	m.LastError = &msg
+	if m.Attempts >= MaxAttempts {
+		m.Status = StatusDead
+		return
+	}
+	delay := initialRetryDelay << (m.Attempts - 1)
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	m.NextAttemptAt = now.Add(delay)
+}
@@ -0,0 +1,54 @@
+package event
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"backend/domain/customer"
+	"backend/domain/favorite"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"backend/domain/question"
+	"backend/domain/review"
+	"backend/domain/submission"
+	"backend/domain/suggestion"
+	"time"
+)
+
+// Outbox - トランザクション内でイベントを記録する
+type Outbox interface {
+	Add(events ...Event) error
+}
+
+// Tx - トランザクションに参加するリポジトリ
+type Tx interface {
+	Customers() customer.CustomerRepository
+	Sanctions() customer.SanctionRepository
+	Reviews() review.ReviewRepository
+	Replies() review.ReplyRepository
+	Questions() question.QuestionRepository
+	Answers() question.AnswerRepository
+	Submissions() submission.SubmissionRepository
+	Suggestions() suggestion.SuggestionRepository
+	Products() product.ProductRepository
+	Categories() product.CategoryRepository
+	Stores() product.StoreRepository
+	Offers() offer.OfferRepository
+	Favorites() favorite.FavoriteRepository
+	Collections() favorite.CollectionRepository
+	Admins() admin.AdminRepository
+	Roles() admin.RoleRepository
+	AuditLogs() audit.AuditLogRepository
+	Outbox() Outbox
+}
+
+// UnitOfWork - 業務データの更新とイベントの記録を1トランザクションで行う（fn がエラーを返すとロールバック）
+type UnitOfWork interface {
+	Do(fn func(tx Tx) error) error
+}
+
+// OutboxRepository - 配信ワーカー用の outbox リポジトリインターフェース
+type OutboxRepository interface {
+	// FindDue - 配信時刻を過ぎた pending のイベント（古い順）
+	FindDue(now time.Time, limit int) ([]OutboxMessage, error)
+	Save(message *OutboxMessage) error
+}
@@ -0,0 +1,125 @@
+package favorite
+
+import (
+	"backend/domain/product"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	CollectionNameMaxLength        = 50
+	CollectionDescriptionMaxLength = 500
+	CollectionItemNoteMaxLength    = 500
+	// DefaultCollectionName - 既定のリスト名（お気に入り登録時に追加される）
+	DefaultCollectionName = "お気に入り"
+	// shareTokenBytes - 共有トークンのバイト数
+	shareTokenBytes = 18
+)
+
+var (
+	ErrCollectionNameEmpty          = errors.New("collection name is required")
+	ErrCollectionNameTooLong        = errors.New("collection name must be at most 50 characters")
+	ErrCollectionDescriptionTooLong = errors.New("collection description must be at most 500 characters")
+	ErrCollectionItemNoteTooLong    = errors.New("note must be at most 500 characters")
+)
+
+// Collection - お気に入りリスト（「朝食」「ギフト」等）
+type Collection struct {
+	ID          int64            `json:"id" gorm:"primaryKey;autoIncrement"`
+	CustomerID  int64            `json:"customerId"`
+	Name        string           `json:"name"`
+	Description *string          `json:"description"`
+	IsDefault   bool             `json:"isDefault" gorm:"default:false"`
+	IsPublic    bool             `json:"isPublic" gorm:"default:false"`
+	ShareToken  *string          `json:"shareToken"`
+	Items       []CollectionItem `json:"items" gorm:"foreignKey:CollectionID"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	UpdatedAt   time.Time        `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Collection) TableName() string {
+	return "favorite_collections"
+}
+
+// CollectionItem - リスト内の商品（メモと並び順付き）
+type CollectionItem struct {
+	ID           int64            `json:"id" gorm:"primaryKey;autoIncrement"`
+	CollectionID int64            `json:"collectionId"`
+	ProductID    int64            `json:"productId"`
+	Product      *product.Product `json:"product,omitempty" gorm:"foreignKey:ProductID"`
+	Note         *string          `json:"note"`
+	Position     int              `json:"position"`
+	CreatedAt    time.Time        `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (CollectionItem) TableName() string {
+	return "favorite_collection_items"
+}
+
+// NewCollectionName - リスト名を検証
+func NewCollectionName(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrCollectionNameEmpty
+	}
+	if utf8.RuneCountInString(trimmed) > CollectionNameMaxLength {
+		return "", ErrCollectionNameTooLong
+	}
+	return trimmed, nil
+}
+
+// NewCollectionDescription - リストの説明を検証（空ならnil）
+func NewCollectionDescription(value *string) (*string, error) {
+	return optionalText(value, CollectionDescriptionMaxLength, ErrCollectionDescriptionTooLong)
+}
+
+// NewCollectionItemNote - 商品メモを検証（空ならnil）
+func NewCollectionItemNote(value *string) (*string, error) {
+	return optionalText(value, CollectionItemNoteMaxLength, ErrCollectionItemNoteTooLong)
+}
+
+// SetPublic - 公開設定を変更（公開時に共有トークンを発行、非公開にするとトークンを破棄して旧リンクを無効化）
+func (c *Collection) SetPublic(public bool) error {
+	c.IsPublic = public
+	if !public {
+		c.ShareToken = nil
+		return nil
+	}
+	if c.ShareToken != nil {
+		return nil
+	}
+	token, err := newShareToken()
+	if err != nil {
+		return err
+	}
+	c.ShareToken = &token
+	return nil
+}
+
+func optionalText(value *string, maxLength int, errTooLong error) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(trimmed) > maxLength {
+		return nil, errTooLong
+	}
+	return &trimmed, nil
+}
+
+func newShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
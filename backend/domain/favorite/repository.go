@@ -1,9 +1,39 @@
 package favorite
 
+import "errors"
+
+var (
+	// ErrFavoriteNotFound - 該当するお気に入りがない
+	ErrFavoriteNotFound = errors.New("favorite not found")
+	// ErrCollectionNotFound - 該当するリストがない
+	ErrCollectionNotFound = errors.New("collection not found")
+)
+
 // FavoriteRepository - お気に入りリポジトリインターフェース
 type FavoriteRepository interface {
 	FindByCustomerID(customerID int64) ([]Favorite, error)
+	// FindByCustomerIDAndProductID - 顧客・商品のお気に入り（なければ ErrFavoriteNotFound）
 	FindByCustomerIDAndProductID(customerID, productID int64) (*Favorite, error)
 	Create(favorite *Favorite) error
 	Delete(customerID, productID int64) error
 }
+
+// CollectionRepository - お気に入りリストリポジトリインターフェース
+type CollectionRepository interface {
+	// FindByCustomerID - 顧客のリスト一覧（既定のリストが先頭、商品は並び順）
+	FindByCustomerID(customerID int64) ([]Collection, error)
+	// FindByID / FindByShareToken / FindDefault - 該当するリストがなければ ErrCollectionNotFound
+	FindByID(id int64) (*Collection, error)
+	FindByShareToken(token string) (*Collection, error)
+	FindDefault(customerID int64) (*Collection, error)
+	Create(collection *Collection) error
+	Update(collection *Collection) error
+	Delete(id int64) error
+	AddItem(item *CollectionItem) error
+	UpdateItem(item *CollectionItem) error
+	RemoveItem(collectionID, productID int64) error
+	// RemoveProduct - 顧客の全リストから商品を取り除く（お気に入り解除時）
+	RemoveProduct(customerID, productID int64) error
+	// ReorderItems - productIDs の順に並び順を振り直す
+	ReorderItems(collectionID int64, productIDs []int64) error
+}
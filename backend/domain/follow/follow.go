@@ -0,0 +1,40 @@
+package follow
+
+import (
+	"errors"
+	"time"
+)
+
+// フィードの取得件数
+const (
+	FeedDefaultLimit = 20
+	FeedMaxLimit     = 50
+)
+
+var ErrCannotFollowSelf = errors.New("you cannot follow yourself")
+
+// Follow - カスタマー間のフォロー関係（FollowerID が FolloweeID をフォロー）
+type Follow struct {
+	FollowerID int64     `json:"followerId" gorm:"primaryKey"`
+	FolloweeID int64     `json:"followeeId" gorm:"primaryKey"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (Follow) TableName() string {
+	return "customer_follows"
+}
+
+// NewFollow - フォロー関係を生成（自分自身はフォローできない）
+func NewFollow(followerID, followeeID int64, now time.Time) (*Follow, error) {
+	if followerID == followeeID {
+		return nil, ErrCannotFollowSelf
+	}
+	return &Follow{FollowerID: followerID, FolloweeID: followeeID, CreatedAt: now}, nil
+}
+
+// Counts - フォロワー数・フォロー数
+type Counts struct {
+	Followers int64
+	Following int64
+}
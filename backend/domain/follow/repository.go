@@ -0,0 +1,14 @@
+package follow
+
+import "backend/domain/review"
+
+// FollowRepository - フォローリポジトリインターフェース
+type FollowRepository interface {
+	// Create - フォロー（既にフォロー済みなら何もしない）
+	Create(f *Follow) error
+	Delete(followerID, followeeID int64) error
+	Exists(followerID, followeeID int64) (bool, error)
+	Counts(customerID int64) (Counts, error)
+	// FindFeed - フォロー中の公開カスタマーのレビュー（ID の降順、beforeID が 0 でなければそれより古いもの）
+	FindFeed(followerID, beforeID int64, limit int) ([]review.Review, error)
+}
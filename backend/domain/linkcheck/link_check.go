@@ -0,0 +1,126 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// リンクの種類（ストアリンクはストアコードを Target に使う）
+const (
+	TargetImage     = "image"
+	TargetAffiliate = "affiliate"
+)
+
+// リンクの状態
+const (
+	StatusOK          Status = "ok"
+	StatusBroken      Status = "broken"
+	StatusUnreachable Status = "unreachable"
+)
+
+const (
+	// CheckInterval - 正常なリンクを再チェックする間隔
+	CheckInterval = 24 * time.Hour
+	// RetryBaseDelay - 失敗したリンクの最初の再チェックまでの間隔（以降は倍々）
+	RetryBaseDelay = time.Hour
+	// RetryMaxDelay - 再チェック間隔の上限
+	RetryMaxDelay = 7 * 24 * time.Hour
+	// ErrorMessageMaxLength - 保存するエラーメッセージの最大長
+	ErrorMessageMaxLength = 500
+)
+
+// Status - リンクの状態
+type Status string
+
+// LinkCheck - 商品URLの死活チェック結果
+type LinkCheck struct {
+	ID                  int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	ProductID           int64     `json:"productId"`
+	ProductName         string    `json:"productName" gorm:"->"`
+	Target              string    `json:"target"`
+	URL                 string    `json:"url" gorm:"column:url"`
+	Status              Status    `json:"status"`
+	HTTPStatus          *int      `json:"httpStatus" gorm:"column:http_status"`
+	ErrorMessage        *string   `json:"errorMessage"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastCheckedAt       time.Time `json:"lastCheckedAt"`
+	NextCheckAt         time.Time `json:"nextCheckAt"`
+}
+
+// TableName - GORMテーブル名
+func (LinkCheck) TableName() string {
+	return "product_link_checks"
+}
+
+// Result - 1回のチェック結果
+type Result struct {
+	HTTPStatus int
+	Err        error
+	// RetryAfter - サーバーが Retry-After で指定した待ち時間（0なら指定なし）
+	RetryAfter time.Duration
+}
+
+// Checker - URLの死活チェック
+type Checker interface {
+	Check(ctx context.Context, url string) Result
+}
+
+// Record - チェック結果を反映し、次回チェック時刻を決める（失敗が続くほど間隔を延ばす）
+func (c *LinkCheck) Record(result Result, now time.Time) {
+	c.LastCheckedAt = now
+	c.HTTPStatus = nil
+	c.ErrorMessage = nil
+	if result.HTTPStatus != 0 {
+		status := result.HTTPStatus
+		c.HTTPStatus = &status
+	}
+	if result.Err != nil {
+		msg := result.Err.Error()
+		if len(msg) > ErrorMessageMaxLength {
+			msg = msg[:ErrorMessageMaxLength]
+		}
+		c.ErrorMessage = &msg
+	}
+
+	c.Status = classify(result)
+	if c.Status == StatusOK {
+		c.ConsecutiveFailures = 0
+		c.NextCheckAt = now.Add(CheckInterval)
+		return
+	}
+
+	c.ConsecutiveFailures++
+	delay := RetryMaxDelay
+	if c.ConsecutiveFailures <= 10 {
+		delay = RetryBaseDelay << (c.ConsecutiveFailures - 1)
+	}
+	if result.RetryAfter > delay {
+		delay = result.RetryAfter
+	}
+	if delay > RetryMaxDelay {
+		delay = RetryMaxDelay
+	}
+	c.NextCheckAt = now.Add(delay)
+}
+
+// IsDue - チェック時刻に達しているか
+func (c *LinkCheck) IsDue(now time.Time) bool {
+	return !c.NextCheckAt.After(now)
+}
+
+// classify - 2xx/3xx は正常、404/410 等のクライアントエラーはリンク切れ、それ以外は到達不能
+func classify(result Result) Status {
+	switch {
+	case result.Err != nil || result.HTTPStatus == 0:
+		return StatusUnreachable
+	case result.HTTPStatus < http.StatusBadRequest:
+		return StatusOK
+	case result.HTTPStatus == http.StatusTooManyRequests:
+		return StatusUnreachable
+	case result.HTTPStatus < http.StatusInternalServerError:
+		return StatusBroken
+	default:
+		return StatusUnreachable
+	}
+}
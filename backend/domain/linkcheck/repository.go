@@ -0,0 +1,10 @@
+package linkcheck
+
+// LinkCheckRepository - リンクチェック結果リポジトリインターフェース
+type LinkCheckRepository interface {
+	FindAll() ([]LinkCheck, error)
+	// FindFailing - 正常でないリンク一覧（商品名付き、連続失敗回数の多い順）
+	FindFailing() ([]LinkCheck, error)
+	Save(check *LinkCheck) error
+	Delete(id int64) error
+}
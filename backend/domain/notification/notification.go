@@ -0,0 +1,223 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 通知の種類
+const (
+	TypeNewReview   Type = "new_review"
+	TypePriceDrop   Type = "price_drop"
+	TypeBackInStock Type = "back_in_stock"
+)
+
+// 配信チャネル
+const (
+	ChannelInApp Channel = "in_app"
+	ChannelEmail Channel = "email"
+)
+
+var (
+	ErrTypeInvalid = errors.New("notification type must be one of new_review, price_drop, back_in_stock")
+)
+
+// 配信記録の状態
+const (
+	DeliverySent   = "sent"
+	DeliveryFailed = "failed"
+)
+
+// lastErrorMaxBytes - 配信記録に残すエラーの長さの上限（バイト）
+const lastErrorMaxBytes = 1000
+
+// Type - 通知の種類のValue Object
+type Type string
+
+// Channel - 配信チャネル
+type Channel string
+
+// Types - 全ての通知の種類（設定画面の表示順）
+func Types() []Type {
+	return []Type{TypeNewReview, TypePriceDrop, TypeBackInStock}
+}
+
+// NewType - Type を生成
+func NewType(value string) (Type, error) {
+	for _, t := range Types() {
+		if Type(value) == t {
+			return t, nil
+		}
+	}
+	return "", ErrTypeInvalid
+}
+
+// Notification - アプリ内通知
+type Notification struct {
+	ID         int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	CustomerID int64      `json:"customerId"`
+	Type       Type       `json:"type"`
+	ProductID  int64      `json:"productId"`
+	Title      string     `json:"title"`
+	Body       string     `json:"body"`
+	EventID    *int64     `json:"-"`
+	ReadAt     *time.Time `json:"readAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// Event - お気に入り商品に起きた出来事（購読者ごとの通知に展開される）
+type Event struct {
+	// ID - 元になったドメインイベントの outbox ID（再配信時の重複排除に使う）
+	ID        int64
+	Type      Type
+	ProductID int64
+	Title     string
+	Body      string
+	// ActorCustomerID - 出来事を起こした顧客（本人には通知しない、0 は該当なし）
+	ActorCustomerID int64
+}
+
+// NewReviewEvent - 新着レビューのイベント
+func NewReviewEvent(productID int64, productName string, reviewerID int64, rating int) Event {
+	return Event{
+		Type:            TypeNewReview,
+		ProductID:       productID,
+		Title:           fmt.Sprintf("「%s」に新しいレビュー", productName),
+		Body:            fmt.Sprintf("お気に入りの「%s」に★%dのレビューが投稿されました。", productName, rating),
+		ActorCustomerID: reviewerID,
+	}
+}
+
+// NewPriceDropEvent - 値下がりのイベント（価格は表示用の表記）
+func NewPriceDropEvent(productID int64, productName, storeName, oldPrice, newPrice string) Event {
+	return Event{
+		Type:      TypePriceDrop,
+		ProductID: productID,
+		Title:     fmt.Sprintf("「%s」が値下がりしました", productName),
+		Body:      fmt.Sprintf("%sでの価格が %s から %s になりました。", storeName, oldPrice, newPrice),
+	}
+}
+
+// NewBackInStockEvent - 再入荷のイベント
+func NewBackInStockEvent(productID int64, productName, storeName string) Event {
+	return Event{
+		Type:      TypeBackInStock,
+		ProductID: productID,
+		Title:     fmt.Sprintf("「%s」が再入荷しました", productName),
+		Body:      fmt.Sprintf("%sで在庫が復活しました。", storeName),
+	}
+}
+
+// ToNotification - 購読者向けの通知を生成
+func (e Event) ToNotification(customerID int64, at time.Time) *Notification {
+	return &Notification{
+		CustomerID: customerID,
+		Type:       e.Type,
+		ProductID:  e.ProductID,
+		Title:      e.Title,
+		Body:       e.Body,
+		EventID:    &e.ID,
+		CreatedAt:  at,
+	}
+}
+
+// Delivery - アプリ外チャネルでの配信記録（イベント・顧客・チャネルごとに1行、送信済みなら再配信時に送らない）
+type Delivery struct {
+	EventID    int64   `gorm:"primaryKey"`
+	CustomerID int64   `gorm:"primaryKey"`
+	Channel    Channel `gorm:"primaryKey"`
+	Status     string
+	Attempts   int
+	LastError  *string
+	SentAt     *time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName - GORMテーブル名
+func (Delivery) TableName() string {
+	return "notification_deliveries"
+}
+
+// Record - 送信結果を記録
+func (d *Delivery) Record(err error, at time.Time) {
+	d.Attempts++
+	d.UpdatedAt = at
+	if err == nil {
+		d.Status = DeliverySent
+		d.SentAt = &at
+		d.LastError = nil
+		return
+	}
+	msg := err.Error()
+	if len(msg) > lastErrorMaxBytes {
+		msg = strings.ToValidUTF8(msg[:lastErrorMaxBytes], "")
+	}
+	d.Status = DeliveryFailed
+	d.LastError = &msg
+}
+
+// Preference - 通知の種類ごとの受信設定
+type Preference struct {
+	CustomerID int64 `json:"-" gorm:"primaryKey"`
+	Type       Type  `json:"type" gorm:"primaryKey"`
+	InApp      bool  `json:"inApp"`
+	Email      bool  `json:"email"`
+}
+
+// TableName - GORMテーブル名
+func (Preference) TableName() string {
+	return "notification_preferences"
+}
+
+// DefaultPreference - 未設定時の受信設定（アプリ内のみ受信）
+func DefaultPreference(customerID int64, t Type) Preference {
+	return Preference{CustomerID: customerID, Type: t, InApp: true, Email: false}
+}
+
+// Enabled - チャネルでの受信が有効か
+func (p Preference) Enabled(channel Channel) bool {
+	switch channel {
+	case ChannelInApp:
+		return p.InApp
+	case ChannelEmail:
+		return p.Email
+	}
+	return false
+}
+
+// ResolvePreferences - 保存済みの設定に未設定の種類の既定値を補い、全種類分を返す
+func ResolvePreferences(customerID int64, stored []Preference) []Preference {
+	byType := make(map[Type]Preference, len(stored))
+	for _, p := range stored {
+		byType[p.Type] = p
+	}
+	result := make([]Preference, 0, len(Types()))
+	for _, t := range Types() {
+		p, ok := byType[t]
+		if !ok {
+			p = DefaultPreference(customerID, t)
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// Recipient - 通知の受信者
+type Recipient struct {
+	CustomerID int64
+	Email      string
+	Name       string
+}
+
+// Sender - アプリ外への配信チャネル（メール等）
+type Sender interface {
+	Channel() Channel
+	Send(to Recipient, n *Notification) error
+}
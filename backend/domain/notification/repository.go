@@ -0,0 +1,20 @@
+package notification
+
+import "time"
+
+// NotificationRepository - 通知リポジトリインターフェース
+type NotificationRepository interface {
+	// FindSubscribers - 商品をお気に入り登録している有効な顧客
+	FindSubscribers(productID int64) ([]Recipient, error)
+	FindPreferences(customerIDs []int64) ([]Preference, error)
+	SavePreferences(prefs []Preference) error
+	// Create - 通知を保存（同じイベントで同じ顧客への通知が既にあれば何もしない）
+	Create(n *Notification) error
+	FindByCustomerID(customerID int64, unreadOnly bool, limit int) ([]Notification, error)
+	CountUnread(customerID int64) (int64, error)
+	// MarkRead - 未読の通知を既読にする（ids が空なら全件）
+	MarkRead(customerID int64, ids []int64, at time.Time) (int64, error)
+	// FindDeliveries - イベントのアプリ外チャネルでの配信記録
+	FindDeliveries(eventID int64) ([]Delivery, error)
+	SaveDelivery(d *Delivery) error
+}
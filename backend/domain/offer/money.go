@@ -0,0 +1,66 @@
+package offer
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency - 比較・表示の基準通貨
+const DefaultCurrency = "JPY"
+
+var (
+	ErrCurrencyUnsupported = errors.New("currency must be one of JPY, USD, EUR")
+	ErrPriceNegative       = errors.New("price must not be negative")
+	ErrPriceInvalid        = errors.New("price format is invalid")
+)
+
+// 通貨ごとの補助単位の桁数
+var currencyExponents = map[string]int{
+	"JPY": 0,
+	"USD": 2,
+	"EUR": 2,
+}
+
+// Money - 金額のValue Object（Amount は補助単位。JPY は円、USD はセント）
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney - Money を生成（バリデーション付き）
+func NewMoney(amount int64, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if _, ok := currencyExponents[currency]; !ok {
+		return Money{}, ErrCurrencyUnsupported
+	}
+	if amount < 0 {
+		return Money{}, ErrPriceNegative
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseMoney - "1280" や "12.99" のような表記から Money を生成
+func ParseMoney(value, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	exp, ok := currencyExponents[currency]
+	if !ok {
+		return Money{}, ErrCurrencyUnsupported
+	}
+
+	f, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", ""), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return Money{}, ErrPriceInvalid
+	}
+	return NewMoney(int64(math.Round(f*math.Pow10(exp))), currency)
+}
+
+// String - 表示用の表記（例: "1280 JPY", "12.99 USD"）
+func (m Money) String() string {
+	exp := currencyExponents[m.Currency]
+	if exp == 0 {
+		return strconv.FormatInt(m.Amount, 10) + " " + m.Currency
+	}
+	return strconv.FormatFloat(float64(m.Amount)/math.Pow10(exp), 'f', exp, 64) + " " + m.Currency
+}
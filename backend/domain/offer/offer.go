@@ -0,0 +1,121 @@
+package offer
+
+import (
+	"errors"
+	"time"
+)
+
+// 在庫状況
+const (
+	AvailabilityInStock    Availability = "in_stock"
+	AvailabilityOutOfStock Availability = "out_of_stock"
+	AvailabilityUnknown    Availability = "unknown"
+)
+
+var ErrAvailabilityInvalid = errors.New("availability must be one of in_stock, out_of_stock, unknown")
+
+// Availability - 在庫状況のValue Object
+type Availability string
+
+// NewAvailability - Availability を生成（空文字は unknown）
+func NewAvailability(value string) (Availability, error) {
+	switch Availability(value) {
+	case "":
+		return AvailabilityUnknown, nil
+	case AvailabilityInStock, AvailabilityOutOfStock, AvailabilityUnknown:
+		return Availability(value), nil
+	}
+	return "", ErrAvailabilityInvalid
+}
+
+// Offer - ストアごとの販売情報（現在価格）
+type Offer struct {
+	ID            int64        `json:"id" gorm:"primaryKey;autoIncrement"`
+	ProductID     int64        `json:"productId"`
+	Store         string       `json:"store"`
+	PriceAmount   int64        `json:"priceAmount"`
+	Currency      string       `json:"currency"`
+	Availability  Availability `json:"availability"`
+	LastCheckedAt time.Time    `json:"lastCheckedAt"`
+	CreatedAt     time.Time    `json:"createdAt"`
+	UpdatedAt     time.Time    `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Offer) TableName() string {
+	return "retailer_offers"
+}
+
+// Price - 現在価格を取得
+func (o *Offer) Price() Money {
+	return Money{Amount: o.PriceAmount, Currency: o.Currency}
+}
+
+// Apply - 価格・在庫を反映し、履歴に残すべき変化があったかを返す
+func (o *Offer) Apply(price Money, availability Availability, checkedAt time.Time) bool {
+	changed := o.ID == 0 ||
+		o.PriceAmount != price.Amount ||
+		o.Currency != price.Currency ||
+		o.Availability != availability
+
+	o.PriceAmount = price.Amount
+	o.Currency = price.Currency
+	o.Availability = availability
+	o.LastCheckedAt = checkedAt
+	return changed
+}
+
+// PricePoint - 価格履歴
+type PricePoint struct {
+	ID           int64        `json:"id" gorm:"primaryKey;autoIncrement"`
+	OfferID      int64        `json:"offerId"`
+	ProductID    int64        `json:"productId"`
+	Store        string       `json:"store"`
+	PriceAmount  int64        `json:"priceAmount"`
+	Currency     string       `json:"currency"`
+	Availability Availability `json:"availability"`
+	RecordedAt   time.Time    `json:"recordedAt"`
+}
+
+// TableName - GORMテーブル名
+func (PricePoint) TableName() string {
+	return "retailer_offer_price_history"
+}
+
+// NewPricePoint - オファーの現在値から価格履歴を生成
+func NewPricePoint(o *Offer) *PricePoint {
+	return &PricePoint{
+		OfferID:      o.ID,
+		ProductID:    o.ProductID,
+		Store:        o.Store,
+		PriceAmount:  o.PriceAmount,
+		Currency:     o.Currency,
+		Availability: o.Availability,
+		RecordedAt:   o.LastCheckedAt,
+	}
+}
+
+// Cheapest - 在庫ありの中で基準通貨の最安オファーを取得（なければnil）
+func Cheapest(offers []Offer) *Offer {
+	var cheapest *Offer
+	for i := range offers {
+		o := &offers[i]
+		if o.Availability != AvailabilityInStock || o.Currency != DefaultCurrency {
+			continue
+		}
+		if cheapest == nil || o.PriceAmount < cheapest.PriceAmount {
+			cheapest = o
+		}
+	}
+	return cheapest
+}
+
+// IsPriceDropFrom - 同一通貨で前回より値下がりしたか
+func (o *Offer) IsPriceDropFrom(prev Offer) bool {
+	return prev.ID != 0 && prev.Currency == o.Currency && o.PriceAmount < prev.PriceAmount
+}
+
+// IsBackInStockFrom - 在庫切れから在庫ありに戻ったか
+func (o *Offer) IsBackInStockFrom(prev Offer) bool {
+	return prev.ID != 0 && prev.Availability == AvailabilityOutOfStock && o.Availability == AvailabilityInStock
+}
@@ -0,0 +1,21 @@
+package offer
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrOfferNotFound - 該当するオファーがない
+var ErrOfferNotFound = errors.New("offer not found")
+
+// OfferRepository - ストア販売情報リポジトリインターフェース
+type OfferRepository interface {
+	FindByProductID(productID int64) ([]Offer, error)
+	// FindByProductIDAndStore - 商品・ストアのオファー（なければ ErrOfferNotFound）
+	FindByProductIDAndStore(productID int64, store string) (*Offer, error)
+	// FindByProductIDAndStoreForUpdate - 行ロックして取得（トランザクション内で使い、同時の登録・更新を直列化する。未登録の同時作成は一意制約で防ぐ）
+	FindByProductIDAndStoreForUpdate(productID int64, store string) (*Offer, error)
+	// Save - オファーを保存し、history が指定されていれば同じトランザクションで価格履歴を追加
+	Save(offer *Offer, history *PricePoint) error
+	FindHistory(productID int64, since time.Time) ([]PricePoint, error)
+}
@@ -0,0 +1,65 @@
+package offer
+
+import (
+	"sort"
+	"time"
+)
+
+// 価格トレンドの方向
+const (
+	TrendDown TrendDirection = "down"
+	TrendUp   TrendDirection = "up"
+	TrendFlat TrendDirection = "flat"
+)
+
+// TrendDirection - 価格トレンドの方向
+type TrendDirection string
+
+// DailyPrice - 日ごとの最安値
+type DailyPrice struct {
+	Date        string `json:"date"`
+	PriceAmount int64  `json:"priceAmount"`
+}
+
+// Trend - 価格推移
+type Trend struct {
+	Currency  string         `json:"currency"`
+	Direction TrendDirection `json:"direction"`
+	Points    []DailyPrice   `json:"points"`
+}
+
+// BuildTrend - 価格履歴から基準通貨の日別最安値とトレンド方向を算出
+func BuildTrend(history []PricePoint) Trend {
+	lowest := make(map[string]int64)
+	for _, p := range history {
+		if p.Currency != DefaultCurrency || p.Availability == AvailabilityOutOfStock {
+			continue
+		}
+		day := p.RecordedAt.Format("2006-01-02")
+		if current, ok := lowest[day]; !ok || p.PriceAmount < current {
+			lowest[day] = p.PriceAmount
+		}
+	}
+
+	points := make([]DailyPrice, 0, len(lowest))
+	for day, amount := range lowest {
+		points = append(points, DailyPrice{Date: day, PriceAmount: amount})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Date < points[j].Date })
+
+	direction := TrendFlat
+	if len(points) >= 2 {
+		first, last := points[0].PriceAmount, points[len(points)-1].PriceAmount
+		switch {
+		case last < first:
+			direction = TrendDown
+		case last > first:
+			direction = TrendUp
+		}
+	}
+
+	return Trend{Currency: DefaultCurrency, Direction: direction, Points: points}
+}
+
+// TrendWindow - 価格推移の集計期間
+const TrendWindow = 90 * 24 * time.Hour
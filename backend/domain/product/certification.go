@@ -0,0 +1,90 @@
+package product
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	CertificationNameMaxLength   = 100
+	CertificationIssuerMaxLength = 100
+	CertificateNumberMaxLength   = 100
+)
+
+var (
+	ErrCertificationNameEmpty         = errors.New("certification name is required")
+	ErrCertificationNameTooLong       = errors.New("certification name must be at most 100 characters")
+	ErrCertificationIssuerEmpty       = errors.New("certification issuing body is required")
+	ErrCertificationIssuerTooLong     = errors.New("certification issuing body must be at most 100 characters")
+	ErrCertificateNumberTooLong       = errors.New("certificate number must be at most 100 characters")
+	ErrCertificationExpiryBeforeIssue = errors.New("certification expiry date must be after the issue date")
+)
+
+// Certification - 商品の認証情報（Vegan Society、有機JAS等）
+type Certification struct {
+	ID                int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	ProductID         int64      `json:"productId"`
+	Name              string     `json:"name"`
+	IssuingBody       string     `json:"issuingBody"`
+	CertificateNumber *string    `json:"certificateNumber"`
+	IssuedAt          *time.Time `json:"issuedAt" gorm:"type:date"`
+	ExpiresAt         *time.Time `json:"expiresAt" gorm:"type:date"`
+	IsExpired         bool       `json:"isExpired" gorm:"default:false"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Certification) TableName() string {
+	return "product_certifications"
+}
+
+// NewCertification - Certification を生成（バリデーション付き）
+func NewCertification(name, issuingBody string, certificateNumber *string, issuedAt, expiresAt *time.Time) (*Certification, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrCertificationNameEmpty
+	}
+	if len(name) > CertificationNameMaxLength {
+		return nil, ErrCertificationNameTooLong
+	}
+
+	issuingBody = strings.TrimSpace(issuingBody)
+	if issuingBody == "" {
+		return nil, ErrCertificationIssuerEmpty
+	}
+	if len(issuingBody) > CertificationIssuerMaxLength {
+		return nil, ErrCertificationIssuerTooLong
+	}
+
+	var number *string
+	if certificateNumber != nil {
+		trimmed := strings.TrimSpace(*certificateNumber)
+		if len(trimmed) > CertificateNumberMaxLength {
+			return nil, ErrCertificateNumberTooLong
+		}
+		if trimmed != "" {
+			number = &trimmed
+		}
+	}
+
+	if issuedAt != nil && expiresAt != nil && !expiresAt.After(*issuedAt) {
+		return nil, ErrCertificationExpiryBeforeIssue
+	}
+
+	c := &Certification{
+		Name:              name,
+		IssuingBody:       issuingBody,
+		CertificateNumber: number,
+		IssuedAt:          issuedAt,
+		ExpiresAt:         expiresAt,
+	}
+	c.IsExpired = c.ExpiredAt(time.Now())
+	return c, nil
+}
+
+// ExpiredAt - 指定時刻時点で有効期限切れか
+func (c *Certification) ExpiredAt(now time.Time) bool {
+	return c.ExpiresAt != nil && c.ExpiresAt.Before(now)
+}
@@ -0,0 +1,39 @@
+package product
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ValidateDetails - 商品名・説明（英語・日本語）と画像URLのバリデーション（エラーにはフィールド名を付ける）
+func ValidateDetails(name, nameJa, description, descriptionJa, imageURL string) error {
+	if _, err := NewProductNameEn(name); err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+	if _, err := NewProductNameJa(nameJa); err != nil {
+		return fmt.Errorf("nameJa: %w", err)
+	}
+	if _, err := NewProductDescriptionEn(description); err != nil {
+		return fmt.Errorf("description: %w", err)
+	}
+	if _, err := NewProductDescriptionJa(descriptionJa); err != nil {
+		return fmt.Errorf("descriptionJa: %w", err)
+	}
+	if _, err := NewImageURL(imageURL); err != nil {
+		return fmt.Errorf("imageUrl: %w", err)
+	}
+	return nil
+}
+
+// NormalizeName - 重複判定用に商品名を正規化（小文字化し、空白・記号を除く）
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
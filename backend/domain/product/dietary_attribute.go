@@ -0,0 +1,116 @@
+package product
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 食事制限・原材料に関する属性
+const (
+	DietaryAttributeGlutenFree  DietaryAttribute = "gluten_free"
+	DietaryAttributePalmOilFree DietaryAttribute = "palm_oil_free"
+	DietaryAttributeOrganic     DietaryAttribute = "organic"
+	DietaryAttributeSoyFree     DietaryAttribute = "soy_free"
+	DietaryAttributeNutFree     DietaryAttribute = "nut_free"
+	DietaryAttributeSugarFree   DietaryAttribute = "sugar_free"
+	DietaryAttributeNonGMO      DietaryAttribute = "non_gmo"
+)
+
+var ErrDietaryAttributeInvalid = errors.New("dietary attribute is invalid")
+
+var validDietaryAttributes = map[DietaryAttribute]bool{
+	DietaryAttributeGlutenFree:  true,
+	DietaryAttributePalmOilFree: true,
+	DietaryAttributeOrganic:     true,
+	DietaryAttributeSoyFree:     true,
+	DietaryAttributeNutFree:     true,
+	DietaryAttributeSugarFree:   true,
+	DietaryAttributeNonGMO:      true,
+}
+
+// DietaryAttribute - 食事属性のValue Object（グルテンフリー等）
+type DietaryAttribute string
+
+// NewDietaryAttribute - DietaryAttribute を生成（バリデーション付き）
+func NewDietaryAttribute(value string) (DietaryAttribute, error) {
+	attr := DietaryAttribute(strings.ToLower(strings.TrimSpace(value)))
+	if !validDietaryAttributes[attr] {
+		return "", fmt.Errorf("%w: %s", ErrDietaryAttributeInvalid, value)
+	}
+	return attr, nil
+}
+
+// String - string値を取得
+func (a DietaryAttribute) String() string {
+	return string(a)
+}
+
+// DietaryAttributes - 食事属性の集合（PostgreSQL の TEXT[] に保存）
+type DietaryAttributes []DietaryAttribute
+
+// NewDietaryAttributes - 重複を除いてソートした DietaryAttributes を生成
+func NewDietaryAttributes(values []string) (DietaryAttributes, error) {
+	seen := make(map[DietaryAttribute]bool, len(values))
+	attrs := DietaryAttributes{}
+	for _, v := range values {
+		attr, err := NewDietaryAttribute(v)
+		if err != nil {
+			return nil, err
+		}
+		if seen[attr] {
+			continue
+		}
+		seen[attr] = true
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i] < attrs[j] })
+	return attrs, nil
+}
+
+// Contains - 属性を含むか
+func (a DietaryAttributes) Contains(attr DietaryAttribute) bool {
+	for _, v := range a {
+		if v == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// Value - driver.Valuer 実装（TEXT[] として書き込む）
+func (a DietaryAttributes) Value() (driver.Value, error) {
+	values := make([]string, len(a))
+	for i, v := range a {
+		values[i] = string(v)
+	}
+	return values, nil
+}
+
+// Scan - sql.Scanner 実装（TEXT[] のテキスト表現 "{a,b}" を読み込む）
+func (a *DietaryAttributes) Scan(src interface{}) error {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		*a = DietaryAttributes{}
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into DietaryAttributes", src)
+	}
+
+	raw = strings.TrimSuffix(strings.TrimPrefix(raw, "{"), "}")
+	attrs := DietaryAttributes{}
+	if raw != "" {
+		for _, v := range strings.Split(raw, ",") {
+			attrs = append(attrs, DietaryAttribute(strings.Trim(v, `"`)))
+		}
+	}
+	*a = attrs
+	return nil
+}
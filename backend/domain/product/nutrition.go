@@ -0,0 +1,134 @@
+package product
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// 栄養成分表示の基準
+const (
+	NutritionBasisPer100g    NutritionBasis = "per_100g"
+	NutritionBasisPerServing NutritionBasis = "per_serving"
+)
+
+const (
+	// ServingSizeMaxGrams - 1食あたり量の上限
+	ServingSizeMaxGrams = 2000
+	// MaxKcalPerGram - 脂質のエネルギー換算係数（1gあたりの最大エネルギー）
+	MaxKcalPerGram = 9
+	// ProteinKcalPerGram - たんぱく質のエネルギー換算係数
+	ProteinKcalPerGram = 4
+	// HighProteinEnergyRatio - 高たんぱくとみなすエネルギー比率（たんぱく質由来が20%以上）
+	HighProteinEnergyRatio = 0.2
+)
+
+var (
+	ErrNutritionBasisInvalid     = errors.New("nutrition basis must be per_100g or per_serving")
+	ErrServingSizeRequired       = errors.New("serving size is required when nutrition basis is per_serving")
+	ErrServingSizeInvalid        = errors.New("serving size must be between 0 and 2000 grams")
+	ErrNutritionValueNegative    = errors.New("nutrition values must not be negative")
+	ErrNutritionExceedsReference = errors.New("total of protein, fat, carbohydrate and salt exceeds the reference amount")
+	ErrNutritionCaloriesTooHigh  = errors.New("calories exceed the maximum possible for the reference amount")
+)
+
+// NutritionBasis - 栄養成分表示の基準（100gあたり / 1食あたり）
+type NutritionBasis string
+
+// NewNutritionBasis - NutritionBasis を生成（バリデーション付き）
+func NewNutritionBasis(value string) (NutritionBasis, error) {
+	basis := NutritionBasis(value)
+	if basis != NutritionBasisPer100g && basis != NutritionBasisPerServing {
+		return "", ErrNutritionBasisInvalid
+	}
+	return basis, nil
+}
+
+// Nutrition - 栄養成分のValue Object（エネルギーはkcal、その他はg）
+type Nutrition struct {
+	ProductID         int64          `json:"-" gorm:"primaryKey"`
+	Basis             NutritionBasis `json:"basis"`
+	ServingSizeGrams  *float64       `json:"servingSizeGrams"`
+	CaloriesKcal      float64        `json:"caloriesKcal"`
+	ProteinGrams      float64        `json:"proteinGrams"`
+	FatGrams          float64        `json:"fatGrams"`
+	CarbohydrateGrams float64        `json:"carbohydrateGrams"`
+	SaltGrams         float64        `json:"saltGrams"`
+	// 並び替え・絞り込み用に100gあたりへ換算した値（DBの生成列）
+	CaloriesPer100g float64   `json:"caloriesPer100g" gorm:"->"`
+	ProteinPer100g  float64   `json:"proteinPer100g" gorm:"->"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Nutrition) TableName() string {
+	return "product_nutrition"
+}
+
+// NewNutrition - Nutrition を生成（基準量に応じたバリデーション付き）
+func NewNutrition(basisValue string, servingSizeGrams *float64, calories, protein, fat, carbohydrate, salt float64) (*Nutrition, error) {
+	basis, err := NewNutritionBasis(basisValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if servingSizeGrams != nil && !isValidServingSize(*servingSizeGrams) {
+		return nil, ErrServingSizeInvalid
+	}
+	if basis == NutritionBasisPerServing && servingSizeGrams == nil {
+		return nil, ErrServingSizeRequired
+	}
+
+	for _, v := range []float64{calories, protein, fat, carbohydrate, salt} {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, ErrNutritionValueNegative
+		}
+	}
+
+	n := &Nutrition{
+		Basis:             basis,
+		ServingSizeGrams:  servingSizeGrams,
+		CaloriesKcal:      calories,
+		ProteinGrams:      protein,
+		FatGrams:          fat,
+		CarbohydrateGrams: carbohydrate,
+		SaltGrams:         salt,
+	}
+
+	reference := n.referenceGrams()
+	if protein+fat+carbohydrate+salt > reference {
+		return nil, ErrNutritionExceedsReference
+	}
+	if calories > reference*MaxKcalPerGram {
+		return nil, ErrNutritionCaloriesTooHigh
+	}
+
+	n.CaloriesPer100g = n.per100g(calories)
+	n.ProteinPer100g = n.per100g(protein)
+	return n, nil
+}
+
+// IsHighProtein - たんぱく質由来のエネルギーが20%以上か
+func (n *Nutrition) IsHighProtein() bool {
+	if n.CaloriesKcal <= 0 {
+		return false
+	}
+	return n.ProteinGrams*ProteinKcalPerGram >= n.CaloriesKcal*HighProteinEnergyRatio
+}
+
+// referenceGrams - 表示基準量（g）
+func (n *Nutrition) referenceGrams() float64 {
+	if n.Basis == NutritionBasisPerServing {
+		return *n.ServingSizeGrams
+	}
+	return 100
+}
+
+// per100g - 100gあたりに換算
+func (n *Nutrition) per100g(value float64) float64 {
+	return value * 100 / n.referenceGrams()
+}
+
+func isValidServingSize(grams float64) bool {
+	return grams > 0 && grams <= ServingSizeMaxGrams
+}
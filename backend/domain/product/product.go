@@ -15,21 +15,35 @@ type Category struct {
 
 // Product - 商品
 type Product struct {
-	ID               int64      `json:"id" gorm:"primaryKey;autoIncrement"`
-	Categories       []Category `json:"categories" gorm:"many2many:product_categories;"`
-	Name             string     `json:"name"`
-	NameJa           string     `json:"nameJa"`
-	Description      string     `json:"description"`
-	DescriptionJa    string     `json:"descriptionJa"`
-	ImageURL         string     `json:"imageUrl" gorm:"column:image_url"`
-	AffiliateURL     *string    `json:"affiliateUrl" gorm:"column:affiliate_url"`
-	AmazonURL        *string    `json:"amazonUrl" gorm:"column:amazon_url"`
-	RakutenURL       *string    `json:"rakutenUrl" gorm:"column:rakuten_url"`
-	YahooURL         *string    `json:"yahooUrl" gorm:"column:yahoo_url"`
-	Rating           float64    `json:"rating" gorm:"default:0"`
-	ReviewCount      int        `json:"reviewCount" gorm:"default:0"`
-	CreatedByAdminID *int64     `json:"createdByAdminId"`
-	UpdatedByAdminID *int64     `json:"updatedByAdminId"`
-	CreatedAt        time.Time  `json:"createdAt"`
-	UpdatedAt        time.Time  `json:"updatedAt"`
+	ID                int64             `json:"id" gorm:"primaryKey;autoIncrement"`
+	Categories        []Category        `json:"categories" gorm:"many2many:product_categories;"`
+	Name              string            `json:"name"`
+	NameJa            string            `json:"nameJa"`
+	NormalizedName    *string           `json:"-"` // 重複判定用（NormalizeName、保存時にリポジトリが設定）
+	NormalizedNameJa  *string           `json:"-"`
+	Description       string            `json:"description"`
+	DescriptionJa     string            `json:"descriptionJa"`
+	ImageURL          string            `json:"imageUrl" gorm:"column:image_url"`
+	AffiliateURL      *string           `json:"affiliateUrl" gorm:"column:affiliate_url"`
+	StoreLinks        []StoreLink       `json:"storeLinks" gorm:"foreignKey:ProductID"`
+	AmazonURL         *string           `json:"amazonUrl" gorm:"-"` // 互換用: StoreLinks からの射影（ProjectLegacyURLs）
+	RakutenURL        *string           `json:"rakutenUrl" gorm:"-"`
+	YahooURL          *string           `json:"yahooUrl" gorm:"-"`
+	DietaryAttributes DietaryAttributes `json:"dietaryAttributes" gorm:"type:text[]"`
+	Certifications    []Certification   `json:"certifications" gorm:"foreignKey:ProductID"`
+	Nutrition         *Nutrition        `json:"nutrition" gorm:"foreignKey:ProductID"`
+	Rating            float64           `json:"rating" gorm:"default:0"`
+	ReviewCount       int               `json:"reviewCount" gorm:"default:0"`
+	CreatedByAdminID  *int64            `json:"createdByAdminId"`
+	UpdatedByAdminID  *int64            `json:"updatedByAdminId"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
+}
+
+// DisplayName - 表示用の商品名（日本語名を優先）
+func (p *Product) DisplayName() string {
+	if p.NameJa != "" {
+		return p.NameJa
+	}
+	return p.Name
 }
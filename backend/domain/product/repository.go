@@ -1,9 +1,59 @@
 package product
 
+import (
+	"errors"
+	"time"
+)
+
+// 商品一覧の並び順
+const (
+	ProductSortNewest   ProductSort = "newest"
+	ProductSortRating   ProductSort = "rating"
+	ProductSortProtein  ProductSort = "protein"
+	ProductSortCalories ProductSort = "calories"
+)
+
+var (
+	ErrProductSortInvalid = errors.New("sort must be one of newest, rating, protein, calories")
+	// ErrProductNotFound - 該当する商品がない
+	ErrProductNotFound = errors.New("product not found")
+)
+
+// ProductSort - 商品一覧の並び順
+type ProductSort string
+
+// NewProductSort - ProductSort を生成（空文字は新着順）
+func NewProductSort(value string) (ProductSort, error) {
+	switch ProductSort(value) {
+	case "", ProductSortNewest:
+		return ProductSortNewest, nil
+	case ProductSortRating, ProductSortProtein, ProductSortCalories:
+		return ProductSort(value), nil
+	}
+	return "", ErrProductSortInvalid
+}
+
+// ProductFilter - 商品一覧の絞り込み条件
+type ProductFilter struct {
+	IDs               []int64 // 指定したIDの商品のみ（空なら絞り込まない）
+	CategoryID        int64
+	Search            string
+	DietaryAttributes DietaryAttributes
+	CertifiedOnly     bool
+	// 栄養成分（100gあたり換算）による絞り込み
+	HighProtein        bool
+	MinProteinPer100g  *float64
+	MaxCaloriesPer100g *float64
+	Sort               ProductSort
+}
+
 // ProductRepository - 商品リポジトリインターフェース
 type ProductRepository interface {
-	FindAll(categoryID int64, search string) ([]Product, error)
+	FindAll(filter ProductFilter) ([]Product, error)
+	// FindByID - 商品（なければ ErrProductNotFound）
 	FindByID(id int64) (*Product, error)
+	// FindByIDForUpdate - 行ロックして取得（トランザクション内で使い、同時の更新を直列化する）
+	FindByIDForUpdate(id int64) (*Product, error)
 	Create(product *Product) error
 	Update(product *Product) error
 	Delete(id int64) error
@@ -18,3 +68,22 @@ type CategoryRepository interface {
 	Update(category *Category) error
 	Delete(id int64) error
 }
+
+// CertificationRepository - 商品認証リポジトリインターフェース
+type CertificationRepository interface {
+	FindExpired() ([]Certification, error)
+	MarkExpired(now time.Time) (int64, error)
+}
+
+// StoreRepository - ストアリポジトリインターフェース
+type StoreRepository interface {
+	FindAll(activeOnly bool) ([]Store, error)
+	FindByID(id int64) (*Store, error)
+	FindByCode(code string) (*Store, error)
+	Create(store *Store) error
+	Update(store *Store) error
+	Delete(id int64) error
+	CountProductLinks(storeID int64) (int64, error)
+	// CountOffers - ストアの価格情報（retailer_offers）の件数
+	CountOffers(code string) (int64, error)
+}
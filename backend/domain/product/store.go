@@ -0,0 +1,124 @@
+package product
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// 移行前から存在するストアのコード（products の旧 *_url カラムに対応）
+const (
+	StoreAmazon  = "amazon"
+	StoreRakuten = "rakuten"
+	StoreYahoo   = "yahoo"
+)
+
+const (
+	StoreNameMaxLength = 100
+)
+
+var (
+	ErrStoreCodeInvalid   = errors.New("store code must be 2-50 characters of lowercase letters, digits or underscores")
+	ErrStoreNameEmpty     = errors.New("store name is required")
+	ErrStoreNameTooLong   = errors.New("store name must be at most 100 characters")
+	ErrStoreLinkDuplicate = errors.New("store link is duplicated")
+
+	storeCodePattern = regexp.MustCompile(`^[a-z0-9_]{2,50}$`)
+)
+
+// Store - ストア（マーケットプレイス）マスタ
+type Store struct {
+	ID               int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	Code             string    `json:"code" gorm:"uniqueIndex"`
+	Name             string    `json:"name"`
+	NameJa           string    `json:"nameJa"`
+	DisplayOrder     int       `json:"displayOrder" gorm:"default:0"`
+	IsActive         bool      `json:"isActive" gorm:"default:true"`
+	CreatedByAdminID *int64    `json:"createdByAdminId"`
+	UpdatedByAdminID *int64    `json:"updatedByAdminId"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// NewStoreCode - ストアコードを検証（小文字に正規化）
+func NewStoreCode(value string) (string, error) {
+	code := strings.ToLower(strings.TrimSpace(value))
+	if !storeCodePattern.MatchString(code) {
+		return "", ErrStoreCodeInvalid
+	}
+	return code, nil
+}
+
+// NewStoreName - ストア名を検証
+func NewStoreName(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrStoreNameEmpty
+	}
+	if len(trimmed) > StoreNameMaxLength {
+		return "", ErrStoreNameTooLong
+	}
+	return trimmed, nil
+}
+
+// NewStoreLinkURL - ストアリンクのURLを検証（必須）
+func NewStoreLinkURL(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrURLEmpty
+	}
+	if !isValidURL(trimmed) {
+		return "", ErrURLInvalid
+	}
+	return trimmed, nil
+}
+
+// StoreLink - 商品とストアの販売ページリンク
+type StoreLink struct {
+	ProductID int64     `json:"productId" gorm:"primaryKey"`
+	StoreID   int64     `json:"storeId" gorm:"primaryKey"`
+	Store     *Store    `json:"store,omitempty" gorm:"foreignKey:StoreID"`
+	URL       string    `json:"url" gorm:"column:url"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (StoreLink) TableName() string {
+	return "product_store_links"
+}
+
+// StoreURL - ストアコードに対応する商品リンクを取得（未登録ならnil）
+func (p *Product) StoreURL(code string) *string {
+	for _, link := range p.StoreLinks {
+		if link.Store != nil && link.Store.Code == code {
+			url := link.URL
+			return &url
+		}
+	}
+	return nil
+}
+
+// ProjectLegacyURLs - StoreLinks から旧フィールド（amazonUrl 等）を埋める（互換用）
+func (p *Product) ProjectLegacyURLs() {
+	p.AmazonURL = p.StoreURL(StoreAmazon)
+	p.RakutenURL = p.StoreURL(StoreRakuten)
+	p.YahooURL = p.StoreURL(StoreYahoo)
+}
+
+// StoreDisplayName - 表示用のストア名（マスタ未読込ならストアコード）
+func (p *Product) StoreDisplayName(code string) string {
+	for _, link := range p.StoreLinks {
+		if link.Store == nil || link.Store.Code != code {
+			continue
+		}
+		if link.Store.NameJa != "" {
+			return link.Store.NameJa
+		}
+		if link.Store.Name != "" {
+			return link.Store.Name
+		}
+	}
+	return code
+}
@@ -0,0 +1,186 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// アフィリエイトの中継ドメイン
+const (
+	moshimoHost          = "af.moshimo.com"
+	rakutenAffiliateHost = "hb.afl.rakuten.co.jp"
+	valueCommerceHost    = "ck.jp.ap.valuecommerce.com"
+)
+
+var (
+	ErrStoreURLHostMismatch = errors.New("URL host does not match the store")
+	ErrStoreURLShortLink    = errors.New("short links cannot carry the affiliate tag; use the full product URL")
+	ErrAffiliateWrapperURL  = errors.New("affiliate link does not contain a valid destination URL")
+
+	amazonASINPattern = regexp.MustCompile(`/(?:dp|gp/product|gp/aw/d)/([A-Z0-9]{10})(?:[/?]|$)`)
+)
+
+// storeURLRule - ストアごとのURL検証・正規化ルール
+type storeURLRule struct {
+	hosts      []string
+	shortHosts []string
+	// wrapperParams - 中継ドメインごとの遷移先URLパラメータ名
+	wrapperParams map[string]string
+	// normalize - 遷移先URLを正規化してアフィリエイトIDを付与（id が空なら付与しない）
+	normalize func(u *url.URL, id string) string
+	// wrap - アフィリエイト中継URLを生成（nil なら中継しない）
+	wrap func(target, id string) string
+}
+
+var storeURLRules = map[string]storeURLRule{
+	StoreAmazon: {
+		hosts:         []string{"amazon.co.jp", "amazon.com"},
+		shortHosts:    []string{"amzn.to", "amzn.asia"},
+		wrapperParams: map[string]string{moshimoHost: "url"},
+		normalize:     normalizeAmazonURL,
+	},
+	StoreRakuten: {
+		hosts:         []string{"rakuten.co.jp"},
+		wrapperParams: map[string]string{moshimoHost: "url", rakutenAffiliateHost: "pc"},
+		normalize:     stripQuery,
+		wrap: func(target, id string) string {
+			return fmt.Sprintf("https://%s/hgc/%s/?pc=%s", rakutenAffiliateHost, url.PathEscape(id), url.QueryEscape(target))
+		},
+	},
+	StoreYahoo: {
+		hosts:         []string{"shopping.yahoo.co.jp", "paypaymall.yahoo.co.jp"},
+		wrapperParams: map[string]string{moshimoHost: "url", valueCommerceHost: "vc_url"},
+		normalize:     stripQuery,
+	},
+}
+
+// StoreURLRules - ストアURLの検証・正規化とアフィリエイトID付与
+type StoreURLRules struct {
+	affiliateIDs map[string]string
+}
+
+// NewStoreURLRules - ストアコードごとのアフィリエイトIDから StoreURLRules を生成
+// Amazon はアソシエイトタグ（tag=）、楽天はアフィリエイトID（hb.afl.rakuten.co.jp で中継）
+func NewStoreURLRules(affiliateIDs map[string]string) StoreURLRules {
+	ids := make(map[string]string, len(affiliateIDs))
+	for code, id := range affiliateIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			ids[code] = id
+		}
+	}
+	return StoreURLRules{affiliateIDs: ids}
+}
+
+// Normalize - ストアに応じてURLを検証・正規化し、設定されたアフィリエイトIDを付与
+// ルールのないストアはそのまま返す。もしもアフィリエイト等の中継URLは遷移先のみ検証して維持する
+func (r StoreURLRules) Normalize(storeCode, rawURL string) (string, error) {
+	rule, ok := storeURLRules[storeCode]
+	if !ok {
+		return rawURL, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ErrURLInvalid
+	}
+	host := strings.ToLower(u.Hostname())
+	id := r.affiliateIDs[storeCode]
+
+	if param, ok := rule.wrapperParams[host]; ok {
+		target, err := url.Parse(u.Query().Get(param))
+		if err != nil || !isValidURL(target.String()) {
+			return "", ErrAffiliateWrapperURL
+		}
+		if !rule.matchesHost(target.Hostname()) {
+			return "", ErrStoreURLHostMismatch
+		}
+		// 自前のIDで中継できる場合は付け替える（誤ったIDの混入を防ぐ）
+		if host != moshimoHost && rule.wrap != nil && id != "" {
+			return rule.wrap(rule.normalize(target, ""), id), nil
+		}
+		return rawURL, nil
+	}
+
+	for _, short := range rule.shortHosts {
+		if host == short {
+			return "", ErrStoreURLShortLink
+		}
+	}
+	if !rule.matchesHost(host) {
+		return "", ErrStoreURLHostMismatch
+	}
+
+	if rule.wrap != nil {
+		target := rule.normalize(u, "")
+		if id == "" {
+			return target, nil
+		}
+		return rule.wrap(target, id), nil
+	}
+	return rule.normalize(u, id), nil
+}
+
+// StoreCodeFor - URL（中継URLは遷移先）がルールのあるストアのドメインならそのストアコード
+func (r StoreURLRules) StoreCodeFor(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	host := strings.ToLower(u.Hostname())
+	for code, rule := range storeURLRules {
+		if param, ok := rule.wrapperParams[host]; ok {
+			if target, err := url.Parse(u.Query().Get(param)); err == nil && rule.matchesHost(target.Hostname()) {
+				return code, true
+			}
+			continue
+		}
+		if rule.matchesHost(host) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// matchesHost - ホストがストアのドメイン（またはそのサブドメイン）か
+func (rule storeURLRule) matchesHost(host string) bool {
+	host = strings.ToLower(host)
+	for _, h := range rule.hosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeAmazonURL - ASINがあれば /dp/ASIN 形式に短縮し、tag を付与
+func normalizeAmazonURL(u *url.URL, tag string) string {
+	host := strings.ToLower(u.Hostname())
+	if !strings.HasPrefix(host, "www.") {
+		host = "www." + host
+	}
+
+	path := u.Path
+	if m := amazonASINPattern.FindStringSubmatch(u.Path); m != nil {
+		path = "/dp/" + m[1]
+	}
+
+	query := url.Values{}
+	if tag == "" {
+		tag = u.Query().Get("tag")
+	}
+	if tag != "" {
+		query.Set("tag", tag)
+	}
+
+	normalized := url.URL{Scheme: "https", Host: host, Path: path, RawQuery: query.Encode()}
+	return normalized.String()
+}
+
+// stripQuery - クエリ・フラグメント（トラッキング用パラメータ）を除いてhttpsに揃える
+func stripQuery(u *url.URL, _ string) string {
+	normalized := url.URL{Scheme: "https", Host: strings.ToLower(u.Host), Path: u.Path, RawPath: u.RawPath}
+	return normalized.String()
+}
@@ -0,0 +1,156 @@
+package question
+
+import (
+	"backend/domain/customer"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	QuestionBodyMaxLength = 500
+	AnswerBodyMaxLength   = 1000
+)
+
+var (
+	ErrBodyEmpty             = errors.New("body is required")
+	ErrQuestionBodyTooLong   = fmt.Errorf("question must be at most %d characters", QuestionBodyMaxLength)
+	ErrAnswerBodyTooLong     = fmt.Errorf("answer must be at most %d characters", AnswerBodyMaxLength)
+	ErrNotAsker              = errors.New("only the asker can accept an answer")
+	ErrAnswerMismatch        = errors.New("answer belongs to another question")
+	ErrCannotUpvoteOwnAnswer = errors.New("you cannot upvote your own answer")
+)
+
+// Body - 質問・回答の本文のValue Object
+type Body string
+
+// NewQuestionBody - 質問の本文を生成（バリデーション付き）
+func NewQuestionBody(value string) (Body, error) {
+	return newBody(value, QuestionBodyMaxLength, ErrQuestionBodyTooLong)
+}
+
+// NewAnswerBody - 回答の本文を生成（バリデーション付き）
+func NewAnswerBody(value string) (Body, error) {
+	return newBody(value, AnswerBodyMaxLength, ErrAnswerBodyTooLong)
+}
+
+func newBody(value string, maxLength int, errTooLong error) (Body, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrBodyEmpty
+	}
+	if utf8.RuneCountInString(trimmed) > maxLength {
+		return "", errTooLong
+	}
+	return Body(trimmed), nil
+}
+
+// Question - 商品への質問
+type Question struct {
+	ID               int64              `json:"id"`
+	ProductID        int64              `json:"productId"`
+	CustomerID       int64              `json:"customerId"`
+	Author           *customer.Reviewer `json:"author,omitempty"`
+	Body             Body               `json:"body"`
+	AcceptedAnswerID *int64             `json:"acceptedAnswerId"`
+	AnswerCount      int                `json:"answerCount"`
+	Answers          []Answer           `json:"answers,omitempty"` // 詳細取得時のみ（採用された回答、賛成数の多い順）
+	CreatedAt        time.Time          `json:"createdAt"`
+}
+
+// NewQuestion - 質問を生成
+func NewQuestion(productID, customerID int64, body Body) *Question {
+	return &Question{ProductID: productID, CustomerID: customerID, Body: body}
+}
+
+// IsAskedBy - customerID のカスタマーの質問か
+func (q *Question) IsAskedBy(customerID int64) bool {
+	return q.CustomerID == customerID
+}
+
+// AcceptAnswer - 質問者が回答を採用（answer が nil なら採用を取り消す）
+func (q *Question) AcceptAnswer(customerID int64, answer *Answer) error {
+	if !q.IsAskedBy(customerID) {
+		return ErrNotAsker
+	}
+	if answer == nil {
+		q.AcceptedAnswerID = nil
+		return nil
+	}
+	if answer.QuestionID != q.ID {
+		return ErrAnswerMismatch
+	}
+	q.AcceptedAnswerID = &answer.ID
+	return nil
+}
+
+// RankAnswers - 回答を採用された回答、賛成数の多い順、古い順に並べて IsAccepted を設定
+func (q *Question) RankAnswers(answers []Answer) []Answer {
+	for i := range answers {
+		answers[i].IsAccepted = q.AcceptedAnswerID != nil && answers[i].ID == *q.AcceptedAnswerID
+	}
+	sort.SliceStable(answers, func(i, j int) bool {
+		a, b := answers[i], answers[j]
+		if a.IsAccepted != b.IsAccepted {
+			return a.IsAccepted
+		}
+		if a.UpvoteCount != b.UpvoteCount {
+			return a.UpvoteCount > b.UpvoteCount
+		}
+		return a.ID < b.ID
+	})
+	return answers
+}
+
+// Answer - 質問への回答（カスタマーの回答、または管理者による公式回答）
+type Answer struct {
+	ID          int64              `json:"id"`
+	QuestionID  int64              `json:"questionId"`
+	CustomerID  *int64             `json:"customerId,omitempty"`
+	AdminID     *int64             `json:"-"`
+	IsOfficial  bool               `json:"isOfficial"` // ブランド・運営の公式回答（管理者が投稿）
+	Author      *customer.Reviewer `json:"author,omitempty"`
+	Body        Body               `json:"body"`
+	UpvoteCount int                `json:"upvoteCount"`
+	IsAccepted  bool               `json:"isAccepted"`
+	HasUpvoted  bool               `json:"hasUpvoted"` // 閲覧者が賛成済みか（未ログインなら false）
+	CreatedAt   time.Time          `json:"createdAt"`
+}
+
+// NewCustomerAnswer - カスタマーの回答を生成
+func NewCustomerAnswer(questionID, customerID int64, body Body) *Answer {
+	return &Answer{QuestionID: questionID, CustomerID: &customerID, Body: body}
+}
+
+// NewOfficialAnswer - 管理者による公式回答を生成
+func NewOfficialAnswer(questionID, adminID int64, body Body) *Answer {
+	return &Answer{QuestionID: questionID, AdminID: &adminID, IsOfficial: true, Body: body}
+}
+
+// IsWrittenBy - customerID のカスタマーが書いた回答か
+func (a *Answer) IsWrittenBy(customerID int64) bool {
+	return a.CustomerID != nil && *a.CustomerID == customerID
+}
+
+// NewUpvote - 回答への賛成を生成（自分の回答には賛成できない）
+func NewUpvote(a *Answer, customerID int64, now time.Time) (*Upvote, error) {
+	if a.IsWrittenBy(customerID) {
+		return nil, ErrCannotUpvoteOwnAnswer
+	}
+	return &Upvote{AnswerID: a.ID, CustomerID: customerID, CreatedAt: now}, nil
+}
+
+// Upvote - 回答への賛成（1人1回）
+type Upvote struct {
+	AnswerID   int64     `json:"answerId" gorm:"primaryKey"`
+	CustomerID int64     `json:"customerId" gorm:"primaryKey"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (Upvote) TableName() string {
+	return "answer_upvotes"
+}
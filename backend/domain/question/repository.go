@@ -0,0 +1,37 @@
+package question
+
+import "errors"
+
+var (
+	// ErrQuestionNotFound - 該当する質問がない
+	ErrQuestionNotFound = errors.New("question not found")
+	// ErrAnswerNotFound - 該当する回答がない
+	ErrAnswerNotFound = errors.New("answer not found")
+)
+
+// QuestionRepository - 商品への質問リポジトリインターフェース
+type QuestionRepository interface {
+	// FindByProductID - 商品への質問（新しい順、回答数付き）
+	FindByProductID(productID int64) ([]Question, error)
+	// FindByID - 該当する質問がなければ ErrQuestionNotFound
+	FindByID(id int64) (*Question, error)
+	Create(q *Question) error
+	// SetAcceptedAnswer - 採用された回答を更新（answerID が nil なら取り消し）
+	SetAcceptedAnswer(questionID int64, answerID *int64) error
+	// Delete - 質問を削除（回答と賛成も削除される）
+	Delete(id int64) error
+}
+
+// AnswerRepository - 質問への回答リポジトリインターフェース
+type AnswerRepository interface {
+	// FindByQuestionID - 質問への回答（賛成数付き、viewerID が賛成済みかを設定。0 なら未ログイン）
+	FindByQuestionID(questionID, viewerID int64) ([]Answer, error)
+	// FindByID - 該当する回答がなければ ErrAnswerNotFound
+	FindByID(id int64) (*Answer, error)
+	Create(a *Answer) error
+	Delete(id int64) error
+	// Upvote - 賛成を記録（賛成済みなら何もしない）
+	Upvote(u *Upvote) error
+	// RemoveUpvote - 賛成を取り消す（賛成していなければ何もしない）
+	RemoveUpvote(answerID, customerID int64) error
+}
@@ -0,0 +1,166 @@
+package ranking
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ランキングの種類
+const (
+	TypeTrending Type = "trending"
+	TypeTop      Type = "top"
+)
+
+const (
+	// TrendingWindow - 「今週のトレンド」の集計期間
+	TrendingWindow = 7 * 24 * time.Hour
+	// TopWindow - 「高評価」の集計期間
+	TopWindow = 365 * 24 * time.Hour
+	// MaxEntries - ランキングごとに保存する件数
+	MaxEntries = 20
+	// AllCategories - 全カテゴリのランキングを表す CategoryID
+	AllCategories int64 = 0
+
+	// trendingReviewWeight - トレンドスコアにおけるレビュー1件の重み（お気に入り1件 = 1）
+	trendingReviewWeight = 2
+	// topPriorReviews - 高評価ランキングのベイズ平均で加える仮想レビュー数（少数レビューの満点を抑える）
+	topPriorReviews = 5
+)
+
+var ErrTypeInvalid = errors.New("ranking type must be trending or top")
+
+// Type - ランキングの種類
+type Type string
+
+// NewType - Type を生成（空文字はトレンド）
+func NewType(value string) (Type, error) {
+	switch Type(value) {
+	case "", TypeTrending:
+		return TypeTrending, nil
+	case TypeTop:
+		return TypeTop, nil
+	}
+	return "", ErrTypeInvalid
+}
+
+// Activity - 集計期間内の商品ごとの反応
+type Activity struct {
+	ProductID int64
+	Reviews   int
+	RatingSum int
+	Favorites int
+}
+
+// Entry - 集計済みランキングの1行
+type Entry struct {
+	Type       Type      `json:"type" gorm:"primaryKey"`
+	CategoryID int64     `json:"categoryId" gorm:"primaryKey"`
+	Rank       int       `json:"rank" gorm:"primaryKey"`
+	ProductID  int64     `json:"productId"`
+	Score      float64   `json:"score"`
+	ComputedAt time.Time `json:"computedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Entry) TableName() string {
+	return "product_rankings"
+}
+
+// Build - 反応と商品のカテゴリから全体・カテゴリ別のランキングを生成
+func Build(t Type, activities []Activity, productCategories map[int64][]int64, now time.Time) []Entry {
+	scores := score(t, activities, productCategories)
+
+	byCategory := map[int64]map[int64]float64{AllCategories: scores}
+	for productID, s := range scores {
+		for _, categoryID := range productCategories[productID] {
+			if byCategory[categoryID] == nil {
+				byCategory[categoryID] = make(map[int64]float64)
+			}
+			byCategory[categoryID][productID] = s
+		}
+	}
+
+	categoryIDs := make([]int64, 0, len(byCategory))
+	for id := range byCategory {
+		categoryIDs = append(categoryIDs, id)
+	}
+	sort.Slice(categoryIDs, func(i, j int) bool { return categoryIDs[i] < categoryIDs[j] })
+
+	var entries []Entry
+	for _, categoryID := range categoryIDs {
+		for i, productID := range top(byCategory[categoryID], MaxEntries) {
+			entries = append(entries, Entry{
+				Type:       t,
+				CategoryID: categoryID,
+				Rank:       i + 1,
+				ProductID:  productID,
+				Score:      byCategory[categoryID][productID],
+				ComputedAt: now,
+			})
+		}
+	}
+	return entries
+}
+
+// score - 種類ごとのスコア（反応のない商品・削除済み商品は対象外）
+func score(t Type, activities []Activity, productCategories map[int64][]int64) map[int64]float64 {
+	scores := make(map[int64]float64)
+	var totalReviews, totalRating int
+	for _, a := range activities {
+		totalReviews += a.Reviews
+		totalRating += a.RatingSum
+	}
+
+	for _, a := range activities {
+		if _, ok := productCategories[a.ProductID]; !ok {
+			continue
+		}
+		switch t {
+		case TypeTrending:
+			// レビュー数は評価（5段階）で重み付けし、低評価の炎上で上位に来ないようにする
+			s := float64(a.Favorites)
+			if a.Reviews > 0 {
+				avg := float64(a.RatingSum) / float64(a.Reviews)
+				s += trendingReviewWeight * float64(a.Reviews) * avg / 5
+			}
+			if s > 0 {
+				scores[a.ProductID] = s
+			}
+		case TypeTop:
+			if a.Reviews == 0 {
+				continue
+			}
+			// ベイズ平均: 全体平均を仮想レビューとして加える
+			mean := float64(totalRating) / float64(totalReviews)
+			scores[a.ProductID] = (mean*topPriorReviews + float64(a.RatingSum)) / float64(topPriorReviews+a.Reviews)
+		}
+	}
+	return scores
+}
+
+// top - スコアの高い順に上位 limit 件の商品ID（同点はID昇順）
+func top(scores map[int64]float64, limit int) []int64 {
+	ids := make([]int64, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+// Window - ランキングの集計期間
+func (t Type) Window() time.Duration {
+	if t == TypeTop {
+		return TopWindow
+	}
+	return TrendingWindow
+}
@@ -0,0 +1,12 @@
+package ranking
+
+import "time"
+
+// RankingRepository - ランキングリポジトリインターフェース
+type RankingRepository interface {
+	// FindActivity - since 以降のレビュー・お気に入り登録を商品ごとに集計
+	FindActivity(since time.Time) ([]Activity, error)
+	// Replace - 指定種類のランキングを全件入れ替え
+	Replace(t Type, entries []Entry) error
+	Find(t Type, categoryID int64) ([]Entry, error)
+}
@@ -0,0 +1,249 @@
+package recommendation
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// 推薦理由
+const (
+	ReasonSimilar Reason = "similar" // お気に入り・高評価の共起
+	ReasonPopular Reason = "popular" // カテゴリ内の人気
+)
+
+const (
+	// HighRatingThreshold - 推薦のシグナルとして扱うレビュー評価の下限
+	HighRatingThreshold = 4
+	// MaxSimilarProducts - 1商品あたりに保存する類似商品数
+	MaxSimilarProducts = 20
+	// MaxRecommendations - 1顧客あたりに保存する推薦商品数
+	MaxRecommendations = 30
+)
+
+// Reason - 推薦理由
+type Reason string
+
+// Interaction - 顧客が商品に好意を示したシグナル（お気に入り登録 / 高評価レビュー）
+type Interaction struct {
+	CustomerID int64
+	ProductID  int64
+}
+
+// CatalogItem - 推薦計算に使う商品情報
+type CatalogItem struct {
+	ProductID   int64
+	CategoryIDs []int64
+	Rating      float64
+}
+
+// SimilarProduct - 商品間の類似度（オフライン計算結果）
+type SimilarProduct struct {
+	ProductID        int64     `json:"productId" gorm:"primaryKey"`
+	SimilarProductID int64     `json:"similarProductId" gorm:"primaryKey"`
+	Rank             int       `json:"rank"`
+	Score            float64   `json:"score"`
+	Reason           Reason    `json:"reason"`
+	ComputedAt       time.Time `json:"computedAt"`
+}
+
+// TableName - GORMテーブル名
+func (SimilarProduct) TableName() string {
+	return "product_similarities"
+}
+
+// Recommendation - 顧客ごとの推薦商品（オフライン計算結果）
+type Recommendation struct {
+	CustomerID int64     `json:"customerId" gorm:"primaryKey"`
+	ProductID  int64     `json:"productId" gorm:"primaryKey"`
+	Rank       int       `json:"rank"`
+	Score      float64   `json:"score"`
+	Reason     Reason    `json:"reason"`
+	ComputedAt time.Time `json:"computedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Recommendation) TableName() string {
+	return "customer_recommendations"
+}
+
+// Model - 推薦の計算モデル
+type Model struct {
+	catalog    map[int64]CatalogItem
+	byCustomer map[int64]map[int64]bool
+	byProduct  map[int64]map[int64]bool
+	popularity map[int64]float64
+	now        time.Time
+}
+
+// NewModel - シグナルと商品情報から計算モデルを構築（存在しない商品へのシグナルは無視）
+func NewModel(interactions []Interaction, catalog []CatalogItem, now time.Time) *Model {
+	m := &Model{
+		catalog:    make(map[int64]CatalogItem, len(catalog)),
+		byCustomer: make(map[int64]map[int64]bool),
+		byProduct:  make(map[int64]map[int64]bool),
+		popularity: make(map[int64]float64, len(catalog)),
+		now:        now,
+	}
+	for _, item := range catalog {
+		m.catalog[item.ProductID] = item
+	}
+	for _, in := range interactions {
+		if _, ok := m.catalog[in.ProductID]; !ok {
+			continue
+		}
+		if m.byCustomer[in.CustomerID] == nil {
+			m.byCustomer[in.CustomerID] = make(map[int64]bool)
+		}
+		if m.byProduct[in.ProductID] == nil {
+			m.byProduct[in.ProductID] = make(map[int64]bool)
+		}
+		m.byCustomer[in.CustomerID][in.ProductID] = true
+		m.byProduct[in.ProductID][in.CustomerID] = true
+	}
+	// 人気度 = シグナル数 + 評価（同数のときは評価の高い商品を優先）
+	for id, item := range m.catalog {
+		m.popularity[id] = float64(len(m.byProduct[id])) + item.Rating/10
+	}
+	return m
+}
+
+// SimilarProducts - 全商品の類似商品を計算（共起のコサイン類似度、足りなければ同カテゴリの人気商品で補完）
+func (m *Model) SimilarProducts() []SimilarProduct {
+	var result []SimilarProduct
+	for _, id := range m.sortedProductIDs() {
+		scores := make(map[int64]float64)
+		for customerID := range m.byProduct[id] {
+			for other := range m.byCustomer[customerID] {
+				if other != id {
+					scores[other]++
+				}
+			}
+		}
+		for other, co := range scores {
+			scores[other] = co / math.Sqrt(float64(len(m.byProduct[id])*len(m.byProduct[other])))
+		}
+
+		ranked := rank(scores, MaxSimilarProducts)
+		exclude := map[int64]bool{id: true}
+		for i, s := range ranked {
+			exclude[s.id] = true
+			result = append(result, SimilarProduct{ProductID: id, SimilarProductID: s.id, Rank: i + 1, Score: s.score, Reason: ReasonSimilar, ComputedAt: m.now})
+		}
+		for i, s := range m.popularIn(m.catalog[id].CategoryIDs, exclude, MaxSimilarProducts-len(ranked)) {
+			result = append(result, SimilarProduct{ProductID: id, SimilarProductID: s.id, Rank: len(ranked) + i + 1, Score: 0, Reason: ReasonPopular, ComputedAt: m.now})
+		}
+	}
+	return result
+}
+
+// Recommendations - シグナルのある顧客ごとの推薦を計算（類似商品のスコア合計、足りなければ関心カテゴリの人気商品で補完）
+func (m *Model) Recommendations(similar []SimilarProduct) []Recommendation {
+	neighbors := make(map[int64][]SimilarProduct)
+	for _, s := range similar {
+		if s.Reason == ReasonSimilar {
+			neighbors[s.ProductID] = append(neighbors[s.ProductID], s)
+		}
+	}
+
+	var result []Recommendation
+	for _, customerID := range m.sortedCustomerIDs() {
+		owned := m.byCustomer[customerID]
+		scores := make(map[int64]float64)
+		categories := make(map[int64]bool)
+		for productID := range owned {
+			for _, s := range neighbors[productID] {
+				if !owned[s.SimilarProductID] {
+					scores[s.SimilarProductID] += s.Score
+				}
+			}
+			for _, c := range m.catalog[productID].CategoryIDs {
+				categories[c] = true
+			}
+		}
+
+		ranked := rank(scores, MaxRecommendations)
+		exclude := make(map[int64]bool, len(owned)+len(ranked))
+		for id := range owned {
+			exclude[id] = true
+		}
+		for i, s := range ranked {
+			exclude[s.id] = true
+			result = append(result, Recommendation{CustomerID: customerID, ProductID: s.id, Rank: i + 1, Score: s.score, Reason: ReasonSimilar, ComputedAt: m.now})
+		}
+
+		categoryIDs := make([]int64, 0, len(categories))
+		for c := range categories {
+			categoryIDs = append(categoryIDs, c)
+		}
+		for i, s := range m.popularIn(categoryIDs, exclude, MaxRecommendations-len(ranked)) {
+			result = append(result, Recommendation{CustomerID: customerID, ProductID: s.id, Rank: len(ranked) + i + 1, Score: 0, Reason: ReasonPopular, ComputedAt: m.now})
+		}
+	}
+	return result
+}
+
+type scored struct {
+	id    int64
+	score float64
+}
+
+// rank - スコアの高い順に上位 limit 件（同点はID昇順）
+func rank(scores map[int64]float64, limit int) []scored {
+	ranked := make([]scored, 0, len(scores))
+	for id, score := range scores {
+		ranked = append(ranked, scored{id, score})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].id < ranked[j].id
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// popularIn - 指定カテゴリのいずれかに属する人気商品（exclude を除く）
+func (m *Model) popularIn(categoryIDs []int64, exclude map[int64]bool, limit int) []scored {
+	if limit <= 0 || len(categoryIDs) == 0 {
+		return nil
+	}
+	wanted := make(map[int64]bool, len(categoryIDs))
+	for _, c := range categoryIDs {
+		wanted[c] = true
+	}
+	scores := make(map[int64]float64)
+	for id, item := range m.catalog {
+		if exclude[id] {
+			continue
+		}
+		for _, c := range item.CategoryIDs {
+			if wanted[c] {
+				scores[id] = m.popularity[id]
+				break
+			}
+		}
+	}
+	return rank(scores, limit)
+}
+
+func (m *Model) sortedProductIDs() []int64 {
+	ids := make([]int64, 0, len(m.catalog))
+	for id := range m.catalog {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (m *Model) sortedCustomerIDs() []int64 {
+	ids := make([]int64, 0, len(m.byCustomer))
+	for id := range m.byCustomer {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
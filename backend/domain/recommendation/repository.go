@@ -0,0 +1,11 @@
+package recommendation
+
+// RecommendationRepository - 推薦リポジトリインターフェース
+type RecommendationRepository interface {
+	// FindInteractions - お気に入り登録と高評価レビュー（HighRatingThreshold 以上）を顧客・商品の組で取得
+	FindInteractions() ([]Interaction, error)
+	// ReplaceAll - 類似商品と推薦を同じトランザクションで全件入れ替え
+	ReplaceAll(similar []SimilarProduct, recommendations []Recommendation) error
+	FindSimilar(productID int64, limit int) ([]SimilarProduct, error)
+	FindByCustomerID(customerID int64, limit int) ([]Recommendation, error)
+}
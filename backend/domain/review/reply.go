@@ -0,0 +1,122 @@
+package review
+
+import (
+	"backend/domain/customer"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ReplyMaxDepth - 返信の最大の深さ（1 = レビューへの直接の返信）
+	ReplyMaxDepth      = 3
+	ReplyBodyMaxLength = 500
+)
+
+var (
+	ErrReplyBodyEmpty      = errors.New("reply body is required")
+	ErrReplyBodyTooLong    = fmt.Errorf("reply body must be at most %d characters", ReplyBodyMaxLength)
+	ErrReplyTooDeep        = fmt.Errorf("replies can be nested at most %d levels deep", ReplyMaxDepth)
+	ErrReplyParentMismatch = errors.New("parent reply belongs to another review")
+)
+
+// ReplyBody - 返信本文のValue Object
+type ReplyBody string
+
+// NewReplyBody - ReplyBody を生成（バリデーション付き）
+func NewReplyBody(value string) (ReplyBody, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrReplyBodyEmpty
+	}
+	if utf8.RuneCountInString(trimmed) > ReplyBodyMaxLength {
+		return "", ErrReplyBodyTooLong
+	}
+	return ReplyBody(trimmed), nil
+}
+
+// Reply - レビューへの返信（カスタマーの返信、または管理者による公式返信）
+type Reply struct {
+	ID         int64              `json:"id"`
+	ReviewID   int64              `json:"reviewId"`
+	ParentID   *int64             `json:"parentId"`
+	Depth      int                `json:"depth"`
+	CustomerID *int64             `json:"customerId,omitempty"`
+	AdminID    *int64             `json:"-"`
+	IsOfficial bool               `json:"isOfficial"` // ブランド・運営の公式返信（管理者が投稿）
+	Author     *customer.Reviewer `json:"author,omitempty"`
+	Body       ReplyBody          `json:"body"`
+	Replies    []Reply            `json:"replies"`
+	CreatedAt  time.Time          `json:"createdAt"`
+}
+
+// NewCustomerReply - カスタマーの返信を生成（parent が nil ならレビューへの直接の返信）
+func NewCustomerReply(r *Review, parent *Reply, customerID int64, body ReplyBody) (*Reply, error) {
+	reply, err := newReply(r, parent, body)
+	if err != nil {
+		return nil, err
+	}
+	reply.CustomerID = &customerID
+	return reply, nil
+}
+
+// NewOfficialReply - 管理者による公式返信を生成
+func NewOfficialReply(r *Review, parent *Reply, adminID int64, body ReplyBody) (*Reply, error) {
+	reply, err := newReply(r, parent, body)
+	if err != nil {
+		return nil, err
+	}
+	reply.AdminID = &adminID
+	reply.IsOfficial = true
+	return reply, nil
+}
+
+func newReply(r *Review, parent *Reply, body ReplyBody) (*Reply, error) {
+	reply := &Reply{ReviewID: r.ID, Depth: 1, Body: body}
+	if parent != nil {
+		if parent.ReviewID != r.ID {
+			return nil, ErrReplyParentMismatch
+		}
+		if parent.Depth >= ReplyMaxDepth {
+			return nil, ErrReplyTooDeep
+		}
+		reply.ParentID = &parent.ID
+		reply.Depth = parent.Depth + 1
+	}
+	return reply, nil
+}
+
+// IsWrittenBy - customerID のカスタマーが書いた返信か
+func (r *Reply) IsWrittenBy(customerID int64) bool {
+	return r.CustomerID != nil && *r.CustomerID == customerID
+}
+
+// BuildThread - 古い順の返信一覧をスレッド（入れ子）に組み立てる
+func BuildThread(replies []Reply) []Reply {
+	children := make(map[int64][]int)
+	var roots []int
+	for i, r := range replies {
+		if r.ParentID == nil {
+			roots = append(roots, i)
+		} else {
+			children[*r.ParentID] = append(children[*r.ParentID], i)
+		}
+	}
+
+	var build func(i int) Reply
+	build = func(i int) Reply {
+		r := replies[i]
+		r.Replies = make([]Reply, 0, len(children[r.ID]))
+		for _, c := range children[r.ID] {
+			r.Replies = append(r.Replies, build(c))
+		}
+		return r
+	}
+	thread := make([]Reply, 0, len(roots))
+	for _, i := range roots {
+		thread = append(thread, build(i))
+	}
+	return thread
+}
@@ -1,10 +1,21 @@
 package review
 
+import "errors"
+
+var (
+	// ErrReviewNotFound - 該当するレビューがない
+	ErrReviewNotFound = errors.New("review not found")
+	// ErrReplyNotFound - 該当する返信がない
+	ErrReplyNotFound = errors.New("reply not found")
+)
+
 // ReviewRepository - レビューリポジトリインターフェース
 type ReviewRepository interface {
 	FindAll() ([]Review, error)
 	FindByProductID(productID int64) ([]Review, error)
 	FindByCustomerID(customerID int64) ([]Review, error)
+	CountByCustomerID(customerID int64) (int64, error)
+	// FindByID - 該当するレビューがなければ ErrReviewNotFound
 	FindByID(id int64) (*Review, error)
 	FindByProductIDAndCustomerID(productID, customerID int64) (*Review, error)
 	Create(review *Review) error
@@ -12,3 +23,14 @@ type ReviewRepository interface {
 	Delete(id int64) error
 	GetProductRatingStats(productID int64) (avg float64, count int64, err error)
 }
+
+// ReplyRepository - レビューへの返信リポジトリインターフェース
+type ReplyRepository interface {
+	// FindByReviewID - レビューへの返信（古い順、入れ子にしない）
+	FindByReviewID(reviewID int64) ([]Reply, error)
+	// FindByID - 該当する返信がなければ ErrReplyNotFound
+	FindByID(id int64) (*Reply, error)
+	Create(reply *Reply) error
+	// Delete - 返信を削除（その返信への返信も削除される）
+	Delete(id int64) error
+}
@@ -12,9 +12,10 @@ type Review struct {
 	ProductID  int64              `json:"productId"`
 	Product    *product.Product   `json:"product,omitempty"`
 	CustomerID int64              `json:"customerId"`
-	Customer   *customer.Customer `json:"customer,omitempty"`
+	Customer   *customer.Reviewer `json:"customer,omitempty"` // 公開用の投稿者（メールアドレス等は持たない）
 	Rating     Rating             `json:"rating"`
 	Comment    Comment            `json:"comment"`
+	ReplyCount int                `json:"replyCount"`
 	CreatedAt  time.Time          `json:"createdAt"`
 	UpdatedAt  time.Time          `json:"updatedAt"`
 }
@@ -0,0 +1,25 @@
+package submission
+
+import "errors"
+
+// ErrSubmissionNotFound - 該当する提案がない
+var ErrSubmissionNotFound = errors.New("submission not found")
+
+// SubmissionRepository - 新商品の提案リポジトリインターフェース
+type SubmissionRepository interface {
+	// FindByStatus - ステータスごとの提案（古い順）
+	FindByStatus(status string) ([]Submission, error)
+	// FindByCustomerID - カスタマーの提案（新しい順）
+	FindByCustomerID(customerID int64) ([]Submission, error)
+	// FindByID - 該当する提案がなければ ErrSubmissionNotFound
+	FindByID(id int64) (*Submission, error)
+	// FindByIDForUpdate - 行ロックして取得（トランザクション内で使い、同時の承認・却下を直列化する）
+	FindByIDForUpdate(id int64) (*Submission, error)
+	CountPending(customerID int64) (int64, error)
+	Create(s *Submission) error
+	Update(s *Submission) error
+	// FindProductsByName - product.NormalizeName で正規化した英語名・日本語名が一致する既存商品（重複の候補）
+	FindProductsByName(name, nameJa string) ([]ProductMatch, error)
+	// BackfillNormalizedNames - 正規化した名前が未設定の商品（マイグレーション前に登録）に最大 limit 件設定し、件数を返す
+	BackfillNormalizedNames(limit int) (int, error)
+}
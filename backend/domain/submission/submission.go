@@ -0,0 +1,125 @@
+package submission
+
+import (
+	"backend/domain/product"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 審査ステータス
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// MaxPendingPerCustomer - 1人のカスタマーが同時に審査待ちにできる提案数
+const MaxPendingPerCustomer = 10
+
+var (
+	ErrStatusInvalid           = errors.New("status must be one of pending, approved, rejected")
+	ErrNotPending              = errors.New("submission has already been reviewed")
+	ErrRejectionReasonRequired = errors.New("rejection reason is required")
+	ErrDuplicateProduct        = errors.New("a product with the same name already exists")
+	ErrTooManyPending          = fmt.Errorf("you can have at most %d submissions awaiting review", MaxPendingPerCustomer)
+)
+
+// NewStatus - 審査ステータスを検証
+func NewStatus(value string) (string, error) {
+	switch value {
+	case StatusPending, StatusApproved, StatusRejected:
+		return value, nil
+	}
+	return "", ErrStatusInvalid
+}
+
+// ProductMatch - 提案と同じ名前の既存商品（重複の候補）
+type ProductMatch struct {
+	ProductID int64  `json:"productId"`
+	Name      string `json:"name"`
+	NameJa    string `json:"nameJa"`
+}
+
+// Submission - カスタマーによる新商品の提案（管理者が承認すると商品として登録）
+type Submission struct {
+	ID                 int64          `json:"id" gorm:"primaryKey;autoIncrement"`
+	CustomerID         int64          `json:"customerId"`
+	Name               string         `json:"name"`
+	NameJa             string         `json:"nameJa"`
+	Description        string         `json:"description"`
+	DescriptionJa      string         `json:"descriptionJa"`
+	ImageURL           string         `json:"imageUrl" gorm:"column:image_url"`
+	StoreURL           *string        `json:"storeUrl" gorm:"column:store_url"` // 購入できる店舗のURL（任意）
+	Status             string         `json:"status" gorm:"default:pending"`
+	RejectionReason    string         `json:"rejectionReason,omitempty"`
+	ProductID          *int64         `json:"productId"` // 承認時に登録された商品
+	ReviewedByAdminID  *int64         `json:"reviewedByAdminId,omitempty"`
+	ReviewedAt         *time.Time     `json:"reviewedAt"`
+	PossibleDuplicates []ProductMatch `json:"possibleDuplicates,omitempty" gorm:"-"` // 審査キューでのみ設定
+	CreatedAt          time.Time      `json:"createdAt"`
+	UpdatedAt          time.Time      `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Submission) TableName() string {
+	return "product_submissions"
+}
+
+// NewSubmission - 新商品の提案を生成（管理者の商品登録と同じ英語・日本語のバリデーション）
+func NewSubmission(customerID int64, name, nameJa, description, descriptionJa, imageURL string, storeURL *string) (*Submission, error) {
+	name, nameJa = strings.TrimSpace(name), strings.TrimSpace(nameJa)
+	description, descriptionJa = strings.TrimSpace(description), strings.TrimSpace(descriptionJa)
+	imageURL = strings.TrimSpace(imageURL)
+	if err := product.ValidateDetails(name, nameJa, description, descriptionJa, imageURL); err != nil {
+		return nil, err
+	}
+	store, err := product.NewOptionalURL(storeURL)
+	if err != nil {
+		return nil, fmt.Errorf("storeUrl: %w", err)
+	}
+	return &Submission{
+		CustomerID:    customerID,
+		Name:          name,
+		NameJa:        nameJa,
+		Description:   description,
+		DescriptionJa: descriptionJa,
+		ImageURL:      imageURL,
+		StoreURL:      store.Value(),
+		Status:        StatusPending,
+	}, nil
+}
+
+// IsPending - 審査待ちか
+func (s *Submission) IsPending() bool {
+	return s.Status == StatusPending
+}
+
+// Approve - 承認（登録された商品を紐付ける）
+func (s *Submission) Approve(productID, adminID int64, now time.Time) error {
+	if !s.IsPending() {
+		return ErrNotPending
+	}
+	s.Status = StatusApproved
+	s.ProductID = &productID
+	s.ReviewedByAdminID = &adminID
+	s.ReviewedAt = &now
+	return nil
+}
+
+// Reject - 理由を付けて却下
+func (s *Submission) Reject(reason string, adminID int64, now time.Time) error {
+	if !s.IsPending() {
+		return ErrNotPending
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrRejectionReasonRequired
+	}
+	s.Status = StatusRejected
+	s.RejectionReason = reason
+	s.ReviewedByAdminID = &adminID
+	s.ReviewedAt = &now
+	return nil
+}
@@ -0,0 +1,22 @@
+package suggestion
+
+import "errors"
+
+// ErrSuggestionNotFound - 該当する修正提案がない
+var ErrSuggestionNotFound = errors.New("suggestion not found")
+
+// SuggestionRepository - 商品の修正提案リポジトリインターフェース（変更案も読み書きする）
+type SuggestionRepository interface {
+	// FindByStatus - ステータスごとの提案（古い順）
+	FindByStatus(status string) ([]Suggestion, error)
+	// FindByCustomerID - カスタマーの提案（新しい順）
+	FindByCustomerID(customerID int64) ([]Suggestion, error)
+	// FindByID - 該当する提案がなければ ErrSuggestionNotFound
+	FindByID(id int64) (*Suggestion, error)
+	// FindByIDForUpdate - 行ロックして取得（トランザクション内で使い、同時の承認・却下を直列化する）
+	FindByIDForUpdate(id int64) (*Suggestion, error)
+	CountPending(customerID int64) (int64, error)
+	Create(s *Suggestion) error
+	// Update - 審査結果と各変更案の反映有無を保存
+	Update(s *Suggestion) error
+}
@@ -0,0 +1,298 @@
+package suggestion
+
+import (
+	"backend/domain/product"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 審査ステータス
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// 修正を提案できる項目（JSON のフィールド名。ストアリンクは "storeLinks.<ストアコード>"）
+const (
+	FieldName          = "name"
+	FieldNameJa        = "nameJa"
+	FieldDescription   = "description"
+	FieldDescriptionJa = "descriptionJa"
+	FieldImageURL      = "imageUrl"
+	FieldAffiliateURL  = "affiliateUrl"
+
+	storeLinkFieldPrefix = "storeLinks."
+)
+
+const (
+	// MaxPendingPerCustomer - 1人のカスタマーが同時に審査待ちにできる提案数
+	MaxPendingPerCustomer = 10
+	CommentMaxLength      = 500
+)
+
+var (
+	ErrStatusInvalid           = errors.New("status must be one of pending, approved, rejected")
+	ErrFieldUnknown            = errors.New("field cannot be edited")
+	ErrNoChanges               = errors.New("suggestion must change at least one field")
+	ErrCommentTooLong          = fmt.Errorf("comment must be at most %d characters", CommentMaxLength)
+	ErrNotPending              = errors.New("suggestion has already been reviewed")
+	ErrFieldNotSuggested       = errors.New("field is not part of the suggestion")
+	ErrFieldOutdated           = errors.New("field has changed since the suggestion; select it explicitly to apply")
+	ErrRejectionReasonRequired = errors.New("rejection reason is required")
+	ErrTooManyPending          = fmt.Errorf("you can have at most %d suggestions awaiting review", MaxPendingPerCustomer)
+)
+
+// NewStatus - 審査ステータスを検証
+func NewStatus(value string) (string, error) {
+	switch value {
+	case StatusPending, StatusApproved, StatusRejected:
+		return value, nil
+	}
+	return "", ErrStatusInvalid
+}
+
+// StoreLinkField - ストアリンクの項目名
+func StoreLinkField(storeCode string) string {
+	return storeLinkFieldPrefix + storeCode
+}
+
+// StoreCodeOf - ストアリンクの項目ならストアコードを返す
+func StoreCodeOf(field string) (string, bool) {
+	if !strings.HasPrefix(field, storeLinkFieldPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, storeLinkFieldPrefix), true
+}
+
+// Change - 1項目の変更案（提案時点の値と提案された値。ストアリンク・アフィリエイトURLの空文字は削除）
+type Change struct {
+	ID           int64  `json:"-" gorm:"primaryKey;autoIncrement"`
+	SuggestionID int64  `json:"-"`
+	Field        string `json:"field"`
+	Original     string `json:"original" gorm:"column:original_value"`
+	Proposed     string `json:"proposed" gorm:"column:proposed_value"`
+	Applied      bool   `json:"applied"` // 承認時に商品へ反映したか
+}
+
+// TableName - GORMテーブル名
+func (Change) TableName() string {
+	return "product_edit_suggestion_changes"
+}
+
+// FieldComparison - 管理者向けの項目ごとの比較（提案時点・現在・提案の値）
+type FieldComparison struct {
+	Field    string `json:"field"`
+	Original string `json:"original"`
+	Current  string `json:"current"`
+	Proposed string `json:"proposed"`
+	Outdated bool   `json:"outdated"` // 提案後に商品側の値が変わった
+}
+
+// Suggestion - カスタマーによる商品情報の修正提案（管理者が承認すると商品に反映）
+type Suggestion struct {
+	ID                int64             `json:"id" gorm:"primaryKey;autoIncrement"`
+	ProductID         int64             `json:"productId"`
+	CustomerID        int64             `json:"customerId"`
+	Comment           string            `json:"comment"`
+	Changes           []Change          `json:"changes" gorm:"foreignKey:SuggestionID"`
+	Status            string            `json:"status" gorm:"default:pending"`
+	RejectionReason   string            `json:"rejectionReason,omitempty"`
+	ReviewedByAdminID *int64            `json:"reviewedByAdminId,omitempty"`
+	ReviewedAt        *time.Time        `json:"reviewedAt"`
+	Comparison        []FieldComparison `json:"comparison,omitempty" gorm:"-"` // 管理者向けの取得でのみ設定
+	CreatedAt         time.Time         `json:"createdAt"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Suggestion) TableName() string {
+	return "product_edit_suggestions"
+}
+
+// NewSuggestion - 商品の修正提案を生成（proposed は項目名と新しい値。現在と同じ値の項目は除く）
+func NewSuggestion(customerID int64, p *product.Product, proposed map[string]string, comment string) (*Suggestion, error) {
+	comment = strings.TrimSpace(comment)
+	if len([]rune(comment)) > CommentMaxLength {
+		return nil, ErrCommentTooLong
+	}
+
+	fields := make([]string, 0, len(proposed))
+	for field := range proposed {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	s := &Suggestion{ProductID: p.ID, CustomerID: customerID, Comment: comment, Status: StatusPending}
+	for _, field := range fields {
+		value := strings.TrimSpace(proposed[field])
+		if err := validateField(field, value); err != nil {
+			return nil, err
+		}
+		current, err := CurrentValue(p, field)
+		if err != nil {
+			return nil, err
+		}
+		if current == value {
+			continue
+		}
+		s.Changes = append(s.Changes, Change{Field: field, Original: current, Proposed: value})
+	}
+	if len(s.Changes) == 0 {
+		return nil, ErrNoChanges
+	}
+	return s, nil
+}
+
+// validateField - 項目ごとに商品と同じバリデーション
+func validateField(field, value string) error {
+	var err error
+	switch field {
+	case FieldName:
+		_, err = product.NewProductNameEn(value)
+	case FieldNameJa:
+		_, err = product.NewProductNameJa(value)
+	case FieldDescription:
+		_, err = product.NewProductDescriptionEn(value)
+	case FieldDescriptionJa:
+		_, err = product.NewProductDescriptionJa(value)
+	case FieldImageURL:
+		_, err = product.NewImageURL(value)
+	case FieldAffiliateURL:
+		_, err = product.NewOptionalURL(&value)
+	default:
+		code, ok := StoreCodeOf(field)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrFieldUnknown, field)
+		}
+		if normalized, err := product.NewStoreCode(code); err != nil || normalized != code {
+			return fmt.Errorf("%s: %w", field, product.ErrStoreCodeInvalid)
+		}
+		if value != "" {
+			_, err = product.NewStoreLinkURL(value)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
+
+// CurrentValue - 商品の現在の値（リンクのないストアは空文字）
+func CurrentValue(p *product.Product, field string) (string, error) {
+	switch field {
+	case FieldName:
+		return p.Name, nil
+	case FieldNameJa:
+		return p.NameJa, nil
+	case FieldDescription:
+		return p.Description, nil
+	case FieldDescriptionJa:
+		return p.DescriptionJa, nil
+	case FieldImageURL:
+		return p.ImageURL, nil
+	case FieldAffiliateURL:
+		if p.AffiliateURL == nil {
+			return "", nil
+		}
+		return *p.AffiliateURL, nil
+	}
+	code, ok := StoreCodeOf(field)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrFieldUnknown, field)
+	}
+	for _, link := range p.StoreLinks {
+		if link.Store != nil && link.Store.Code == code {
+			return link.URL, nil
+		}
+	}
+	return "", nil
+}
+
+// Compare - 現在の商品と項目ごとに比較
+func (s *Suggestion) Compare(p *product.Product) []FieldComparison {
+	comparison := make([]FieldComparison, 0, len(s.Changes))
+	for _, c := range s.Changes {
+		current, _ := CurrentValue(p, c.Field)
+		comparison = append(comparison, FieldComparison{
+			Field:    c.Field,
+			Original: c.Original,
+			Current:  current,
+			Proposed: c.Proposed,
+			Outdated: current != c.Original,
+		})
+	}
+	return comparison
+}
+
+// IsPending - 審査待ちか
+func (s *Suggestion) IsPending() bool {
+	return s.Status == StatusPending
+}
+
+// Approve - 承認（fields の項目だけを反映、空なら全項目）。提案後に商品 p 側の値が変わった項目は fields で明示した場合に限り反映する
+func (s *Suggestion) Approve(p *product.Product, fields []string, adminID int64, now time.Time) error {
+	if !s.IsPending() {
+		return ErrNotPending
+	}
+	selected := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if !s.hasField(field) {
+			return fmt.Errorf("%w: %s", ErrFieldNotSuggested, field)
+		}
+		selected[field] = true
+	}
+	if len(fields) == 0 {
+		for _, c := range s.Compare(p) {
+			if c.Outdated {
+				return fmt.Errorf("%w: %s", ErrFieldOutdated, c.Field)
+			}
+		}
+	}
+	for i := range s.Changes {
+		s.Changes[i].Applied = len(fields) == 0 || selected[s.Changes[i].Field]
+	}
+	s.Status = StatusApproved
+	s.ReviewedByAdminID = &adminID
+	s.ReviewedAt = &now
+	return nil
+}
+
+// AppliedChanges - 承認時に反映する変更
+func (s *Suggestion) AppliedChanges() []Change {
+	var applied []Change
+	for _, c := range s.Changes {
+		if c.Applied {
+			applied = append(applied, c)
+		}
+	}
+	return applied
+}
+
+// Reject - 理由を付けて却下
+func (s *Suggestion) Reject(reason string, adminID int64, now time.Time) error {
+	if !s.IsPending() {
+		return ErrNotPending
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrRejectionReasonRequired
+	}
+	s.Status = StatusRejected
+	s.RejectionReason = reason
+	s.ReviewedByAdminID = &adminID
+	s.ReviewedAt = &now
+	return nil
+}
+
+func (s *Suggestion) hasField(field string) bool {
+	for _, c := range s.Changes {
+		if c.Field == field {
+			return true
+		}
+	}
+	return false
+}
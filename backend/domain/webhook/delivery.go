@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// 配信状態
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+const (
+	// MaxAttempts - この回数失敗したら配信を諦める（dead、管理画面から再送可能）
+	MaxAttempts = 10
+	// 再試行間隔（失敗ごとに倍、上限あり）
+	initialRetryDelay = time.Minute
+	maxRetryDelay     = 12 * time.Hour
+	lastErrorMaxBytes = 1000
+)
+
+// Delivery - 購読先への1イベントの配信（試行結果を記録する配信ログ）
+type Delivery struct {
+	ID             int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	SubscriptionID int64      `json:"subscriptionId"`
+	EventID        int64      `json:"eventId"`
+	EventName      string     `json:"eventName"`
+	Payload        string     `json:"payload" gorm:"type:jsonb"`
+	Status         string     `json:"status" gorm:"default:pending"`
+	Attempts       int        `json:"attempts" gorm:"default:0"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastStatusCode *int       `json:"lastStatusCode"`
+	LastError      *string    `json:"lastError"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt"`
+	DeliveredAt    *time.Time `json:"deliveredAt"`
+	ReplayOfID     *int64     `json:"replayOfId"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// TableName - GORMテーブル名
+func (Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Envelope - 購読先に送るリクエスト本文
+type Envelope struct {
+	ID        int64           `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// NewDelivery - イベントの配信を作成（eventID は outbox のID、購読先が重複排除に使う）
+func NewDelivery(subscriptionID, eventID int64, eventName string, data any, now time.Time) (*Delivery, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(Envelope{ID: eventID, Event: eventName, CreatedAt: now.UTC(), Data: raw})
+	if err != nil {
+		return nil, err
+	}
+	return &Delivery{
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventName:      eventName,
+		Payload:        string(payload),
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}, nil
+}
+
+// Replay - 同じ本文で新しい配信を作成（元の配信ログはそのまま残す）
+func (d *Delivery) Replay(now time.Time) *Delivery {
+	id := d.ID
+	return &Delivery{
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventName:      d.EventName,
+		Payload:        d.Payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		ReplayOfID:     &id,
+		CreatedAt:      now,
+	}
+}
+
+// Result - 1回の送信結果（StatusCode は応答がなければ 0）
+type Result struct {
+	StatusCode int
+	Err        error
+}
+
+// OK - 2xx 応答なら成功
+func (r Result) OK() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Record - 送信結果を記録し、失敗なら次回の再試行時刻を決める（上限回数で dead）
+func (d *Delivery) Record(result Result, now time.Time) {
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.LastStatusCode = nil
+	if result.StatusCode != 0 {
+		code := result.StatusCode
+		d.LastStatusCode = &code
+	}
+	if result.OK() {
+		d.Status = DeliverySucceeded
+		d.DeliveredAt = &now
+		d.LastError = nil
+		return
+	}
+
+	msg := fmt.Sprintf("unexpected status %d", result.StatusCode)
+	if result.Err != nil {
+		msg = result.Err.Error()
+	}
+	if len(msg) > lastErrorMaxBytes {
+		msg = msg[:lastErrorMaxBytes]
+	}
+	d.LastError = &msg
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryDead
+		return
+	}
+	delay := initialRetryDelay << (d.Attempts - 1)
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	d.NextAttemptAt = now.Add(delay)
+}
+
+// Abandon - 送信せずに dead にする（購読が無効化された場合など）
+func (d *Delivery) Abandon(reason string) {
+	d.Status = DeliveryDead
+	d.LastError = &reason
+}
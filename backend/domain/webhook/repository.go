@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"backend/domain/audit"
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrWebhookNotFound - 該当する Webhook がない
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrDeliveryNotFound - 該当する配信がない
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+// DeliveryFilter - 配信ログの絞り込み条件（ゼロ値は条件なし）
+type DeliveryFilter struct {
+	SubscriptionID int64
+	Status         string
+	Limit          int
+}
+
+// WebhookRepository - Webhook リポジトリインターフェース
+type WebhookRepository interface {
+	FindAll() ([]Subscription, error)
+	// FindByID - 該当する Webhook がなければ ErrWebhookNotFound
+	FindByID(id int64) (*Subscription, error)
+	// FindActiveByEvent - イベントを購読している有効な購読
+	FindActiveByEvent(eventName string) ([]Subscription, error)
+	Create(s *Subscription) error
+	Update(s *Subscription) error
+	Delete(id int64) error
+
+	// CreateDeliveries - 配信を作成（同じ購読・イベントの配信が既にあれば作成しない）
+	CreateDeliveries(deliveries []*Delivery) error
+	// CreateDelivery - 配信を1件作成（再送用、重複チェックなし）
+	CreateDelivery(d *Delivery) error
+	// FindDueDeliveries - 送信時刻を過ぎた pending の配信（古い順）
+	FindDueDeliveries(now time.Time, limit int) ([]Delivery, error)
+	FindDeliveries(filter DeliveryFilter) ([]Delivery, error)
+	// FindDeliveryByID - 該当する配信がなければ ErrDeliveryNotFound
+	FindDeliveryByID(id int64) (*Delivery, error)
+	SaveDelivery(d *Delivery) error
+}
+
+// Tx - トランザクションに参加するリポジトリ（この package は domain/event に依存するため event.Tx とは別に持つ）
+type Tx interface {
+	Webhooks() WebhookRepository
+	AuditLogs() audit.AuditLogRepository
+}
+
+// UnitOfWork - Webhook の更新と監査ログの記録を1トランザクションで行う（fn がエラーを返すとロールバック）
+type UnitOfWork interface {
+	Do(fn func(tx Tx) error) error
+}
+
+// Sender - 購読先URLへ署名付きで送信する
+type Sender interface {
+	Send(ctx context.Context, s *Subscription, d *Delivery) Result
+}
@@ -0,0 +1,290 @@
+package webhook
+
+import (
+	"backend/domain/event"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	NameMaxLength = 100
+	// secretBytes - 自動生成するシークレットのバイト数
+	secretBytes     = 32
+	secretMinLength = 16
+)
+
+// 署名ヘッダー
+const (
+	HeaderSignature = "X-VeganBite-Signature"
+	HeaderEvent     = "X-VeganBite-Event"
+	HeaderDelivery  = "X-VeganBite-Delivery"
+)
+
+var (
+	ErrNameEmpty         = errors.New("webhook name is required")
+	ErrNameTooLong       = errors.New("webhook name must be at most 100 characters")
+	ErrURLInvalid        = errors.New("webhook url must be an absolute http(s) URL")
+	ErrSecretTooShort    = errors.New("webhook secret must be at least 16 characters")
+	ErrEventTypesEmpty   = errors.New("at least one event type is required")
+	ErrEventTypeInvalid  = errors.New("unsupported event type")
+	ErrProductIDsInvalid = errors.New("product IDs must be positive")
+)
+
+// EventTypes - パートナーに配信できるイベント（顧客の制裁等の内部イベントは含めない）
+func EventTypes() []string {
+	return []string{
+		event.NameReviewCreated,
+		event.NameReviewUpdated,
+		event.NameReviewDeleted,
+		event.NameReplyCreated,
+		event.NameReplyDeleted,
+		event.NameQuestionCreated,
+		event.NameQuestionDeleted,
+		event.NameAnswerCreated,
+		event.NameAnswerDeleted,
+		event.NameProductCreated,
+		event.NameProductUpdated,
+		event.NameProductDeleted,
+		event.NameOfferPriceDropped,
+		event.NameOfferBackInStock,
+	}
+}
+
+// Subscription - Webhook 購読（管理者が登録）
+type Subscription struct {
+	ID               int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name             string     `json:"name"`
+	URL              string     `json:"url"`
+	Secret           string     `json:"-"`
+	EventTypes       StringList `json:"eventTypes" gorm:"type:text[]"`
+	ProductIDs       Int64List  `json:"productIds" gorm:"type:bigint[]"` // 空なら全商品
+	IsActive         bool       `json:"isActive" gorm:"default:true"`
+	CreatedByAdminID *int64     `json:"createdByAdminId"`
+	UpdatedByAdminID *int64     `json:"updatedByAdminId"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+// TableName - GORMテーブル名
+func (Subscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// NewName - 購読名を検証
+func NewName(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", ErrNameEmpty
+	}
+	if utf8.RuneCountInString(trimmed) > NameMaxLength {
+		return "", ErrNameTooLong
+	}
+	return trimmed, nil
+}
+
+// NewURL - 配信先URLを検証
+func NewURL(value string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", ErrURLInvalid
+	}
+	return u.String(), nil
+}
+
+// NewSecret - シークレットを検証（空なら生成）
+func NewSecret(value string) (string, error) {
+	if value == "" {
+		b := make([]byte, secretBytes)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		return "whsec_" + hex.EncodeToString(b), nil
+	}
+	if len(value) < secretMinLength {
+		return "", ErrSecretTooShort
+	}
+	return value, nil
+}
+
+// NewEventTypes - 購読するイベントを検証（重複除去）
+func NewEventTypes(values []string) (StringList, error) {
+	supported := map[string]bool{}
+	for _, t := range EventTypes() {
+		supported[t] = true
+	}
+	seen := map[string]bool{}
+	result := StringList{}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if !supported[v] {
+			return nil, fmt.Errorf("%w: %q", ErrEventTypeInvalid, v)
+		}
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	if len(result) == 0 {
+		return nil, ErrEventTypesEmpty
+	}
+	return result, nil
+}
+
+// NewProductIDs - 対象商品の絞り込みを検証（空なら全商品）
+func NewProductIDs(values []int64) (Int64List, error) {
+	result := Int64List{}
+	seen := map[int64]bool{}
+	for _, id := range values {
+		if id <= 0 {
+			return nil, ErrProductIDsInvalid
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// Matches - イベントがこの購読の配信対象か
+func (s *Subscription) Matches(e event.Event) bool {
+	if !s.IsActive || !s.EventTypes.Contains(e.EventName()) {
+		return false
+	}
+	if len(s.ProductIDs) == 0 {
+		return true
+	}
+	productID, ok := event.ProductIDOf(e)
+	return ok && s.ProductIDs.Contains(productID)
+}
+
+// Sign - 署名ヘッダーの値を生成（"t=<unix秒>,v1=<HMAC-SHA256(secret, "<unix秒>.<body>")>"）
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify - 署名を検証（受信側の実装例・テスト用、tolerance を超えて古い署名は拒否）
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) bool {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			sig = value
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || sig == "" {
+		return false
+	}
+	signedAt := time.Unix(unix, 0)
+	if now.Sub(signedAt) > tolerance || signedAt.Sub(now) > tolerance {
+		return false
+	}
+	expected := Sign(secret, signedAt, body)
+	return hmac.Equal([]byte(expected), []byte("t="+ts+",v1="+sig))
+}
+
+// StringList - TEXT[] カラム
+type StringList []string
+
+// Contains - 値を含むか
+func (l StringList) Contains(v string) bool {
+	for _, s := range l {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Value - driver.Valuer 実装
+func (l StringList) Value() (driver.Value, error) {
+	return []string(l), nil
+}
+
+// Scan - sql.Scanner 実装（TEXT[] のテキスト表現 "{a,b}" を読み込む）
+func (l *StringList) Scan(src interface{}) error {
+	items, err := scanArray(src)
+	if err != nil {
+		return err
+	}
+	result := StringList{}
+	for _, v := range items {
+		result = append(result, strings.Trim(v, `"`))
+	}
+	*l = result
+	return nil
+}
+
+// Int64List - BIGINT[] カラム
+type Int64List []int64
+
+// Contains - 値を含むか
+func (l Int64List) Contains(v int64) bool {
+	for _, id := range l {
+		if id == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Value - driver.Valuer 実装
+func (l Int64List) Value() (driver.Value, error) {
+	return []int64(l), nil
+}
+
+// Scan - sql.Scanner 実装（BIGINT[] のテキスト表現 "{1,2}" を読み込む）
+func (l *Int64List) Scan(src interface{}) error {
+	items, err := scanArray(src)
+	if err != nil {
+		return err
+	}
+	result := Int64List{}
+	for _, v := range items {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		result = append(result, id)
+	}
+	*l = result
+	return nil
+}
+
+func scanArray(src interface{}) ([]string, error) {
+	var raw string
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return nil, fmt.Errorf("cannot scan %T into array", src)
+	}
+	raw = strings.TrimSuffix(strings.TrimPrefix(raw, "{"), "}")
+	if raw == "" {
+		return nil, nil
+	}
+	return strings.Split(raw, ","), nil
+}
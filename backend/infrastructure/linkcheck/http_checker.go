@@ -0,0 +1,114 @@
+package linkcheck
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"backend/domain/linkcheck"
+)
+
+const userAgent = "VeganBiteLinkChecker/1.0"
+
+// HTTPChecker - HTTPリクエストでURLの死活を確認（同一ホストへの連続アクセスは間隔を空ける）
+type HTTPChecker struct {
+	client       *http.Client
+	hostInterval time.Duration
+
+	mu          sync.Mutex
+	nextAllowed map[string]time.Time
+}
+
+// NewHTTPChecker - HTTPチェッカーの生成（hostInterval は同一ホストへのリクエスト間隔）
+func NewHTTPChecker(timeout, hostInterval time.Duration) *HTTPChecker {
+	return &HTTPChecker{
+		client:       &http.Client{Timeout: timeout},
+		hostInterval: hostInterval,
+		nextAllowed:  make(map[string]time.Time),
+	}
+}
+
+// Check - HEADで確認し、HEAD非対応のサーバーにはGETで再確認
+func (c *HTTPChecker) Check(ctx context.Context, rawURL string) linkcheck.Result {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return linkcheck.Result{Err: err}
+	}
+
+	result := c.request(ctx, http.MethodHead, u)
+	switch result.HTTPStatus {
+	case http.StatusMethodNotAllowed, http.StatusForbidden, http.StatusNotImplemented:
+		result = c.request(ctx, http.MethodGet, u)
+	}
+	return result
+}
+
+func (c *HTTPChecker) request(ctx context.Context, method string, u *url.URL) linkcheck.Result {
+	if err := c.waitForHost(ctx, u.Host); err != nil {
+		return linkcheck.Result{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return linkcheck.Result{Err: err}
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return linkcheck.Result{Err: err}
+	}
+	defer resp.Body.Close()
+	// 本文は不要だが、接続を再利用できるよう少しだけ読み捨てる
+	_, _ = io.CopyN(io.Discard, resp.Body, 4096)
+
+	return linkcheck.Result{
+		HTTPStatus: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// waitForHost - 同一ホストへの前回リクエストから hostInterval 経過するまで待つ
+func (c *HTTPChecker) waitForHost(ctx context.Context, host string) error {
+	c.mu.Lock()
+	now := time.Now()
+	at := c.nextAllowed[host]
+	if at.Before(now) {
+		at = now
+	}
+	c.nextAllowed[host] = at.Add(c.hostInterval)
+	c.mu.Unlock()
+
+	wait := time.Until(at)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseRetryAfter - Retry-After ヘッダー（秒数またはHTTP日付）を解析
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,130 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	domain "backend/domain/notification"
+)
+
+// base64LineLength - base64 本文の1行の長さ（RFC 2045 の上限 76 文字）
+const base64LineLength = 76
+
+// SMTPSender - SMTPによるメール配信チャネル
+type SMTPSender struct {
+	addr        string
+	host        string
+	from        string
+	auth        smtp.Auth
+	frontendURL string
+	now         func() time.Time
+}
+
+// NewSMTPSender - SMTPメール配信チャネルの生成（username が空なら認証なし）
+func NewSMTPSender(host, port, username, password, from, frontendURL string) *SMTPSender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPSender{
+		addr:        net.JoinHostPort(host, port),
+		host:        host,
+		from:        from,
+		auth:        auth,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+		now:         time.Now,
+	}
+}
+
+// Channel - 配信チャネル名
+func (s *SMTPSender) Channel() domain.Channel {
+	return domain.ChannelEmail
+}
+
+// Send - 通知をメールで送信
+func (s *SMTPSender) Send(to domain.Recipient, n *domain.Notification) error {
+	if to.Email == "" {
+		return errors.New("recipient has no email address")
+	}
+
+	// smtp.SendMail と同じ手順だが、8BITMIME の対応有無で本文のエンコーディングを切り替える
+	c, err := smtp.Dial(s.addr)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+	// Mail は 8BITMIME 対応サーバーに BODY=8BITMIME を付けて送る
+	eightBit, _ := c.Extension("8BITMIME")
+	if err := c.Mail(s.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to.Email); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(s.buildMessage(to, n, eightBit)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// buildMessage - UTF-8 のプレーンテキストメールを組み立てる（eightBit が false なら本文は base64）
+func (s *SMTPSender) buildMessage(to domain.Recipient, n *domain.Notification, eightBit bool) []byte {
+	var body bytes.Buffer
+	if to.Name != "" {
+		fmt.Fprintf(&body, "%s 様\r\n\r\n", to.Name)
+	}
+	fmt.Fprintf(&body, "%s\r\n", n.Body)
+	if s.frontendURL != "" && n.ProductID != 0 {
+		fmt.Fprintf(&body, "\r\n%s/products/%d\r\n", s.frontendURL, n.ProductID)
+	}
+	body.WriteString("\r\n通知設定はマイページから変更できます。\r\n")
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", to.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", n.Title))
+	fmt.Fprintf(&b, "Date: %s\r\n", s.now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	if eightBit {
+		b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+		b.Write(body.Bytes())
+		return b.Bytes()
+	}
+	b.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+	encoded := base64.StdEncoding.EncodeToString(body.Bytes())
+	for len(encoded) > base64LineLength {
+		b.WriteString(encoded[:base64LineLength] + "\r\n")
+		encoded = encoded[base64LineLength:]
+	}
+	b.WriteString(encoded + "\r\n")
+	return b.Bytes()
+}
@@ -0,0 +1,195 @@
+package notification
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	domain "backend/domain/notification"
+)
+
+// smtpSession - テスト用SMTPサーバーが受け取ったエンベロープと本文
+type smtpSession struct {
+	mailFrom string
+	rcptTo   []string
+	data     []byte
+}
+
+// startFakeSMTPServer - 1接続だけ受け付けるSMTPサーバーを起動（extensions は EHLO 応答に含める拡張）
+func startFakeSMTPServer(t *testing.T, extensions ...string) (string, <-chan smtpSession) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	sessions := make(chan smtpSession, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		r := textproto.NewReader(bufio.NewReader(conn))
+		reply := func(lines ...string) {
+			io.WriteString(conn, strings.Join(lines, "\r\n")+"\r\n")
+		}
+
+		var session smtpSession
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadLine()
+			if err != nil {
+				return
+			}
+			verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+			switch verb {
+			case "EHLO":
+				lines := []string{"250-localhost"}
+				for _, ext := range extensions {
+					lines = append(lines, "250-"+ext)
+				}
+				lines = append(lines, "250 HELP")
+				reply(lines...)
+			case "MAIL":
+				session.mailFrom = line
+				reply("250 OK")
+			case "RCPT":
+				session.rcptTo = append(session.rcptTo, line)
+				reply("250 OK")
+			case "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				data, err := r.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				session.data = data
+				reply("250 OK")
+			case "QUIT":
+				reply("221 Bye")
+				sessions <- session
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+	return ln.Addr().String(), sessions
+}
+
+func newTestSMTPSender(t *testing.T, addr string) *SMTPSender {
+	t.Helper()
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		t.Fatalf("split addr: %v", err)
+	}
+	s := NewSMTPSender(host, port, "", "", "noreply@example.com", "https://example.com/")
+	s.now = func() time.Time { return time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC) }
+	return s
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	testCases := []struct {
+		name         string
+		extensions   []string
+		wantEncoding string
+		wantBodyFlag bool
+	}{
+		{"8BITMIME 対応サーバー", []string{"8BITMIME"}, "8bit", true},
+		{"8BITMIME 非対応サーバー", nil, "base64", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, sessions := startFakeSMTPServer(t, tc.extensions...)
+			sender := newTestSMTPSender(t, addr)
+
+			n := &domain.Notification{Title: "値下げのお知らせ", Body: "お気に入りの商品が値下がりしました。", ProductID: 42}
+			if err := sender.Send(domain.Recipient{Email: "hanako@example.com", Name: "花子"}, n); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var session smtpSession
+			select {
+			case session = <-sessions:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for the SMTP session")
+			}
+
+			// エンベロープ
+			if !strings.Contains(session.mailFrom, "<noreply@example.com>") {
+				t.Errorf("unexpected MAIL FROM: %q", session.mailFrom)
+			}
+			if got := strings.Contains(session.mailFrom, "BODY=8BITMIME"); got != tc.wantBodyFlag {
+				t.Errorf("expected BODY=8BITMIME %v, got MAIL FROM %q", tc.wantBodyFlag, session.mailFrom)
+			}
+			if len(session.rcptTo) != 1 || !strings.Contains(session.rcptTo[0], "<hanako@example.com>") {
+				t.Errorf("unexpected RCPT TO: %v", session.rcptTo)
+			}
+
+			// ヘッダー
+			msg, err := mail.ReadMessage(strings.NewReader(string(session.data)))
+			if err != nil {
+				t.Fatalf("parse message: %v", err)
+			}
+			subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+			if err != nil || subject != n.Title {
+				t.Errorf("expected subject %q, got %q (err %v)", n.Title, subject, err)
+			}
+			if msg.Header.Get("Subject") == n.Title {
+				t.Error("expected the non-ASCII subject to be MIME encoded")
+			}
+			if got := msg.Header.Get("To"); got != "hanako@example.com" {
+				t.Errorf("unexpected To header: %q", got)
+			}
+			if got := msg.Header.Get("Content-Type"); got != "text/plain; charset=UTF-8" {
+				t.Errorf("unexpected Content-Type: %q", got)
+			}
+			if got := msg.Header.Get("Content-Transfer-Encoding"); got != tc.wantEncoding {
+				t.Fatalf("expected %s transfer encoding, got %q", tc.wantEncoding, got)
+			}
+
+			// 本文
+			raw, err := io.ReadAll(msg.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			body := string(raw)
+			if tc.wantEncoding == "base64" {
+				// ReadDotBytes は行末を LF に正規化する
+				for _, line := range strings.Split(strings.TrimRight(body, "\n"), "\n") {
+					if len(line) > base64LineLength {
+						t.Errorf("expected base64 lines of at most %d characters, got %d", base64LineLength, len(line))
+					}
+				}
+				decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\n", ""))
+				if err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				body = string(decoded)
+			}
+			for _, want := range []string{"花子 様", n.Body, "https://example.com/products/42"} {
+				if !strings.Contains(body, want) {
+					t.Errorf("expected body to contain %q, got %q", want, body)
+				}
+			}
+		})
+	}
+}
+
+func TestSMTPSender_Send_RequiresEmail(t *testing.T) {
+	sender := NewSMTPSender("127.0.0.1", "25", "", "", "noreply@example.com", "")
+	if err := sender.Send(domain.Recipient{Name: "花子"}, &domain.Notification{Title: "x"}); err == nil {
+		t.Error("expected error for a recipient without an email address")
+	}
+}
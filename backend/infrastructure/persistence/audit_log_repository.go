@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"backend/domain/audit"
+
+	"gorm.io/gorm"
+)
+
+// auditLogListLimit - 監査ログの既定の取得件数
+const auditLogListLimit = 100
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository - 監査ログリポジトリの生成
+func NewAuditLogRepository(db *gorm.DB) audit.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Create(e *audit.Entry) error {
+	return r.db.Create(e).Error
+}
+
+func (r *auditLogRepository) Find(filter audit.Filter) ([]audit.Entry, error) {
+	query := r.db.Model(&audit.Entry{})
+	if filter.AdminID != 0 {
+		query = query.Where("admin_id = ?", filter.AdminID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = auditLogListLimit
+	}
+
+	var entries []audit.Entry
+	if err := query.Order("id DESC").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
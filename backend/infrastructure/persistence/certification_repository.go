@@ -0,0 +1,36 @@
+package persistence
+
+import (
+	"time"
+
+	"backend/domain/product"
+
+	"gorm.io/gorm"
+)
+
+type certificationRepository struct {
+	db *gorm.DB
+}
+
+// NewCertificationRepository - 商品認証リポジトリの生成
+func NewCertificationRepository(db *gorm.DB) product.CertificationRepository {
+	return &certificationRepository{db: db}
+}
+
+func (r *certificationRepository) FindExpired() ([]product.Certification, error) {
+	var certifications []product.Certification
+	if err := r.db.Where("is_expired = ?", true).Order("expires_at DESC, id ASC").Find(&certifications).Error; err != nil {
+		return nil, err
+	}
+	return certifications, nil
+}
+
+func (r *certificationRepository) MarkExpired(now time.Time) (int64, error) {
+	result := r.db.Model(&product.Certification{}).
+		Where("is_expired = ? AND expires_at IS NOT NULL AND expires_at < ?", false, now).
+		Updates(map[string]interface{}{
+			"is_expired": true,
+			"updated_at": now,
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"time"
+
+	"backend/domain/click"
+
+	"gorm.io/gorm"
+)
+
+type clickRepository struct {
+	db *gorm.DB
+}
+
+// NewClickRepository - クリックログリポジトリの生成
+func NewClickRepository(db *gorm.DB) click.ClickRepository {
+	return &clickRepository{db: db}
+}
+
+func (r *clickRepository) Create(c *click.Click) error {
+	return r.db.Create(c).Error
+}
+
+func (r *clickRepository) CountDaily(from, to time.Time, productID *int64) ([]click.DailyCount, error) {
+	query := r.db.Table("affiliate_clicks").
+		Select(`TO_CHAR(affiliate_clicks.clicked_at, 'YYYY-MM-DD') AS date,
+			affiliate_clicks.product_id,
+			products.name_ja AS product_name,
+			affiliate_clicks.store,
+			COUNT(*) AS clicks,
+			COUNT(DISTINCT affiliate_clicks.visitor_id) AS unique_visitors`).
+		Joins("JOIN products ON products.id = affiliate_clicks.product_id").
+		Where("affiliate_clicks.clicked_at >= ? AND affiliate_clicks.clicked_at < ?", from, to)
+	if productID != nil {
+		query = query.Where("affiliate_clicks.product_id = ?", *productID)
+	}
+
+	var counts []click.DailyCount
+	if err := query.
+		Group("date, affiliate_clicks.product_id, products.name_ja, affiliate_clicks.store").
+		Order("date DESC, clicks DESC, affiliate_clicks.product_id ASC, affiliate_clicks.store ASC").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
@@ -0,0 +1,123 @@
+package persistence
+
+import (
+	"errors"
+
+	"backend/domain/favorite"
+
+	"gorm.io/gorm"
+)
+
+type collectionRepository struct {
+	db *gorm.DB
+}
+
+// NewCollectionRepository - お気に入りリストリポジトリの生成
+func NewCollectionRepository(db *gorm.DB) favorite.CollectionRepository {
+	return &collectionRepository{db: db}
+}
+
+// preloadItems - リスト内の商品を並び順で読み込む
+func (r *collectionRepository) preloadItems(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC, id ASC")
+	}).Preload("Items.Product").Preload("Items.Product.Categories")
+}
+
+func (r *collectionRepository) FindByCustomerID(customerID int64) ([]favorite.Collection, error) {
+	var collections []favorite.Collection
+	if err := r.preloadItems(r.db).Where("customer_id = ?", customerID).
+		Order("is_default DESC, created_at ASC, id ASC").Find(&collections).Error; err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+func (r *collectionRepository) FindByID(id int64) (*favorite.Collection, error) {
+	var c favorite.Collection
+	if err := r.preloadItems(r.db).First(&c, "id = ?", id).Error; err != nil {
+		return nil, collectionLookupError(err)
+	}
+	return &c, nil
+}
+
+func (r *collectionRepository) FindByShareToken(token string) (*favorite.Collection, error) {
+	var c favorite.Collection
+	if err := r.preloadItems(r.db).Where("share_token = ? AND is_public = ?", token, true).First(&c).Error; err != nil {
+		return nil, collectionLookupError(err)
+	}
+	return &c, nil
+}
+
+func (r *collectionRepository) FindDefault(customerID int64) (*favorite.Collection, error) {
+	var c favorite.Collection
+	if err := r.db.Where("customer_id = ? AND is_default = ?", customerID, true).First(&c).Error; err != nil {
+		return nil, collectionLookupError(err)
+	}
+	return &c, nil
+}
+
+func (r *collectionRepository) Create(c *favorite.Collection) error {
+	return r.db.Omit("Items").Create(c).Error
+}
+
+func (r *collectionRepository) Update(c *favorite.Collection) error {
+	return r.db.Omit("Items").Save(c).Error
+}
+
+func (r *collectionRepository) Delete(id int64) error {
+	return r.db.Delete(&favorite.Collection{}, "id = ?", id).Error
+}
+
+func (r *collectionRepository) AddItem(item *favorite.CollectionItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 末尾に追加
+		var maxPosition *int
+		if err := tx.Model(&favorite.CollectionItem{}).Where("collection_id = ?", item.CollectionID).
+			Select("MAX(position)").Scan(&maxPosition).Error; err != nil {
+			return err
+		}
+		item.Position = 0
+		if maxPosition != nil {
+			item.Position = *maxPosition + 1
+		}
+		return tx.Omit("Product").Create(item).Error
+	})
+}
+
+func (r *collectionRepository) UpdateItem(item *favorite.CollectionItem) error {
+	return r.db.Model(&favorite.CollectionItem{}).Where("id = ?", item.ID).
+		Updates(map[string]interface{}{"note": item.Note, "position": item.Position}).Error
+}
+
+func (r *collectionRepository) RemoveItem(collectionID, productID int64) error {
+	return r.db.Where("collection_id = ? AND product_id = ?", collectionID, productID).
+		Delete(&favorite.CollectionItem{}).Error
+}
+
+func (r *collectionRepository) RemoveProduct(customerID, productID int64) error {
+	return r.db.Where("product_id = ? AND collection_id IN (?)", productID,
+		r.db.Model(&favorite.Collection{}).Select("id").Where("customer_id = ?", customerID),
+	).Delete(&favorite.CollectionItem{}).Error
+}
+
+func (r *collectionRepository) ReorderItems(collectionID int64, productIDs []int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for position, productID := range productIDs {
+			if err := tx.Model(&favorite.CollectionItem{}).
+				Where("collection_id = ? AND product_id = ?", collectionID, productID).
+				Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// collectionLookupError - レコードなしをドメインのエラーに変換
+func collectionLookupError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return favorite.ErrCollectionNotFound
+	}
+	return err
+}
@@ -1,9 +1,13 @@
 package persistence
 
 import (
+	"errors"
+	"time"
+
 	"backend/domain/customer"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type customerRepository struct {
@@ -16,8 +20,19 @@ func NewCustomerRepository(db *gorm.DB) customer.CustomerRepository {
 }
 
 func (r *customerRepository) FindByID(id int64) (*customer.Customer, error) {
+	return r.find(r.db, id)
+}
+
+func (r *customerRepository) FindByIDForUpdate(id int64) (*customer.Customer, error) {
+	return r.find(r.db.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *customerRepository) find(db *gorm.DB, id int64) (*customer.Customer, error) {
 	var c customer.Customer
-	if err := r.db.First(&c, "id = ?", id).Error; err != nil {
+	if err := db.First(&c, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, customer.ErrCustomerNotFound
+		}
 		return nil, err
 	}
 	return &c, nil
@@ -55,10 +70,23 @@ func (r *customerRepository) FindAllWithReviewCount() ([]customer.Customer, map[
 	return customers, reviewCounts, nil
 }
 
+func (r *customerRepository) FindSuspensionExpired(now time.Time) ([]customer.Customer, error) {
+	var customers []customer.Customer
+	if err := r.db.Where("status = ? AND suspended_until <= ?", customer.StatusSuspended, now).
+		Order("id ASC").Find(&customers).Error; err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
+
 func (r *customerRepository) Create(c *customer.Customer) error {
 	return r.db.Create(c).Error
 }
 
 func (r *customerRepository) Update(c *customer.Customer) error {
+	if c.IsAnonymized() {
+		// 退会済みの google_id / email は NULL のまま（UNIQUE 制約のため空文字で上書きしない）
+		return r.db.Omit("GoogleID", "Email").Save(c).Error
+	}
 	return r.db.Save(c).Error
 }
@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	"time"
+
+	"backend/domain/customer"
+
+	"gorm.io/gorm"
+)
+
+type erasureRepository struct {
+	db *gorm.DB
+}
+
+// NewErasureRepository - 退会（匿名化）リポジトリの生成
+func NewErasureRepository(db *gorm.DB) customer.ErasureRepository {
+	return &erasureRepository{db: db}
+}
+
+func (r *erasureRepository) FindDueForErasure(now time.Time) ([]customer.Customer, error) {
+	var customers []customer.Customer
+	if err := r.db.
+		Where("anonymized_at IS NULL AND deletion_scheduled_at <= ?", now).
+		Order("deletion_scheduled_at").
+		Find(&customers).Error; err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
+
+func (r *erasureRepository) Erase(c *customer.Customer) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// google_id / email は UNIQUE のため空文字ではなく NULL にする
+		if err := tx.Omit("GoogleID", "Email").Save(c).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&customer.Customer{}).Where("id = ?", c.ID).
+			Updates(map[string]interface{}{"google_id": nil, "email": nil}).Error; err != nil {
+			return err
+		}
+		// 商品の評価集計のため評価は残し、本文だけ消す
+		if err := tx.Model(&reviewModel{}).Where("customer_id = ?", c.ID).
+			Update("comment", nil).Error; err != nil {
+			return err
+		}
+		// スレッドの構造を保つため返信は残し、本文だけ消す
+		if err := tx.Model(&replyModel{}).Where("customer_id = ?", c.ID).
+			Update("body", nil).Error; err != nil {
+			return err
+		}
+		// 回答・採用を保つため質問と回答も本文だけ消す
+		for _, model := range []interface{}{&questionModel{}, &answerModel{}} {
+			if err := tx.Model(model).Where("customer_id = ?", c.ID).
+				Update("body", nil).Error; err != nil {
+				return err
+			}
+		}
+		// 審査済みの修正提案は商品の変更履歴として残し、理由だけ消す
+		if err := tx.Exec("UPDATE product_edit_suggestions SET comment = '' WHERE customer_id = ?", c.ID).Error; err != nil {
+			return err
+		}
+		for _, query := range []string{
+			"DELETE FROM product_edit_suggestions WHERE customer_id = ? AND status = 'pending'",
+			"DELETE FROM product_submissions WHERE customer_id = ? AND status = 'pending'",
+			"DELETE FROM favorites WHERE customer_id = ?",
+			"DELETE FROM favorite_collections WHERE customer_id = ?",
+			"DELETE FROM notifications WHERE customer_id = ?",
+			"DELETE FROM notification_preferences WHERE customer_id = ?",
+			"DELETE FROM customer_recommendations WHERE customer_id = ?",
+			"DELETE FROM answer_upvotes WHERE customer_id = ?",
+		} {
+			if err := tx.Exec(query, c.ID).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Exec("DELETE FROM customer_follows WHERE follower_id = ? OR followee_id = ?", c.ID, c.ID).Error
+	})
+}
@@ -1,6 +1,8 @@
 package persistence
 
 import (
+	"errors"
+
 	"backend/domain/favorite"
 
 	"gorm.io/gorm"
@@ -26,6 +28,9 @@ func (r *favoriteRepository) FindByCustomerID(customerID int64) ([]favorite.Favo
 func (r *favoriteRepository) FindByCustomerIDAndProductID(customerID, productID int64) (*favorite.Favorite, error) {
 	var fav favorite.Favorite
 	if err := r.db.Where("customer_id = ? AND product_id = ?", customerID, productID).First(&fav).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, favorite.ErrFavoriteNotFound
+		}
 		return nil, err
 	}
 	return &fav, nil
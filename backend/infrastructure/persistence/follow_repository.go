@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"backend/domain/customer"
+	"backend/domain/follow"
+	"backend/domain/review"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type followRepository struct {
+	db *gorm.DB
+}
+
+// NewFollowRepository - フォローリポジトリの生成
+func NewFollowRepository(db *gorm.DB) follow.FollowRepository {
+	return &followRepository{db: db}
+}
+
+func (r *followRepository) Create(f *follow.Follow) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(f).Error
+}
+
+func (r *followRepository) Delete(followerID, followeeID int64) error {
+	return r.db.Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Delete(&follow.Follow{}).Error
+}
+
+func (r *followRepository) Exists(followerID, followeeID int64) (bool, error) {
+	var count int64
+	if err := r.db.Model(&follow.Follow{}).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *followRepository) Counts(customerID int64) (follow.Counts, error) {
+	var counts follow.Counts
+	if err := r.db.Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM customer_follows WHERE followee_id = ?) AS followers,
+			(SELECT COUNT(*) FROM customer_follows WHERE follower_id = ?) AS following`,
+		customerID, customerID,
+	).Scan(&counts).Error; err != nil {
+		return follow.Counts{}, err
+	}
+	return counts, nil
+}
+
+// FindFeed - フォロー中のカスタマーのレビューを1回の JOIN で取得し、reviews.id の降順に limit 件（keyset ページング）
+func (r *followRepository) FindFeed(followerID, beforeID int64, limit int) ([]review.Review, error) {
+	query := r.db.
+		Joins("JOIN customer_follows ON customer_follows.followee_id = reviews.customer_id AND customer_follows.follower_id = ?", followerID).
+		Joins("JOIN customers ON customers.id = reviews.customer_id AND customers.profile_visibility = ? AND customers.anonymized_at IS NULL", customer.VisibilityPublic)
+	if beforeID > 0 {
+		query = query.Where("reviews.id < ?", beforeID)
+	}
+
+	var models []reviewModel
+	if err := query.Preload("Customer").Preload("Product").
+		Order("reviews.id DESC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	reviews := make([]review.Review, 0, len(models))
+	for _, m := range models {
+		e, err := m.toEntity()
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, *e)
+	}
+	if err := fillReplyCounts(r.db, reviews); err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
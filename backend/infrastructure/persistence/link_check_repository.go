@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"backend/domain/linkcheck"
+
+	"gorm.io/gorm"
+)
+
+type linkCheckRepository struct {
+	db *gorm.DB
+}
+
+// NewLinkCheckRepository - リンクチェック結果リポジトリの生成
+func NewLinkCheckRepository(db *gorm.DB) linkcheck.LinkCheckRepository {
+	return &linkCheckRepository{db: db}
+}
+
+func (r *linkCheckRepository) FindAll() ([]linkcheck.LinkCheck, error) {
+	var checks []linkcheck.LinkCheck
+	if err := r.db.Order("next_check_at ASC, id ASC").Find(&checks).Error; err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+func (r *linkCheckRepository) FindFailing() ([]linkcheck.LinkCheck, error) {
+	var checks []linkcheck.LinkCheck
+	if err := r.db.Select("product_link_checks.*, products.name_ja AS product_name").
+		Joins("JOIN products ON products.id = product_link_checks.product_id").
+		Where("product_link_checks.status <> ?", linkcheck.StatusOK).
+		Order("product_link_checks.consecutive_failures DESC, product_link_checks.last_checked_at DESC").
+		Find(&checks).Error; err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+func (r *linkCheckRepository) Save(check *linkcheck.LinkCheck) error {
+	return r.db.Save(check).Error
+}
+
+func (r *linkCheckRepository) Delete(id int64) error {
+	return r.db.Delete(&linkcheck.LinkCheck{}, "id = ?", id).Error
+}
@@ -0,0 +1,104 @@
+package persistence
+
+import (
+	"backend/domain/customer"
+	"backend/domain/notification"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository - 通知リポジトリの生成
+func NewNotificationRepository(db *gorm.DB) notification.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) FindSubscribers(productID int64) ([]notification.Recipient, error) {
+	var recipients []notification.Recipient
+	if err := r.db.Table("favorites").
+		Select("customers.id AS customer_id, customers.email, customers.name").
+		Joins("JOIN customers ON customers.id = favorites.customer_id").
+		Where("favorites.product_id = ? AND customers.status <> ?", productID, customer.StatusBanned).
+		Order("customers.id").
+		Scan(&recipients).Error; err != nil {
+		return nil, err
+	}
+	return recipients, nil
+}
+
+func (r *notificationRepository) FindPreferences(customerIDs []int64) ([]notification.Preference, error) {
+	var prefs []notification.Preference
+	if len(customerIDs) == 0 {
+		return prefs, nil
+	}
+	if err := r.db.Where("customer_id IN ?", customerIDs).Find(&prefs).Error; err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+func (r *notificationRepository) SavePreferences(prefs []notification.Preference) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "customer_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_app", "email"}),
+	}).Create(&prefs).Error
+}
+
+func (r *notificationRepository) Create(n *notification.Notification) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "customer_id"}, {Name: "event_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "event_id IS NOT NULL"}}},
+		DoNothing:   true,
+	}).Create(n).Error
+}
+
+func (r *notificationRepository) FindByCustomerID(customerID int64, unreadOnly bool, limit int) ([]notification.Notification, error) {
+	var notifications []notification.Notification
+	query := r.db.Where("customer_id = ?", customerID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(customerID int64) (int64, error) {
+	var count int64
+	err := r.db.Model(&notification.Notification{}).
+		Where("customer_id = ? AND read_at IS NULL", customerID).Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepository) MarkRead(customerID int64, ids []int64, at time.Time) (int64, error) {
+	query := r.db.Model(&notification.Notification{}).Where("customer_id = ? AND read_at IS NULL", customerID)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	result := query.Update("read_at", at)
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) FindDeliveries(eventID int64) ([]notification.Delivery, error) {
+	var deliveries []notification.Delivery
+	if err := r.db.Where("event_id = ?", eventID).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *notificationRepository) SaveDelivery(d *notification.Delivery) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "customer_id"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "attempts", "last_error", "sent_at", "updated_at"}),
+	}).Create(d).Error
+}
@@ -0,0 +1,69 @@
+package persistence
+
+import (
+	"errors"
+	"time"
+
+	"backend/domain/offer"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type offerRepository struct {
+	db *gorm.DB
+}
+
+// NewOfferRepository - ストア販売情報リポジトリの生成
+func NewOfferRepository(db *gorm.DB) offer.OfferRepository {
+	return &offerRepository{db: db}
+}
+
+func (r *offerRepository) FindByProductID(productID int64) ([]offer.Offer, error) {
+	var offers []offer.Offer
+	if err := r.db.Where("product_id = ?", productID).Order("price_amount ASC, store ASC").Find(&offers).Error; err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+func (r *offerRepository) FindByProductIDAndStore(productID int64, store string) (*offer.Offer, error) {
+	return r.find(r.db, productID, store)
+}
+
+func (r *offerRepository) FindByProductIDAndStoreForUpdate(productID int64, store string) (*offer.Offer, error) {
+	return r.find(r.db.Clauses(clause.Locking{Strength: "UPDATE"}), productID, store)
+}
+
+func (r *offerRepository) find(db *gorm.DB, productID int64, store string) (*offer.Offer, error) {
+	var o offer.Offer
+	if err := db.Where("product_id = ? AND store = ?", productID, store).First(&o).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, offer.ErrOfferNotFound
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+func (r *offerRepository) Save(o *offer.Offer, history *offer.PricePoint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(o).Error; err != nil {
+			return err
+		}
+		if history == nil {
+			return nil
+		}
+		history.OfferID = o.ID
+		return tx.Create(history).Error
+	})
+}
+
+func (r *offerRepository) FindHistory(productID int64, since time.Time) ([]offer.PricePoint, error) {
+	var points []offer.PricePoint
+	if err := r.db.Where("product_id = ? AND recorded_at >= ?", productID, since).
+		Order("recorded_at ASC, id ASC").Find(&points).Error; err != nil {
+		return nil, err
+	}
+	return points, nil
+}
@@ -0,0 +1,48 @@
+package persistence
+
+import (
+	"backend/domain/event"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type outboxRepository struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewOutboxRepository - outbox リポジトリの生成
+func NewOutboxRepository(db *gorm.DB) event.OutboxRepository {
+	return &outboxRepository{db: db, now: time.Now}
+}
+
+// Add - イベントを outbox に追加（UnitOfWork のトランザクション内で呼ばれる）
+func (r *outboxRepository) Add(events ...event.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := r.now()
+	messages := make([]*event.OutboxMessage, 0, len(events))
+	for _, e := range events {
+		m, err := event.NewOutboxMessage(e, now)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, m)
+	}
+	return r.db.Create(&messages).Error
+}
+
+func (r *outboxRepository) FindDue(now time.Time, limit int) ([]event.OutboxMessage, error) {
+	var messages []event.OutboxMessage
+	if err := r.db.Where("status = ? AND next_attempt_at <= ?", event.StatusPending, now).
+		Order("id ASC").Limit(limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (r *outboxRepository) Save(m *event.OutboxMessage) error {
+	return r.db.Save(m).Error
+}
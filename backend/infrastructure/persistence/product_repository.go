@@ -1,9 +1,12 @@
 package persistence
 
 import (
+	"errors"
+
 	"backend/domain/product"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type productRepository struct {
@@ -15,53 +18,162 @@ func NewProductRepository(db *gorm.DB) product.ProductRepository {
 	return &productRepository{db: db}
 }
 
-func (r *productRepository) FindAll(categoryID int64, search string) ([]product.Product, error) {
+func (r *productRepository) FindAll(filter product.ProductFilter) ([]product.Product, error) {
 	var products []product.Product
-	query := r.db.Preload("Categories")
+	query := r.preloadAssociations(r.db)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where("products.id IN ?", filter.IDs)
+	}
 
-	if categoryID > 0 {
+	if filter.CategoryID > 0 {
 		// 多対多: product_categories中間テーブルを経由してJOIN
 		query = query.Joins("JOIN product_categories ON product_categories.product_id = products.id").
-			Where("product_categories.category_id = ?", categoryID)
+			Where("product_categories.category_id = ?", filter.CategoryID)
+	}
+
+	if filter.Search != "" {
+		query = query.Where("products.name ILIKE ? OR products.name_ja ILIKE ?", "%"+filter.Search+"%", "%"+filter.Search+"%")
 	}
 
-	if search != "" {
-		query = query.Where("products.name ILIKE ? OR products.name_ja ILIKE ?", "%"+search+"%", "%"+search+"%")
+	if len(filter.DietaryAttributes) > 0 {
+		// 指定された属性をすべて含む商品のみ
+		query = query.Where("products.dietary_attributes @> ?", filter.DietaryAttributes)
+	}
+
+	if filter.CertifiedOnly {
+		query = query.Where("EXISTS (SELECT 1 FROM product_certifications pc WHERE pc.product_id = products.id AND pc.is_expired = FALSE)")
+	}
+
+	needsNutrition := filter.HighProtein || filter.MinProteinPer100g != nil || filter.MaxCaloriesPer100g != nil ||
+		filter.Sort == product.ProductSortProtein || filter.Sort == product.ProductSortCalories
+	if needsNutrition {
+		query = query.Joins("LEFT JOIN product_nutrition ON product_nutrition.product_id = products.id")
+	}
+	if filter.HighProtein {
+		query = query.Where("product_nutrition.calories_kcal > 0 AND product_nutrition.protein_grams * ? >= product_nutrition.calories_kcal * ?",
+			product.ProteinKcalPerGram, product.HighProteinEnergyRatio)
+	}
+	if filter.MinProteinPer100g != nil {
+		query = query.Where("product_nutrition.protein_per_100g >= ?", *filter.MinProteinPer100g)
+	}
+	if filter.MaxCaloriesPer100g != nil {
+		query = query.Where("product_nutrition.calories_per_100g <= ?", *filter.MaxCaloriesPer100g)
+	}
+
+	switch filter.Sort {
+	case product.ProductSortRating:
+		query = query.Order("products.rating DESC, products.review_count DESC")
+	case product.ProductSortProtein:
+		query = query.Order("product_nutrition.protein_per_100g DESC NULLS LAST")
+	case product.ProductSortCalories:
+		query = query.Order("product_nutrition.calories_per_100g ASC NULLS LAST")
 	}
 
 	if err := query.Order("products.created_at DESC, products.id ASC").Find(&products).Error; err != nil {
 		return nil, err
 	}
+	for i := range products {
+		products[i].ProjectLegacyURLs()
+	}
 	return products, nil
 }
 
 func (r *productRepository) FindByID(id int64) (*product.Product, error) {
+	return r.find(r.db, id)
+}
+
+func (r *productRepository) FindByIDForUpdate(id int64) (*product.Product, error) {
+	return r.find(r.db.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *productRepository) find(db *gorm.DB, id int64) (*product.Product, error) {
 	var p product.Product
-	if err := r.db.Preload("Categories").First(&p, "id = ?", id).Error; err != nil {
+	if err := r.preloadAssociations(db).First(&p, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, product.ErrProductNotFound
+		}
 		return nil, err
 	}
+	p.ProjectLegacyURLs()
 	return &p, nil
 }
 
+// preloadAssociations - 商品の関連を読み込む（ストアリンクは表示順）
+func (r *productRepository) preloadAssociations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Categories").
+		Preload("Certifications").
+		Preload("Nutrition").
+		Preload("StoreLinks", func(db *gorm.DB) *gorm.DB {
+			return db.Joins("JOIN stores ON stores.id = product_store_links.store_id").
+				Order("stores.display_order ASC, stores.id ASC")
+		}).
+		Preload("StoreLinks.Store")
+}
+
 func (r *productRepository) Create(p *product.Product) error {
-	return r.db.Create(p).Error
+	setNormalizedNames(p)
+	return r.db.Omit("StoreLinks.Store").Create(p).Error
 }
 
 func (r *productRepository) Update(p *product.Product) error {
+	setNormalizedNames(p)
 	// トランザクション内でカテゴリーの関連を更新
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		// 商品の基本情報を更新
-		if err := tx.Save(p).Error; err != nil {
+		// 商品の基本情報を更新（関連は下で個別に置き換える）
+		if err := tx.Omit("Categories", "Certifications", "Nutrition", "StoreLinks").Save(p).Error; err != nil {
 			return err
 		}
 		// カテゴリーの関連を置き換え
 		if err := tx.Model(p).Association("Categories").Replace(p.Categories); err != nil {
 			return err
 		}
-		return nil
+		// 認証情報を置き換え（既存を削除して再作成）
+		if err := tx.Where("product_id = ?", p.ID).Delete(&product.Certification{}).Error; err != nil {
+			return err
+		}
+		for i := range p.Certifications {
+			p.Certifications[i].ID = 0
+			p.Certifications[i].ProductID = p.ID
+		}
+		if len(p.Certifications) > 0 {
+			if err := tx.Create(&p.Certifications).Error; err != nil {
+				return err
+			}
+		}
+		// ストアリンクを置き換え
+		if err := tx.Where("product_id = ?", p.ID).Delete(&product.StoreLink{}).Error; err != nil {
+			return err
+		}
+		for i := range p.StoreLinks {
+			p.StoreLinks[i].ProductID = p.ID
+		}
+		if len(p.StoreLinks) > 0 {
+			if err := tx.Omit("Store").Create(&p.StoreLinks).Error; err != nil {
+				return err
+			}
+		}
+		// 栄養成分を登録または削除
+		if p.Nutrition == nil {
+			return tx.Where("product_id = ?", p.ID).Delete(&product.Nutrition{}).Error
+		}
+		p.Nutrition.ProductID = p.ID
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "product_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"basis", "serving_size_grams", "calories_kcal", "protein_grams",
+				"fat_grams", "carbohydrate_grams", "salt_grams", "updated_at",
+			}),
+		}).Create(p.Nutrition).Error
 	})
 }
 
+// setNormalizedNames - 重複判定用の正規化した名前を product.NormalizeName で設定（SQL では正規化しない）
+func setNormalizedNames(p *product.Product) {
+	name, nameJa := product.NormalizeName(p.Name), product.NormalizeName(p.NameJa)
+	p.NormalizedName, p.NormalizedNameJa = &name, &nameJa
+}
+
 func (r *productRepository) Delete(id int64) error {
 	return r.db.Delete(&product.Product{}, "id = ?", id).Error
 }
@@ -0,0 +1,256 @@
+package persistence
+
+import (
+	"errors"
+	"time"
+
+	"backend/domain/customer"
+	"backend/domain/question"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// questionModel - GORM用のDBモデル（プリミティブ型）
+type questionModel struct {
+	ID               int64              `gorm:"primaryKey;autoIncrement"`
+	ProductID        int64              `gorm:"column:product_id"`
+	CustomerID       int64              `gorm:"column:customer_id"`
+	Customer         *customer.Customer `gorm:"foreignKey:CustomerID"`
+	Body             string             `gorm:"column:body"`
+	AcceptedAnswerID *int64             `gorm:"column:accepted_answer_id"`
+	CreatedAt        time.Time          `gorm:"column:created_at"`
+}
+
+func (questionModel) TableName() string {
+	return "product_questions"
+}
+
+// toEntity - DBモデル → ドメインEntity変換
+func (m *questionModel) toEntity() *question.Question {
+	q := &question.Question{
+		ID:               m.ID,
+		ProductID:        m.ProductID,
+		CustomerID:       m.CustomerID,
+		Body:             question.Body(m.Body),
+		AcceptedAnswerID: m.AcceptedAnswerID,
+		CreatedAt:        m.CreatedAt,
+	}
+	if m.Customer != nil {
+		q.Author = m.Customer.Reviewer()
+	}
+	return q
+}
+
+// answerModel - GORM用のDBモデル（プリミティブ型）
+type answerModel struct {
+	ID         int64              `gorm:"primaryKey;autoIncrement"`
+	QuestionID int64              `gorm:"column:question_id"`
+	CustomerID *int64             `gorm:"column:customer_id"`
+	Customer   *customer.Customer `gorm:"foreignKey:CustomerID"`
+	AdminID    *int64             `gorm:"column:admin_id"`
+	IsOfficial bool               `gorm:"column:is_official"`
+	Body       string             `gorm:"column:body"`
+	CreatedAt  time.Time          `gorm:"column:created_at"`
+}
+
+func (answerModel) TableName() string {
+	return "product_answers"
+}
+
+// toEntity - DBモデル → ドメインEntity変換
+func (m *answerModel) toEntity() *question.Answer {
+	a := &question.Answer{
+		ID:         m.ID,
+		QuestionID: m.QuestionID,
+		CustomerID: m.CustomerID,
+		AdminID:    m.AdminID,
+		IsOfficial: m.IsOfficial,
+		Body:       question.Body(m.Body),
+		CreatedAt:  m.CreatedAt,
+	}
+	if m.Customer != nil {
+		a.Author = m.Customer.Reviewer()
+	}
+	return a
+}
+
+type questionRepository struct {
+	db *gorm.DB
+}
+
+// NewQuestionRepository - 商品への質問リポジトリの生成
+func NewQuestionRepository(db *gorm.DB) question.QuestionRepository {
+	return &questionRepository{db: db}
+}
+
+func (r *questionRepository) FindByProductID(productID int64) ([]question.Question, error) {
+	var models []questionModel
+	if err := r.db.Preload("Customer").Where("product_id = ?", productID).Order("id DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	questions := make([]question.Question, 0, len(models))
+	ids := make([]int64, 0, len(models))
+	for _, m := range models {
+		questions = append(questions, *m.toEntity())
+		ids = append(ids, m.ID)
+	}
+	if len(ids) == 0 {
+		return questions, nil
+	}
+
+	// N+1 を避けて回答数を1クエリで集計
+	var rows []struct {
+		QuestionID int64
+		Count      int
+	}
+	if err := r.db.Model(&answerModel{}).
+		Select("question_id, COUNT(*) AS count").
+		Where("question_id IN ?", ids).
+		Group("question_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.QuestionID] = row.Count
+	}
+	for i := range questions {
+		questions[i].AnswerCount = counts[questions[i].ID]
+	}
+	return questions, nil
+}
+
+func (r *questionRepository) FindByID(id int64) (*question.Question, error) {
+	var model questionModel
+	if err := r.db.Preload("Customer").First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, question.ErrQuestionNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *questionRepository) Create(q *question.Question) error {
+	model := &questionModel{
+		ProductID:  q.ProductID,
+		CustomerID: q.CustomerID,
+		Body:       string(q.Body),
+	}
+	if err := r.db.Omit("Customer").Create(model).Error; err != nil {
+		return err
+	}
+
+	// Reload with Customer
+	if err := r.db.Preload("Customer").First(model, "id = ?", model.ID).Error; err != nil {
+		return err
+	}
+	*q = *model.toEntity()
+	return nil
+}
+
+func (r *questionRepository) SetAcceptedAnswer(questionID int64, answerID *int64) error {
+	return r.db.Model(&questionModel{}).Where("id = ?", questionID).Update("accepted_answer_id", answerID).Error
+}
+
+// Delete - 質問を削除（回答と賛成は外部キーの ON DELETE CASCADE で削除）
+func (r *questionRepository) Delete(id int64) error {
+	return r.db.Delete(&questionModel{}, "id = ?", id).Error
+}
+
+type answerRepository struct {
+	db *gorm.DB
+}
+
+// NewAnswerRepository - 質問への回答リポジトリの生成
+func NewAnswerRepository(db *gorm.DB) question.AnswerRepository {
+	return &answerRepository{db: db}
+}
+
+func (r *answerRepository) FindByQuestionID(questionID, viewerID int64) ([]question.Answer, error) {
+	var models []answerModel
+	if err := r.db.Preload("Customer").Where("question_id = ?", questionID).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	answers := make([]question.Answer, 0, len(models))
+	ids := make([]int64, 0, len(models))
+	for _, m := range models {
+		answers = append(answers, *m.toEntity())
+		ids = append(ids, m.ID)
+	}
+	if len(ids) == 0 {
+		return answers, nil
+	}
+
+	// 賛成数と閲覧者の賛成有無を1クエリで集計
+	var rows []struct {
+		AnswerID int64
+		Count    int
+		Upvoted  bool
+	}
+	if err := r.db.Model(&question.Upvote{}).
+		Select("answer_id, COUNT(*) AS count, BOOL_OR(customer_id = ?) AS upvoted", viewerID).
+		Where("answer_id IN ?", ids).
+		Group("answer_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	byAnswer := make(map[int64]int, len(rows))
+	for i, row := range rows {
+		byAnswer[row.AnswerID] = i
+	}
+	for i := range answers {
+		if j, ok := byAnswer[answers[i].ID]; ok {
+			answers[i].UpvoteCount = rows[j].Count
+			answers[i].HasUpvoted = rows[j].Upvoted
+		}
+	}
+	return answers, nil
+}
+
+func (r *answerRepository) FindByID(id int64) (*question.Answer, error) {
+	var model answerModel
+	if err := r.db.Preload("Customer").First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, question.ErrAnswerNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *answerRepository) Create(a *question.Answer) error {
+	model := &answerModel{
+		QuestionID: a.QuestionID,
+		CustomerID: a.CustomerID,
+		AdminID:    a.AdminID,
+		IsOfficial: a.IsOfficial,
+		Body:       string(a.Body),
+	}
+	if err := r.db.Omit("Customer").Create(model).Error; err != nil {
+		return err
+	}
+
+	// Reload with Customer
+	if err := r.db.Preload("Customer").First(model, "id = ?", model.ID).Error; err != nil {
+		return err
+	}
+	*a = *model.toEntity()
+	return nil
+}
+
+// Delete - 回答を削除（採用されていた場合は accepted_answer_id が ON DELETE SET NULL で外れる）
+func (r *answerRepository) Delete(id int64) error {
+	return r.db.Delete(&answerModel{}, "id = ?", id).Error
+}
+
+func (r *answerRepository) Upvote(u *question.Upvote) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(u).Error
+}
+
+func (r *answerRepository) RemoveUpvote(answerID, customerID int64) error {
+	return r.db.Where("answer_id = ? AND customer_id = ?", answerID, customerID).Delete(&question.Upvote{}).Error
+}
@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"time"
+
+	"backend/domain/ranking"
+
+	"gorm.io/gorm"
+)
+
+type rankingRepository struct {
+	db *gorm.DB
+}
+
+// NewRankingRepository - ランキングリポジトリの生成
+func NewRankingRepository(db *gorm.DB) ranking.RankingRepository {
+	return &rankingRepository{db: db}
+}
+
+func (r *rankingRepository) FindActivity(since time.Time) ([]ranking.Activity, error) {
+	var activities []ranking.Activity
+	if err := r.db.Raw(`
+		SELECT product_id,
+			SUM(reviews) AS reviews,
+			SUM(rating_sum) AS rating_sum,
+			SUM(favorites) AS favorites
+		FROM (
+			SELECT product_id, COUNT(*) AS reviews, SUM(rating) AS rating_sum, 0 AS favorites
+			FROM reviews WHERE created_at >= ? GROUP BY product_id
+			UNION ALL
+			SELECT product_id, 0, 0, COUNT(*)
+			FROM favorites WHERE created_at >= ? GROUP BY product_id
+		) activity
+		GROUP BY product_id`, since, since,
+	).Scan(&activities).Error; err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+func (r *rankingRepository) Replace(t ranking.Type, entries []ranking.Entry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("type = ?", t).Delete(&ranking.Entry{}).Error; err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		return tx.Create(&entries).Error
+	})
+}
+
+func (r *rankingRepository) Find(t ranking.Type, categoryID int64) ([]ranking.Entry, error) {
+	var entries []ranking.Entry
+	if err := r.db.Where("type = ? AND category_id = ?", t, categoryID).Order("rank ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"backend/domain/recommendation"
+
+	"gorm.io/gorm"
+)
+
+// recommendationBatchSize - 一括登録のバッチサイズ
+const recommendationBatchSize = 500
+
+type recommendationRepository struct {
+	db *gorm.DB
+}
+
+// NewRecommendationRepository - 推薦リポジトリの生成
+func NewRecommendationRepository(db *gorm.DB) recommendation.RecommendationRepository {
+	return &recommendationRepository{db: db}
+}
+
+func (r *recommendationRepository) FindInteractions() ([]recommendation.Interaction, error) {
+	var interactions []recommendation.Interaction
+	if err := r.db.Raw(`
+		SELECT customer_id, product_id FROM favorites
+		UNION
+		SELECT customer_id, product_id FROM reviews WHERE rating >= ?`,
+		recommendation.HighRatingThreshold,
+	).Scan(&interactions).Error; err != nil {
+		return nil, err
+	}
+	return interactions, nil
+}
+
+func (r *recommendationRepository) ReplaceAll(similar []recommendation.SimilarProduct, recommendations []recommendation.Recommendation) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM product_similarities").Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM customer_recommendations").Error; err != nil {
+			return err
+		}
+		if len(similar) > 0 {
+			if err := tx.CreateInBatches(similar, recommendationBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if len(recommendations) > 0 {
+			if err := tx.CreateInBatches(recommendations, recommendationBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *recommendationRepository) FindSimilar(productID int64, limit int) ([]recommendation.SimilarProduct, error) {
+	var similar []recommendation.SimilarProduct
+	if err := r.db.Where("product_id = ?", productID).
+		Order("rank ASC").Limit(limit).
+		Find(&similar).Error; err != nil {
+		return nil, err
+	}
+	return similar, nil
+}
+
+func (r *recommendationRepository) FindByCustomerID(customerID int64, limit int) ([]recommendation.Recommendation, error) {
+	var recommendations []recommendation.Recommendation
+	if err := r.db.Where("customer_id = ?", customerID).
+		Order("rank ASC").Limit(limit).
+		Find(&recommendations).Error; err != nil {
+		return nil, err
+	}
+	return recommendations, nil
+}
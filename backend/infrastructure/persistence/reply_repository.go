@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"errors"
+	"time"
+
+	"backend/domain/customer"
+	"backend/domain/review"
+
+	"gorm.io/gorm"
+)
+
+// replyModel - GORM用のDBモデル（プリミティブ型）
+type replyModel struct {
+	ID         int64              `gorm:"primaryKey;autoIncrement"`
+	ReviewID   int64              `gorm:"column:review_id"`
+	ParentID   *int64             `gorm:"column:parent_id"`
+	Depth      int                `gorm:"column:depth"`
+	CustomerID *int64             `gorm:"column:customer_id"`
+	Customer   *customer.Customer `gorm:"foreignKey:CustomerID"`
+	AdminID    *int64             `gorm:"column:admin_id"`
+	IsOfficial bool               `gorm:"column:is_official"`
+	Body       string             `gorm:"column:body"`
+	CreatedAt  time.Time          `gorm:"column:created_at"`
+}
+
+func (replyModel) TableName() string {
+	return "review_replies"
+}
+
+// toEntity - DBモデル → ドメインEntity変換
+func (m *replyModel) toEntity() *review.Reply {
+	r := &review.Reply{
+		ID:         m.ID,
+		ReviewID:   m.ReviewID,
+		ParentID:   m.ParentID,
+		Depth:      m.Depth,
+		CustomerID: m.CustomerID,
+		AdminID:    m.AdminID,
+		IsOfficial: m.IsOfficial,
+		Body:       review.ReplyBody(m.Body),
+		CreatedAt:  m.CreatedAt,
+	}
+	if m.Customer != nil {
+		r.Author = m.Customer.Reviewer()
+	}
+	return r
+}
+
+type replyRepository struct {
+	db *gorm.DB
+}
+
+// NewReplyRepository - レビュー返信リポジトリの生成
+func NewReplyRepository(db *gorm.DB) review.ReplyRepository {
+	return &replyRepository{db: db}
+}
+
+func (r *replyRepository) FindByReviewID(reviewID int64) ([]review.Reply, error) {
+	var models []replyModel
+	if err := r.db.Preload("Customer").Where("review_id = ?", reviewID).Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	replies := make([]review.Reply, 0, len(models))
+	for _, m := range models {
+		replies = append(replies, *m.toEntity())
+	}
+	return replies, nil
+}
+
+func (r *replyRepository) FindByID(id int64) (*review.Reply, error) {
+	var model replyModel
+	if err := r.db.Preload("Customer").First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, review.ErrReplyNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *replyRepository) Create(reply *review.Reply) error {
+	model := &replyModel{
+		ReviewID:   reply.ReviewID,
+		ParentID:   reply.ParentID,
+		Depth:      reply.Depth,
+		CustomerID: reply.CustomerID,
+		AdminID:    reply.AdminID,
+		IsOfficial: reply.IsOfficial,
+		Body:       string(reply.Body),
+	}
+	if err := r.db.Omit("Customer").Create(model).Error; err != nil {
+		return err
+	}
+
+	// Reload with Customer
+	if err := r.db.Preload("Customer").First(model, "id = ?", model.ID).Error; err != nil {
+		return err
+	}
+	*reply = *model.toEntity()
+	return nil
+}
+
+// Delete - 返信を削除（子の返信は外部キーの ON DELETE CASCADE で削除）
+func (r *replyRepository) Delete(id int64) error {
+	return r.db.Delete(&replyModel{}, "id = ?", id).Error
+}
+
+// fillReplyCounts - レビュー一覧に返信数を設定（N+1 を避けて1クエリで集計）
+func fillReplyCounts(db *gorm.DB, reviews []review.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(reviews))
+	for _, rev := range reviews {
+		ids = append(ids, rev.ID)
+	}
+
+	var rows []struct {
+		ReviewID int64
+		Count    int
+	}
+	if err := db.Model(&replyModel{}).
+		Select("review_id, COUNT(*) AS count").
+		Where("review_id IN ?", ids).
+		Group("review_id").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.ReviewID] = row.Count
+	}
+	for i := range reviews {
+		reviews[i].ReplyCount = counts[reviews[i].ID]
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package persistence
 
 import (
+	"errors"
 	"time"
 
 	"backend/domain/customer"
@@ -41,9 +42,11 @@ func (m *reviewModel) toEntity() (*review.Review, error) {
 		Comment:    comment,
 		CreatedAt:  m.CreatedAt,
 		UpdatedAt:  m.UpdatedAt,
-		Customer:   m.Customer,
 		Product:    m.Product,
 	}
+	if m.Customer != nil {
+		r.Customer = m.Customer.Reviewer()
+	}
 
 	return r, nil
 }
@@ -84,6 +87,9 @@ func (r *reviewRepository) FindAll() ([]review.Review, error) {
 		}
 		reviews = append(reviews, *e)
 	}
+	if err := fillReplyCounts(r.db, reviews); err != nil {
+		return nil, err
+	}
 	return reviews, nil
 }
 
@@ -101,6 +107,9 @@ func (r *reviewRepository) FindByProductID(productID int64) ([]review.Review, er
 		}
 		reviews = append(reviews, *e)
 	}
+	if err := fillReplyCounts(r.db, reviews); err != nil {
+		return nil, err
+	}
 	return reviews, nil
 }
 
@@ -118,12 +127,26 @@ func (r *reviewRepository) FindByCustomerID(customerID int64) ([]review.Review,
 		}
 		reviews = append(reviews, *e)
 	}
+	if err := fillReplyCounts(r.db, reviews); err != nil {
+		return nil, err
+	}
 	return reviews, nil
 }
 
+func (r *reviewRepository) CountByCustomerID(customerID int64) (int64, error) {
+	var count int64
+	if err := r.db.Model(&reviewModel{}).Where("customer_id = ?", customerID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r *reviewRepository) FindByID(id int64) (*review.Review, error) {
 	var model reviewModel
 	if err := r.db.First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, review.ErrReviewNotFound
+		}
 		return nil, err
 	}
 	return model.toEntity()
@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"backend/domain/customer"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type sanctionRepository struct {
+	db *gorm.DB
+}
+
+// NewSanctionRepository - 制裁履歴リポジトリの生成
+func NewSanctionRepository(db *gorm.DB) customer.SanctionRepository {
+	return &sanctionRepository{db: db}
+}
+
+func (r *sanctionRepository) FindByCustomerID(customerID int64) ([]customer.Sanction, error) {
+	var sanctions []customer.Sanction
+	if err := r.db.Where("customer_id = ?", customerID).
+		Order("starts_at DESC, id DESC").Find(&sanctions).Error; err != nil {
+		return nil, err
+	}
+	return sanctions, nil
+}
+
+func (r *sanctionRepository) FindActive(now time.Time) ([]customer.Sanction, error) {
+	var sanctions []customer.Sanction
+	if err := r.db.Where("lifted_at IS NULL AND starts_at <= ? AND (ends_at IS NULL OR ends_at > ?)", now, now).
+		Find(&sanctions).Error; err != nil {
+		return nil, err
+	}
+	return sanctions, nil
+}
+
+func (r *sanctionRepository) CountByCustomer() (map[int64]int, error) {
+	type row struct {
+		CustomerID int64
+		Count      int
+	}
+	var rows []row
+	if err := r.db.Model(&customer.Sanction{}).
+		Select("customer_id, COUNT(*) AS count").
+		Group("customer_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int, len(rows))
+	for _, r := range rows {
+		counts[r.CustomerID] = r.Count
+	}
+	return counts, nil
+}
+
+func (r *sanctionRepository) Create(s *customer.Sanction) error {
+	return r.db.Create(s).Error
+}
+
+func (r *sanctionRepository) Update(s *customer.Sanction) error {
+	return r.db.Save(s).Error
+}
@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"backend/domain/offer"
+	"backend/domain/product"
+
+	"gorm.io/gorm"
+)
+
+type storeRepository struct {
+	db *gorm.DB
+}
+
+// NewStoreRepository - ストアリポジトリの生成
+func NewStoreRepository(db *gorm.DB) product.StoreRepository {
+	return &storeRepository{db: db}
+}
+
+func (r *storeRepository) FindAll(activeOnly bool) ([]product.Store, error) {
+	var stores []product.Store
+	query := r.db.Order("display_order ASC, id ASC")
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if err := query.Find(&stores).Error; err != nil {
+		return nil, err
+	}
+	return stores, nil
+}
+
+func (r *storeRepository) FindByID(id int64) (*product.Store, error) {
+	var s product.Store
+	if err := r.db.First(&s, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *storeRepository) FindByCode(code string) (*product.Store, error) {
+	var s product.Store
+	if err := r.db.Where("code = ?", code).First(&s).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *storeRepository) Create(s *product.Store) error {
+	return r.db.Create(s).Error
+}
+
+func (r *storeRepository) Update(s *product.Store) error {
+	return r.db.Save(s).Error
+}
+
+func (r *storeRepository) Delete(id int64) error {
+	return r.db.Delete(&product.Store{}, "id = ?", id).Error
+}
+
+func (r *storeRepository) CountProductLinks(storeID int64) (int64, error) {
+	var count int64
+	if err := r.db.Model(&product.StoreLink{}).Where("store_id = ?", storeID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *storeRepository) CountOffers(code string) (int64, error) {
+	var count int64
+	if err := r.db.Model(&offer.Offer{}).Where("store = ?", code).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
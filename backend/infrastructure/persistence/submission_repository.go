@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"errors"
+
+	"backend/domain/product"
+	"backend/domain/submission"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type submissionRepository struct {
+	db *gorm.DB
+}
+
+// NewSubmissionRepository - 新商品の提案リポジトリの生成
+func NewSubmissionRepository(db *gorm.DB) submission.SubmissionRepository {
+	return &submissionRepository{db: db}
+}
+
+func (r *submissionRepository) FindByStatus(status string) ([]submission.Submission, error) {
+	var submissions []submission.Submission
+	if err := r.db.Where("status = ?", status).Order("id").Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+func (r *submissionRepository) FindByCustomerID(customerID int64) ([]submission.Submission, error) {
+	var submissions []submission.Submission
+	if err := r.db.Where("customer_id = ?", customerID).Order("id DESC").Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+func (r *submissionRepository) FindByID(id int64) (*submission.Submission, error) {
+	return r.find(r.db, id)
+}
+
+func (r *submissionRepository) FindByIDForUpdate(id int64) (*submission.Submission, error) {
+	return r.find(r.db.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *submissionRepository) find(db *gorm.DB, id int64) (*submission.Submission, error) {
+	var s submission.Submission
+	if err := db.First(&s, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, submission.ErrSubmissionNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *submissionRepository) CountPending(customerID int64) (int64, error) {
+	var count int64
+	if err := r.db.Model(&submission.Submission{}).
+		Where("customer_id = ? AND status = ?", customerID, submission.StatusPending).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *submissionRepository) Create(s *submission.Submission) error {
+	return r.db.Create(s).Error
+}
+
+func (r *submissionRepository) Update(s *submission.Submission) error {
+	return r.db.Save(s).Error
+}
+
+func (r *submissionRepository) FindProductsByName(name, nameJa string) ([]submission.ProductMatch, error) {
+	var matches []submission.ProductMatch
+	if err := r.db.Model(&product.Product{}).
+		Select("id AS product_id, name, name_ja").
+		Where("normalized_name = ? OR normalized_name_ja = ?", product.NormalizeName(name), product.NormalizeName(nameJa)).
+		Order("id").
+		Scan(&matches).Error; err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (r *submissionRepository) BackfillNormalizedNames(limit int) (int, error) {
+	var products []product.Product
+	if err := r.db.Select("id", "name", "name_ja").
+		Where("normalized_name IS NULL OR normalized_name_ja IS NULL").
+		Order("id").Limit(limit).Find(&products).Error; err != nil {
+		return 0, err
+	}
+	for i := range products {
+		p := &products[i]
+		setNormalizedNames(p)
+		if err := r.db.Model(p).UpdateColumns(map[string]interface{}{
+			"normalized_name":    p.NormalizedName,
+			"normalized_name_ja": p.NormalizedNameJa,
+		}).Error; err != nil {
+			return i, err
+		}
+	}
+	return len(products), nil
+}
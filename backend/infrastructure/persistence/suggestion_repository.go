@@ -0,0 +1,89 @@
+package persistence
+
+import (
+	"errors"
+
+	"backend/domain/suggestion"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type suggestionRepository struct {
+	db *gorm.DB
+}
+
+// NewSuggestionRepository - 商品の修正提案リポジトリの生成
+func NewSuggestionRepository(db *gorm.DB) suggestion.SuggestionRepository {
+	return &suggestionRepository{db: db}
+}
+
+// preloadChanges - 変更案を項目の順に読み込む
+func (r *suggestionRepository) preloadChanges(db *gorm.DB) *gorm.DB {
+	return db.Preload("Changes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	})
+}
+
+func (r *suggestionRepository) FindByStatus(status string) ([]suggestion.Suggestion, error) {
+	var suggestions []suggestion.Suggestion
+	if err := r.preloadChanges(r.db).Where("status = ?", status).Order("id").Find(&suggestions).Error; err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+func (r *suggestionRepository) FindByCustomerID(customerID int64) ([]suggestion.Suggestion, error) {
+	var suggestions []suggestion.Suggestion
+	if err := r.preloadChanges(r.db).Where("customer_id = ?", customerID).Order("id DESC").Find(&suggestions).Error; err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+func (r *suggestionRepository) FindByID(id int64) (*suggestion.Suggestion, error) {
+	return r.find(r.db, id)
+}
+
+func (r *suggestionRepository) FindByIDForUpdate(id int64) (*suggestion.Suggestion, error) {
+	return r.find(r.db.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *suggestionRepository) find(db *gorm.DB, id int64) (*suggestion.Suggestion, error) {
+	var s suggestion.Suggestion
+	if err := r.preloadChanges(db).First(&s, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, suggestion.ErrSuggestionNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *suggestionRepository) CountPending(customerID int64) (int64, error) {
+	var count int64
+	if err := r.db.Model(&suggestion.Suggestion{}).
+		Where("customer_id = ? AND status = ?", customerID, suggestion.StatusPending).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *suggestionRepository) Create(s *suggestion.Suggestion) error {
+	return r.db.Create(s).Error
+}
+
+func (r *suggestionRepository) Update(s *suggestion.Suggestion) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Changes").Save(s).Error; err != nil {
+			return err
+		}
+		for _, c := range s.Changes {
+			if err := tx.Model(&suggestion.Change{}).Where("id = ?", c.ID).Update("applied", c.Applied).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
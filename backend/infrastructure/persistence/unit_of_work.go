@@ -0,0 +1,81 @@
+package persistence
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"backend/domain/customer"
+	"backend/domain/event"
+	"backend/domain/favorite"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"backend/domain/question"
+	"backend/domain/review"
+	"backend/domain/submission"
+	"backend/domain/suggestion"
+	"backend/domain/webhook"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type unitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork - GORMトランザクションによる UnitOfWork の生成
+func NewUnitOfWork(db *gorm.DB) event.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+func (u *unitOfWork) Do(fn func(tx event.Tx) error) error {
+	return u.db.Transaction(func(db *gorm.DB) error {
+		return fn(&gormTx{db: db})
+	})
+}
+
+// gormTx - トランザクションに束縛したリポジトリを提供
+type gormTx struct {
+	db *gorm.DB
+}
+
+func (t *gormTx) Customers() customer.CustomerRepository       { return NewCustomerRepository(t.db) }
+func (t *gormTx) Sanctions() customer.SanctionRepository       { return NewSanctionRepository(t.db) }
+func (t *gormTx) Reviews() review.ReviewRepository             { return NewReviewRepository(t.db) }
+func (t *gormTx) Replies() review.ReplyRepository              { return NewReplyRepository(t.db) }
+func (t *gormTx) Questions() question.QuestionRepository       { return NewQuestionRepository(t.db) }
+func (t *gormTx) Answers() question.AnswerRepository           { return NewAnswerRepository(t.db) }
+func (t *gormTx) Submissions() submission.SubmissionRepository { return NewSubmissionRepository(t.db) }
+func (t *gormTx) Suggestions() suggestion.SuggestionRepository { return NewSuggestionRepository(t.db) }
+func (t *gormTx) Products() product.ProductRepository          { return NewProductRepository(t.db) }
+func (t *gormTx) Categories() product.CategoryRepository       { return NewCategoryRepository(t.db) }
+func (t *gormTx) Stores() product.StoreRepository              { return NewStoreRepository(t.db) }
+func (t *gormTx) Offers() offer.OfferRepository                { return NewOfferRepository(t.db) }
+func (t *gormTx) Favorites() favorite.FavoriteRepository       { return NewFavoriteRepository(t.db) }
+func (t *gormTx) Collections() favorite.CollectionRepository   { return NewCollectionRepository(t.db) }
+func (t *gormTx) Admins() admin.AdminRepository                { return NewAdminRepository(t.db) }
+func (t *gormTx) Roles() admin.RoleRepository                  { return NewAdminRoleRepository(t.db) }
+func (t *gormTx) AuditLogs() audit.AuditLogRepository          { return NewAuditLogRepository(t.db) }
+func (t *gormTx) Outbox() event.Outbox                         { return &outboxRepository{db: t.db, now: time.Now} }
+
+type webhookUnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewWebhookUnitOfWork - Webhook の更新と監査ログを GORMトランザクションで束ねる UnitOfWork の生成
+func NewWebhookUnitOfWork(db *gorm.DB) webhook.UnitOfWork {
+	return &webhookUnitOfWork{db: db}
+}
+
+func (u *webhookUnitOfWork) Do(fn func(tx webhook.Tx) error) error {
+	return u.db.Transaction(func(db *gorm.DB) error {
+		return fn(&gormWebhookTx{db: db})
+	})
+}
+
+// gormWebhookTx - トランザクションに束縛した Webhook・監査ログのリポジトリを提供
+type gormWebhookTx struct {
+	db *gorm.DB
+}
+
+func (t *gormWebhookTx) Webhooks() webhook.WebhookRepository { return NewWebhookRepository(t.db) }
+func (t *gormWebhookTx) AuditLogs() audit.AuditLogRepository { return NewAuditLogRepository(t.db) }
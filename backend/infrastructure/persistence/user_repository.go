@@ -1,9 +1,12 @@
 package persistence
 
 import (
+	"errors"
+
 	"backend/domain/admin"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // adminRepository
@@ -16,9 +19,27 @@ func NewAdminRepository(db *gorm.DB) admin.AdminRepository {
 	return &adminRepository{db: db}
 }
 
+func (r *adminRepository) FindAll() ([]admin.Admin, error) {
+	var admins []admin.Admin
+	if err := r.db.Preload("Role").Order("id").Find(&admins).Error; err != nil {
+		return nil, err
+	}
+	roles := make([]*admin.Role, 0, len(admins))
+	for i := range admins {
+		roles = append(roles, admins[i].Role)
+	}
+	if err := loadPermissions(r.db, roles...); err != nil {
+		return nil, err
+	}
+	return admins, nil
+}
+
 func (r *adminRepository) FindByID(id int64) (*admin.Admin, error) {
 	var a admin.Admin
 	if err := r.db.Preload("Role").First(&a, "id = ?", id).Error; err != nil {
+		return nil, adminLookupError(err)
+	}
+	if err := loadPermissions(r.db, a.Role); err != nil {
 		return nil, err
 	}
 	return &a, nil
@@ -27,13 +48,58 @@ func (r *adminRepository) FindByID(id int64) (*admin.Admin, error) {
 func (r *adminRepository) FindByGoogleIDOrEmail(googleID, email string) (*admin.Admin, error) {
 	var a admin.Admin
 	if err := r.db.Preload("Role").Where("google_id = ? OR email = ?", googleID, email).First(&a).Error; err != nil {
+		return nil, adminLookupError(err)
+	}
+	if err := loadPermissions(r.db, a.Role); err != nil {
 		return nil, err
 	}
 	return &a, nil
 }
 
+func (r *adminRepository) FindByEmail(email string) (*admin.Admin, error) {
+	var a admin.Admin
+	if err := r.db.Preload("Role").Where("LOWER(email) = LOWER(?)", email).First(&a).Error; err != nil {
+		return nil, adminLookupError(err)
+	}
+	return &a, nil
+}
+
+func (r *adminRepository) LockActiveByRole(roleID int64) (int64, error) {
+	// 集計関数と FOR UPDATE は併用できないため、行をロックして取得してから数える
+	var ids []int64
+	err := r.db.Model(&admin.Admin{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role_id = ? AND is_active", roleID).Pluck("id", &ids).Error
+	return int64(len(ids)), err
+}
+
+func (r *adminRepository) CountByRole(roleID int64) (int64, error) {
+	var count int64
+	err := r.db.Model(&admin.Admin{}).Where("role_id = ?", roleID).Count(&count).Error
+	return count, err
+}
+
+func (r *adminRepository) Create(a *admin.Admin) error {
+	return r.withoutEmptyGoogleID(a).Omit("Role").Create(a).Error
+}
+
 func (r *adminRepository) Update(a *admin.Admin) error {
-	return r.db.Save(a).Error
+	return r.withoutEmptyGoogleID(a).Omit("Role").Save(a).Error
+}
+
+// adminLookupError - レコードなしをドメインのエラーに変換
+func adminLookupError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return admin.ErrAdminNotFound
+	}
+	return err
+}
+
+// withoutEmptyGoogleID - 未ログインの招待中管理者は google_id を NULL のままにする（UNIQUE 制約のため）
+func (r *adminRepository) withoutEmptyGoogleID(a *admin.Admin) *gorm.DB {
+	if a.GoogleID == "" {
+		return r.db.Omit("GoogleID")
+	}
+	return r.db
 }
 
 // adminRoleRepository
@@ -51,12 +117,22 @@ func (r *adminRoleRepository) FindAll() ([]admin.Role, error) {
 	if err := r.db.Order("id").Find(&roles).Error; err != nil {
 		return nil, err
 	}
+	ptrs := make([]*admin.Role, len(roles))
+	for i := range roles {
+		ptrs[i] = &roles[i]
+	}
+	if err := loadPermissions(r.db, ptrs...); err != nil {
+		return nil, err
+	}
 	return roles, nil
 }
 
 func (r *adminRoleRepository) FindByID(id int64) (*admin.Role, error) {
 	var role admin.Role
 	if err := r.db.First(&role, "id = ?", id).Error; err != nil {
+		return nil, roleLookupError(err)
+	}
+	if err := loadPermissions(r.db, &role); err != nil {
 		return nil, err
 	}
 	return &role, nil
@@ -65,7 +141,94 @@ func (r *adminRoleRepository) FindByID(id int64) (*admin.Role, error) {
 func (r *adminRoleRepository) FindByName(name string) (*admin.Role, error) {
 	var role admin.Role
 	if err := r.db.Where("name = ?", name).First(&role).Error; err != nil {
+		return nil, roleLookupError(err)
+	}
+	if err := loadPermissions(r.db, &role); err != nil {
 		return nil, err
 	}
 	return &role, nil
 }
+
+func (r *adminRoleRepository) Create(role *admin.Role) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(role).Error; err != nil {
+			return err
+		}
+		return savePermissions(tx, role)
+	})
+}
+
+func (r *adminRoleRepository) Update(role *admin.Role) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(role).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id = ?", role.ID).Delete(&rolePermission{}).Error; err != nil {
+			return err
+		}
+		return savePermissions(tx, role)
+	})
+}
+
+func (r *adminRoleRepository) Delete(id int64) error {
+	return r.db.Delete(&admin.Role{}, "id = ?", id).Error
+}
+
+// roleLookupError - レコードなしをドメインのエラーに変換
+func roleLookupError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return admin.ErrRoleNotFound
+	}
+	return err
+}
+
+// rolePermission - role_permissions の行
+type rolePermission struct {
+	RoleID     int64  `gorm:"primaryKey"`
+	Permission string `gorm:"primaryKey"`
+}
+
+func (rolePermission) TableName() string {
+	return "role_permissions"
+}
+
+// loadPermissions - ロールの Permissions を role_permissions から読み込む
+func loadPermissions(db *gorm.DB, roles ...*admin.Role) error {
+	ids := make([]int64, 0, len(roles))
+	for _, role := range roles {
+		if role != nil {
+			ids = append(ids, role.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var rows []rolePermission
+	if err := db.Where("role_id IN ?", ids).Order("permission").Find(&rows).Error; err != nil {
+		return err
+	}
+	byRole := make(map[int64][]string)
+	for _, row := range rows {
+		byRole[row.RoleID] = append(byRole[row.RoleID], row.Permission)
+	}
+	for _, role := range roles {
+		if role != nil {
+			role.Permissions = byRole[role.ID]
+			if role.Permissions == nil {
+				role.Permissions = []string{}
+			}
+		}
+	}
+	return nil
+}
+
+func savePermissions(tx *gorm.DB, role *admin.Role) error {
+	if len(role.Permissions) == 0 {
+		return nil
+	}
+	rows := make([]rolePermission, len(role.Permissions))
+	for i, p := range role.Permissions {
+		rows[i] = rolePermission{RoleID: role.ID, Permission: p}
+	}
+	return tx.Create(&rows).Error
+}
@@ -0,0 +1,121 @@
+package persistence
+
+import (
+	"backend/domain/webhook"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// deliveryListLimit - 配信ログの既定の取得件数
+const deliveryListLimit = 100
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository - Webhook リポジトリの生成
+func NewWebhookRepository(db *gorm.DB) webhook.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) FindAll() ([]webhook.Subscription, error) {
+	var subscriptions []webhook.Subscription
+	if err := r.db.Order("id ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *webhookRepository) FindByID(id int64) (*webhook.Subscription, error) {
+	var s webhook.Subscription
+	if err := r.db.First(&s, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *webhookRepository) FindActiveByEvent(eventName string) ([]webhook.Subscription, error) {
+	var subscriptions []webhook.Subscription
+	if err := r.db.Where("is_active = ? AND ? = ANY(event_types)", true, eventName).
+		Order("id ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *webhookRepository) Create(s *webhook.Subscription) error {
+	return r.db.Create(s).Error
+}
+
+func (r *webhookRepository) Update(s *webhook.Subscription) error {
+	return r.db.Save(s).Error
+}
+
+func (r *webhookRepository) Delete(id int64) error {
+	return r.db.Delete(&webhook.Subscription{}, id).Error
+}
+
+func (r *webhookRepository) CreateDeliveries(deliveries []*webhook.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "replay_of_id IS NULL"}}},
+		DoNothing:   true,
+	}).Create(&deliveries).Error
+}
+
+func (r *webhookRepository) CreateDelivery(d *webhook.Delivery) error {
+	return r.db.Create(d).Error
+}
+
+func (r *webhookRepository) FindDueDeliveries(now time.Time, limit int) ([]webhook.Delivery, error) {
+	var deliveries []webhook.Delivery
+	if err := r.db.Where("status = ? AND next_attempt_at <= ?", webhook.DeliveryPending, now).
+		Order("id ASC").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *webhookRepository) FindDeliveries(filter webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	query := r.db.Model(&webhook.Delivery{})
+	if filter.SubscriptionID != 0 {
+		query = query.Where("subscription_id = ?", filter.SubscriptionID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = deliveryListLimit
+	}
+
+	var deliveries []webhook.Delivery
+	if err := query.Order("id DESC").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *webhookRepository) FindDeliveryByID(id int64) (*webhook.Delivery, error) {
+	var d webhook.Delivery
+	if err := r.db.First(&d, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, webhook.ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r *webhookRepository) SaveDelivery(d *webhook.Delivery) error {
+	return r.db.Save(d).Error
+}
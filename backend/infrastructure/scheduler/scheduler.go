@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job - 定期実行するジョブ
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler - 定期ジョブの実行管理
+type Scheduler struct {
+	jobs []Job
+}
+
+// NewScheduler - スケジューラーの生成
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Register - ジョブを登録
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start - 登録済みジョブをそれぞれ goroutine で開始（ctx がキャンセルされるまで実行）
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	// 起動直後に1回実行
+	s.runOnce(ctx, job)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(ctx, job)
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[scheduler] job %s panicked: %v", job.Name, r)
+		}
+	}()
+	if err := job.Run(ctx); err != nil {
+		log.Printf("[scheduler] job %s failed: %v", job.Name, err)
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend/domain/webhook"
+)
+
+const userAgent = "VeganBiteWebhooks/1.0"
+
+// HTTPSender - 購読先URLへ署名付きの POST を送る
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender - HTTP送信の生成（timeout は1リクエストの上限）
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout: timeout,
+			// リダイレクト先には署名付きの本文を送らない
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		now: time.Now,
+	}
+}
+
+// Send - 配信本文を POST（2xx 以外は失敗として扱われる）
+func (s *HTTPSender) Send(ctx context.Context, sub *webhook.Subscription, d *webhook.Delivery) webhook.Result {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return webhook.Result{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(webhook.HeaderEvent, d.EventName)
+	req.Header.Set(webhook.HeaderDelivery, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(sub.Secret, s.now(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return webhook.Result{Err: err}
+	}
+	defer resp.Body.Close()
+	// 応答本文は使わないが、接続を再利用できるよう少しだけ読み捨てる
+	_, _ = io.CopyN(io.Discard, resp.Body, 4096)
+
+	return webhook.Result{StatusCode: resp.StatusCode}
+}
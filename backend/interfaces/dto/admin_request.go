@@ -0,0 +1,13 @@
+package dto
+
+// InviteAdminRequest - 管理者招待リクエストDTO（name を省略するとメールアドレスのローカル部）
+type InviteAdminRequest struct {
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	RoleID int64  `json:"roleId"`
+}
+
+// ChangeAdminRoleRequest - 管理者ロール変更リクエストDTO
+type ChangeAdminRoleRequest struct {
+	RoleID int64 `json:"roleId"`
+}
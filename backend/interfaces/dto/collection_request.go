@@ -0,0 +1,24 @@
+package dto
+
+// CollectionRequest - お気に入りリスト作成・更新リクエストDTO
+type CollectionRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	IsPublic    bool    `json:"isPublic"`
+}
+
+// AddCollectionItemRequest - リストへの商品追加リクエストDTO
+type AddCollectionItemRequest struct {
+	ProductID int64   `json:"productId"`
+	Note      *string `json:"note"`
+}
+
+// UpdateCollectionItemRequest - リスト内商品のメモ更新リクエストDTO
+type UpdateCollectionItemRequest struct {
+	Note *string `json:"note"`
+}
+
+// ReorderCollectionItemsRequest - リスト内の並び替えリクエストDTO
+type ReorderCollectionItemsRequest struct {
+	ProductIDs []int64 `json:"productIds"`
+}
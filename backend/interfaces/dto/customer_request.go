@@ -10,3 +10,8 @@ type SuspendCustomerRequest struct {
 	Duration int    `json:"duration"`
 	Reason   string `json:"reason"`
 }
+
+// UnbanCustomerRequest - カスタマーBAN/停止解除リクエスト（理由は任意）
+type UnbanCustomerRequest struct {
+	Reason string `json:"reason"`
+}
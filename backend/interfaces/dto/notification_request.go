@@ -0,0 +1,18 @@
+package dto
+
+// MarkNotificationsReadRequest - 通知既読化リクエストDTO
+type MarkNotificationsReadRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+// NotificationPreferenceRequest - 通知の種類ごとの受信設定DTO
+type NotificationPreferenceRequest struct {
+	Type  string `json:"type"`
+	InApp bool   `json:"inApp"`
+	Email bool   `json:"email"`
+}
+
+// UpdateNotificationPreferencesRequest - 受信設定更新リクエストDTO
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceRequest `json:"preferences"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// UpsertOfferRequest - ストア価格登録リクエストDTO
+type UpsertOfferRequest struct {
+	Price        json.Number `json:"price"`
+	Currency     string      `json:"currency"`
+	Availability string      `json:"availability"`
+	CheckedAt    *time.Time  `json:"checkedAt"`
+}
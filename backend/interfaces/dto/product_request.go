@@ -1,29 +1,66 @@
 package dto
 
+import "time"
+
 // CreateProductRequest - 商品作成リクエストDTO
 type CreateProductRequest struct {
-	Name          string  `json:"name"`
-	NameJa        string  `json:"nameJa"`
-	Description   string  `json:"description"`
-	DescriptionJa string  `json:"descriptionJa"`
-	ImageURL      string  `json:"imageUrl"`
-	AffiliateURL  *string `json:"affiliateUrl"`
-	AmazonURL     *string `json:"amazonUrl"`
-	RakutenURL    *string `json:"rakutenUrl"`
-	YahooURL      *string `json:"yahooUrl"`
-	CategoryIDs   []int64 `json:"categoryIds"`
+	Name              string                 `json:"name"`
+	NameJa            string                 `json:"nameJa"`
+	Description       string                 `json:"description"`
+	DescriptionJa     string                 `json:"descriptionJa"`
+	ImageURL          string                 `json:"imageUrl"`
+	AffiliateURL      *string                `json:"affiliateUrl"`
+	StoreLinks        []StoreLinkRequest     `json:"storeLinks"`
+	AmazonURL         *string                `json:"amazonUrl"` // 互換用（storeLinks 未指定時のみ使用）
+	RakutenURL        *string                `json:"rakutenUrl"`
+	YahooURL          *string                `json:"yahooUrl"`
+	CategoryIDs       []int64                `json:"categoryIds"`
+	DietaryAttributes []string               `json:"dietaryAttributes"`
+	Certifications    []CertificationRequest `json:"certifications"`
+	Nutrition         *NutritionRequest      `json:"nutrition"`
 }
 
 // UpdateProductRequest - 商品更新リクエストDTO
 type UpdateProductRequest struct {
-	Name          string  `json:"name"`
-	NameJa        string  `json:"nameJa"`
-	Description   string  `json:"description"`
-	DescriptionJa string  `json:"descriptionJa"`
-	ImageURL      string  `json:"imageUrl"`
-	AffiliateURL  *string `json:"affiliateUrl"`
-	AmazonURL     *string `json:"amazonUrl"`
-	RakutenURL    *string `json:"rakutenUrl"`
-	YahooURL      *string `json:"yahooUrl"`
-	CategoryIDs   []int64 `json:"categoryIds"`
+	Name              string                 `json:"name"`
+	NameJa            string                 `json:"nameJa"`
+	Description       string                 `json:"description"`
+	DescriptionJa     string                 `json:"descriptionJa"`
+	ImageURL          string                 `json:"imageUrl"`
+	AffiliateURL      *string                `json:"affiliateUrl"`
+	StoreLinks        []StoreLinkRequest     `json:"storeLinks"`
+	AmazonURL         *string                `json:"amazonUrl"` // 互換用（storeLinks 未指定時のみ使用）
+	RakutenURL        *string                `json:"rakutenUrl"`
+	YahooURL          *string                `json:"yahooUrl"`
+	CategoryIDs       []int64                `json:"categoryIds"`
+	DietaryAttributes []string               `json:"dietaryAttributes"`
+	Certifications    []CertificationRequest `json:"certifications"`
+	Nutrition         *NutritionRequest      `json:"nutrition"`
+	RemoveNutrition   bool                   `json:"removeNutrition"` // 栄養成分を削除（nutrition 省略時は既存の値を残す）
+}
+
+// CertificationRequest - 商品認証リクエストDTO
+type CertificationRequest struct {
+	Name              string     `json:"name"`
+	IssuingBody       string     `json:"issuingBody"`
+	CertificateNumber *string    `json:"certificateNumber"`
+	IssuedAt          *time.Time `json:"issuedAt"`
+	ExpiresAt         *time.Time `json:"expiresAt"`
+}
+
+// StoreLinkRequest - ストアリンクリクエストDTO
+type StoreLinkRequest struct {
+	StoreCode string `json:"storeCode"`
+	URL       string `json:"url"`
+}
+
+// NutritionRequest - 栄養成分リクエストDTO
+type NutritionRequest struct {
+	Basis             string   `json:"basis"`
+	ServingSizeGrams  *float64 `json:"servingSizeGrams"`
+	CaloriesKcal      float64  `json:"caloriesKcal"`
+	ProteinGrams      float64  `json:"proteinGrams"`
+	FatGrams          float64  `json:"fatGrams"`
+	CarbohydrateGrams float64  `json:"carbohydrateGrams"`
+	SaltGrams         float64  `json:"saltGrams"`
 }
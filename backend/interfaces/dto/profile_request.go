@@ -0,0 +1,11 @@
+package dto
+
+// UpdateProfileRequest - プロフィール更新リクエストDTO（省略した項目は変更しない。avatar を空文字にすると Google のアバターに戻す）
+type UpdateProfileRequest struct {
+	Name              *string `json:"name"`
+	Avatar            *string `json:"avatar"`
+	Bio               *string `json:"bio"`
+	PreferredLanguage *string `json:"preferredLanguage"`
+	DietaryPreference *string `json:"dietaryPreference"`
+	ProfileVisibility *string `json:"profileVisibility"`
+}
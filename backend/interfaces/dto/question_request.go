@@ -0,0 +1,16 @@
+package dto
+
+// AskQuestionRequest - 商品への質問リクエストDTO
+type AskQuestionRequest struct {
+	Body string `json:"body"`
+}
+
+// AnswerQuestionRequest - 質問への回答リクエストDTO
+type AnswerQuestionRequest struct {
+	Body string `json:"body"`
+}
+
+// AcceptAnswerRequest - 回答の採用リクエストDTO（AnswerID が nil なら採用を取り消す）
+type AcceptAnswerRequest struct {
+	AnswerID *int64 `json:"answerId"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// CreateReplyRequest - レビューへの返信リクエストDTO（ParentID を省略するとレビューへの直接の返信）
+type CreateReplyRequest struct {
+	ParentID *int64 `json:"parentId"`
+	Body     string `json:"body"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+// RoleRequest - ロール作成・更新リクエストDTO（permissions は付与する権限の全量）
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	NameJa      string   `json:"nameJa"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// CreateStoreRequest - ストア作成リクエストDTO
+type CreateStoreRequest struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	NameJa       string `json:"nameJa"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// UpdateStoreRequest - ストア更新リクエストDTO
+type UpdateStoreRequest struct {
+	Code         string `json:"code"` // 省略可（指定する場合は現在のコード。コードは変更できない）
+	Name         string `json:"name"`
+	NameJa       string `json:"nameJa"`
+	DisplayOrder int    `json:"displayOrder"`
+	IsActive     bool   `json:"isActive"`
+}
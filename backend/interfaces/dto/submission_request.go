@@ -0,0 +1,16 @@
+package dto
+
+// SubmitProductRequest - 新商品の提案リクエストDTO
+type SubmitProductRequest struct {
+	Name          string  `json:"name"`
+	NameJa        string  `json:"nameJa"`
+	Description   string  `json:"description"`
+	DescriptionJa string  `json:"descriptionJa"`
+	ImageURL      string  `json:"imageUrl"`
+	StoreURL      *string `json:"storeUrl"`
+}
+
+// RejectSubmissionRequest - 新商品の提案の却下リクエストDTO
+type RejectSubmissionRequest struct {
+	Reason string `json:"reason"`
+}
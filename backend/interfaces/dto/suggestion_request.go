@@ -0,0 +1,17 @@
+package dto
+
+// SuggestEditRequest - 商品情報の修正提案リクエストDTO（changes は項目名と新しい値。"storeLinks.<ストアコード>" の空文字はリンクの削除）
+type SuggestEditRequest struct {
+	Changes map[string]string `json:"changes"`
+	Comment string            `json:"comment"`
+}
+
+// ApproveSuggestionRequest - 修正提案の承認リクエストDTO（fields を省略すると全項目を反映）
+type ApproveSuggestionRequest struct {
+	Fields []string `json:"fields"`
+}
+
+// RejectSuggestionRequest - 修正提案の却下リクエストDTO
+type RejectSuggestionRequest struct {
+	Reason string `json:"reason"`
+}
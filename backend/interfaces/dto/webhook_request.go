@@ -0,0 +1,20 @@
+package dto
+
+// CreateWebhookRequest - Webhook 作成リクエストDTO（secret を省略すると生成）
+type CreateWebhookRequest struct {
+	Name       string   `json:"name"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"eventTypes"`
+	ProductIDs []int64  `json:"productIds"`
+}
+
+// UpdateWebhookRequest - Webhook 更新リクエストDTO（secret を省略すると変更しない）
+type UpdateWebhookRequest struct {
+	Name       string   `json:"name"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"eventTypes"`
+	ProductIDs []int64  `json:"productIds"`
+	IsActive   bool     `json:"isActive"`
+}
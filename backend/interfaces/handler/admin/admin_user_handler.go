@@ -0,0 +1,130 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/admin"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminUserHandler - 管理者アカウント管理ハンドラー
+type AdminUserHandler struct {
+	adminUserUsecase *adminusecase.AdminUserUsecase
+}
+
+// NewAdminUserHandler - 管理者アカウント管理ハンドラーの生成
+func NewAdminUserHandler(adminUserUsecase *adminusecase.AdminUserUsecase) *AdminUserHandler {
+	return &AdminUserHandler{adminUserUsecase: adminUserUsecase}
+}
+
+// GetAllAdmins - 管理者一覧取得
+func (h *AdminUserHandler) GetAllAdmins(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	admins, err := h.adminUserUsecase.GetAllAdmins(handler.AuditActor(c))
+	if err != nil {
+		return adminUserError(c, err)
+	}
+	return c.JSON(http.StatusOK, admins)
+}
+
+// InviteAdmin - 管理者招待
+func (h *AdminUserHandler) InviteAdmin(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	var req dto.InviteAdminRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	a, err := h.adminUserUsecase.InviteAdmin(adminusecase.InviteAdminInput{
+		Email:  req.Email,
+		Name:   req.Name,
+		RoleID: req.RoleID,
+	}, handler.AuditActor(c))
+	if err != nil {
+		return adminUserError(c, err)
+	}
+	return c.JSON(http.StatusCreated, a)
+}
+
+// ChangeRole - 管理者のロール変更
+func (h *AdminUserHandler) ChangeRole(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid admin ID"})
+	}
+	var req dto.ChangeAdminRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	a, err := h.adminUserUsecase.ChangeRole(id, req.RoleID, handler.AuditActor(c))
+	if err != nil {
+		return adminUserError(c, err)
+	}
+	return c.JSON(http.StatusOK, a)
+}
+
+// DeactivateAdmin - 管理者の無効化
+func (h *AdminUserHandler) DeactivateAdmin(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid admin ID"})
+	}
+
+	a, err := h.adminUserUsecase.DeactivateAdmin(id, handler.AuditActor(c))
+	if err != nil {
+		return adminUserError(c, err)
+	}
+	return c.JSON(http.StatusOK, a)
+}
+
+// ReactivateAdmin - 管理者の再有効化
+func (h *AdminUserHandler) ReactivateAdmin(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid admin ID"})
+	}
+
+	a, err := h.adminUserUsecase.ReactivateAdmin(id, handler.AuditActor(c))
+	if err != nil {
+		return adminUserError(c, err)
+	}
+	return c.JSON(http.StatusOK, a)
+}
+
+func adminUserError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, adminusecase.ErrAdminForbidden):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, adminusecase.ErrAdminNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, adminusecase.ErrAdminEmailTaken),
+		errors.Is(err, adminusecase.ErrLastSuperAdmin),
+		errors.Is(err, adminusecase.ErrAdminSelfDeactivate):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, adminusecase.ErrAdminRoleNotFound),
+		errors.Is(err, admin.ErrInvalidEmail):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
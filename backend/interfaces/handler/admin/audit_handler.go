@@ -0,0 +1,70 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/audit"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminAuditHandler - 管理者向け監査ログハンドラー
+type AdminAuditHandler struct {
+	adminAuditUsecase *adminusecase.AdminAuditUsecase
+}
+
+// NewAdminAuditHandler - 管理者向け監査ログハンドラーの生成
+func NewAdminAuditHandler(adminAuditUsecase *adminusecase.AdminAuditUsecase) *AdminAuditHandler {
+	return &AdminAuditHandler{adminAuditUsecase: adminAuditUsecase}
+}
+
+// GetAuditLogs - 監査ログ取得（?adminId=&action=&targetType=&targetId=&from=YYYY-MM-DD&to=YYYY-MM-DD&limit=）
+func (h *AdminAuditHandler) GetAuditLogs(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAuditLogForbidden.Error()})
+	}
+
+	filter := audit.Filter{
+		Action:     c.QueryParam("action"),
+		TargetType: c.QueryParam("targetType"),
+		TargetID:   c.QueryParam("targetId"),
+	}
+	var err error
+	if adminIDStr := c.QueryParam("adminId"); adminIDStr != "" {
+		if filter.AdminID, err = strconv.ParseInt(adminIDStr, 10, 64); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid admin ID"})
+		}
+	}
+	if filter.From, err = parseOptionalDate(c.QueryParam("from")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid from date"})
+	}
+	if filter.To, err = parseOptionalDate(c.QueryParam("to")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid to date"})
+	}
+	if filter.To != nil {
+		// to の日付を含める
+		end := filter.To.AddDate(0, 0, 1)
+		filter.To = &end
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if filter.Limit, err = strconv.Atoi(limitStr); err != nil || filter.Limit <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit"})
+		}
+	}
+
+	entries, err := h.adminAuditUsecase.GetAuditLogs(handler.AuditActor(c), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, adminusecase.ErrAuditLogForbidden):
+			return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+		case errors.Is(err, adminusecase.ErrAuditLogRangeInvalid):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, entries)
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"backend/interfaces/dto"
+	"backend/interfaces/handler"
 	adminusecase "backend/usecase/admin"
 
 	"github.com/labstack/echo/v4"
@@ -39,7 +40,7 @@ func (h *AdminCategoryHandler) CreateCategory(c echo.Context) error {
 		CreatedByAdminID: adminID,
 	}
 
-	category, err := h.adminCategoryUsecase.CreateCategory(input)
+	category, err := h.adminCategoryUsecase.CreateCategory(input, handler.AuditActor(c))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
@@ -71,7 +72,7 @@ func (h *AdminCategoryHandler) UpdateCategory(c echo.Context) error {
 		UpdatedByAdminID: adminID,
 	}
 
-	category, err := h.adminCategoryUsecase.UpdateCategory(id, input)
+	category, err := h.adminCategoryUsecase.UpdateCategory(id, input, handler.AuditActor(c))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
@@ -86,7 +87,7 @@ func (h *AdminCategoryHandler) DeleteCategory(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid category ID"})
 	}
 
-	if err := h.adminCategoryUsecase.DeleteCategory(id); err != nil {
+	if err := h.adminCategoryUsecase.DeleteCategory(id, handler.AuditActor(c)); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.NoContent(http.StatusNoContent)
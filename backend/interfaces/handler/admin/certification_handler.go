@@ -0,0 +1,28 @@
+package adminhandler
+
+import (
+	"net/http"
+
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminCertificationHandler - 管理者向け商品認証ハンドラー
+type AdminCertificationHandler struct {
+	adminCertificationUsecase *adminusecase.AdminCertificationUsecase
+}
+
+// NewAdminCertificationHandler - 管理者向け商品認証ハンドラーの生成
+func NewAdminCertificationHandler(adminCertificationUsecase *adminusecase.AdminCertificationUsecase) *AdminCertificationHandler {
+	return &AdminCertificationHandler{adminCertificationUsecase: adminCertificationUsecase}
+}
+
+// GetExpiredCertifications - 有効期限切れの認証一覧取得
+func (h *AdminCertificationHandler) GetExpiredCertifications(c echo.Context) error {
+	certifications, err := h.adminCertificationUsecase.GetExpiredCertifications()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, certifications)
+}
@@ -0,0 +1,63 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminClickHandler - 管理者向けクリック集計ハンドラー
+type AdminClickHandler struct {
+	adminClickUsecase *adminusecase.AdminClickUsecase
+}
+
+// NewAdminClickHandler - 管理者向けクリック集計ハンドラーの生成
+func NewAdminClickHandler(adminClickUsecase *adminusecase.AdminClickUsecase) *AdminClickHandler {
+	return &AdminClickHandler{adminClickUsecase: adminClickUsecase}
+}
+
+// GetClickReport - 商品・ストア・日ごとのクリック数を取得（?from=YYYY-MM-DD&to=YYYY-MM-DD&productId=）
+func (h *AdminClickHandler) GetClickReport(c echo.Context) error {
+	var input adminusecase.ClickReportInput
+	var err error
+
+	if input.From, err = parseOptionalDate(c.QueryParam("from")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid from date"})
+	}
+	if input.To, err = parseOptionalDate(c.QueryParam("to")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid to date"})
+	}
+	if productIDStr := c.QueryParam("productId"); productIDStr != "" {
+		productID, err := strconv.ParseInt(productIDStr, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+		}
+		input.ProductID = &productID
+	}
+
+	report, err := h.adminClickUsecase.GetDailyReport(input)
+	if err != nil {
+		if errors.Is(err, adminusecase.ErrClickReportRangeInvalid) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// parseOptionalDate - YYYY-MM-DD 形式の日付を解析（空ならnil）
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
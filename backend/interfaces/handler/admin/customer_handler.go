@@ -1,10 +1,12 @@
 package adminhandler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"backend/interfaces/dto"
+	"backend/interfaces/handler"
 	adminusecase "backend/usecase/admin"
 
 	"github.com/labstack/echo/v4"
@@ -31,6 +33,7 @@ type customerResponse struct {
 	StatusReason   *string `json:"statusReason,omitempty"`
 	SuspendedUntil *string `json:"suspendedUntil,omitempty"`
 	ReviewCount    int     `json:"reviewCount"`
+	SanctionCount  int     `json:"sanctionCount"`
 }
 
 // GetAllCustomers - 全カスタマー一覧取得
@@ -60,9 +63,9 @@ func (h *AdminCustomerHandler) BanCustomer(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
 	}
 
-	cust, err := h.adminCustomerUsecase.BanCustomer(id, req.Reason)
+	cust, err := h.adminCustomerUsecase.BanCustomer(id, req.Reason, handler.AuditActor(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return customerError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, toCustomerResponse(&adminusecase.CustomerWithReviewCount{Customer: *cust}))
@@ -80,9 +83,9 @@ func (h *AdminCustomerHandler) SuspendCustomer(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
 	}
 
-	cust, err := h.adminCustomerUsecase.SuspendCustomer(id, req.Duration, req.Reason)
+	cust, err := h.adminCustomerUsecase.SuspendCustomer(id, req.Duration, req.Reason, handler.AuditActor(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return customerError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, toCustomerResponse(&adminusecase.CustomerWithReviewCount{Customer: *cust}))
@@ -95,24 +98,51 @@ func (h *AdminCustomerHandler) UnbanCustomer(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
 	}
 
-	cust, err := h.adminCustomerUsecase.UnbanCustomer(id)
+	var req dto.UnbanCustomerRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	cust, err := h.adminCustomerUsecase.UnbanCustomer(id, req.Reason, handler.AuditActor(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return customerError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, toCustomerResponse(&adminusecase.CustomerWithReviewCount{Customer: *cust}))
 }
 
+// GetCustomerSanctions - カスタマーの制裁履歴取得
+func (h *AdminCustomerHandler) GetCustomerSanctions(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+
+	sanctions, err := h.adminCustomerUsecase.GetCustomerSanctions(id)
+	if err != nil {
+		return customerError(c, err)
+	}
+	return c.JSON(http.StatusOK, sanctions)
+}
+
+func customerError(c echo.Context, err error) error {
+	if errors.Is(err, adminusecase.ErrCustomerNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
 func toCustomerResponse(cust *adminusecase.CustomerWithReviewCount) customerResponse {
 	resp := customerResponse{
-		ID:           cust.ID,
-		Name:         cust.Name,
-		Email:        cust.Email,
-		Avatar:       cust.Avatar,
-		MemberSince:  cust.MemberSince.Format("2006-01-02"),
-		Status:       cust.Status,
-		StatusReason: cust.StatusReason,
-		ReviewCount:  cust.ReviewCount,
+		ID:            cust.ID,
+		Name:          cust.Name,
+		Email:         cust.Email,
+		Avatar:        cust.Avatar,
+		MemberSince:   cust.MemberSince.Format("2006-01-02"),
+		Status:        cust.Status,
+		StatusReason:  cust.StatusReason,
+		ReviewCount:   cust.ReviewCount,
+		SanctionCount: cust.SanctionCount,
 	}
 	if cust.SuspendedUntil != nil {
 		s := cust.SuspendedUntil.Format("2006-01-02T15:04:05Z")
@@ -0,0 +1,28 @@
+package adminhandler
+
+import (
+	"net/http"
+
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminLinkCheckHandler - 管理者向けリンク切れ確認ハンドラー
+type AdminLinkCheckHandler struct {
+	adminLinkCheckUsecase *adminusecase.AdminLinkCheckUsecase
+}
+
+// NewAdminLinkCheckHandler - 管理者向けリンク切れ確認ハンドラーの生成
+func NewAdminLinkCheckHandler(adminLinkCheckUsecase *adminusecase.AdminLinkCheckUsecase) *AdminLinkCheckHandler {
+	return &AdminLinkCheckHandler{adminLinkCheckUsecase: adminLinkCheckUsecase}
+}
+
+// GetBrokenLinks - リンク切れ・到達不能な商品URL一覧取得
+func (h *AdminLinkCheckHandler) GetBrokenLinks(c echo.Context) error {
+	links, err := h.adminLinkCheckUsecase.GetBrokenLinks()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, links)
+}
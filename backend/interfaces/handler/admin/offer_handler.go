@@ -0,0 +1,68 @@
+package adminhandler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminOfferHandler - 管理者向けストア価格ハンドラー
+type AdminOfferHandler struct {
+	adminOfferUsecase *adminusecase.AdminOfferUsecase
+}
+
+// NewAdminOfferHandler - 管理者向けストア価格ハンドラーの生成
+func NewAdminOfferHandler(adminOfferUsecase *adminusecase.AdminOfferUsecase) *AdminOfferHandler {
+	return &AdminOfferHandler{adminOfferUsecase: adminOfferUsecase}
+}
+
+// UpsertOffer - ストア価格の手動登録・更新
+func (h *AdminOfferHandler) UpsertOffer(c echo.Context) error {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	var req dto.UpsertOfferRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	o, err := h.adminOfferUsecase.UpsertOffer(adminusecase.UpsertOfferInput{
+		ProductID:    productID,
+		Store:        c.Param("store"),
+		Price:        req.Price.String(),
+		Currency:     req.Currency,
+		Availability: req.Availability,
+		CheckedAt:    req.CheckedAt,
+	}, handler.AuditActor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, o)
+}
+
+// ImportOffers - CSVでストア価格を一括登録（multipart の file、または text/csv 本文）
+func (h *AdminOfferHandler) ImportOffers(c echo.Context) error {
+	var body io.Reader = c.Request().Body
+	if file, err := c.FormFile("file"); err == nil {
+		src, err := file.Open()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		defer src.Close()
+		body = src
+	}
+
+	result, err := h.adminOfferUsecase.ImportCSV(body, handler.AuditActor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -3,8 +3,11 @@ package adminhandler
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
+	"backend/domain/product"
 	"backend/interfaces/dto"
+	"backend/interfaces/handler"
 	adminusecase "backend/usecase/admin"
 
 	"github.com/labstack/echo/v4"
@@ -34,20 +37,21 @@ func (h *AdminProductHandler) CreateProduct(c echo.Context) error {
 	}
 
 	input := adminusecase.CreateProductInput{
-		Name:             req.Name,
-		NameJa:           req.NameJa,
-		Description:      req.Description,
-		DescriptionJa:    req.DescriptionJa,
-		ImageURL:         req.ImageURL,
-		AffiliateURL:     req.AffiliateURL,
-		AmazonURL:        req.AmazonURL,
-		RakutenURL:       req.RakutenURL,
-		YahooURL:         req.YahooURL,
-		CategoryIDs:      req.CategoryIDs,
-		CreatedByAdminID: adminID,
-	}
-
-	product, err := h.adminProductUsecase.CreateProduct(input)
+		Name:              req.Name,
+		NameJa:            req.NameJa,
+		Description:       req.Description,
+		DescriptionJa:     req.DescriptionJa,
+		ImageURL:          req.ImageURL,
+		AffiliateURL:      req.AffiliateURL,
+		StoreLinks:        toStoreLinkInputs(req.StoreLinks, req.AmazonURL, req.RakutenURL, req.YahooURL),
+		CategoryIDs:       req.CategoryIDs,
+		DietaryAttributes: req.DietaryAttributes,
+		Certifications:    toCertificationInputs(req.Certifications),
+		Nutrition:         toNutritionInput(req.Nutrition),
+		CreatedByAdminID:  adminID,
+	}
+
+	product, err := h.adminProductUsecase.CreateProduct(input, handler.AuditActor(c))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
@@ -74,20 +78,31 @@ func (h *AdminProductHandler) UpdateProduct(c echo.Context) error {
 	}
 
 	input := adminusecase.UpdateProductInput{
-		Name:             req.Name,
-		NameJa:           req.NameJa,
-		Description:      req.Description,
-		DescriptionJa:    req.DescriptionJa,
-		ImageURL:         req.ImageURL,
-		AffiliateURL:     req.AffiliateURL,
-		AmazonURL:        req.AmazonURL,
-		RakutenURL:       req.RakutenURL,
-		YahooURL:         req.YahooURL,
-		CategoryIDs:      req.CategoryIDs,
-		UpdatedByAdminID: adminID,
-	}
-
-	product, err := h.adminProductUsecase.UpdateProduct(id, input)
+		Name:              req.Name,
+		NameJa:            req.NameJa,
+		Description:       req.Description,
+		DescriptionJa:     req.DescriptionJa,
+		ImageURL:          req.ImageURL,
+		AffiliateURL:      req.AffiliateURL,
+		CategoryIDs:       req.CategoryIDs,
+		DietaryAttributes: req.DietaryAttributes,
+		Certifications:    toCertificationInputs(req.Certifications),
+		Nutrition:         toNutritionInput(req.Nutrition),
+		RemoveNutrition:   req.RemoveNutrition,
+		UpdatedByAdminID:  adminID,
+	}
+	// storeLinks 省略時は旧フィールドを既存のリンクに反映する（他のストアのリンクを消さない）
+	if req.StoreLinks != nil {
+		input.StoreLinks = toStoreLinkInputs(req.StoreLinks, nil, nil, nil)
+	} else {
+		input.LegacyStoreURLs = map[string]*string{
+			product.StoreAmazon:  req.AmazonURL,
+			product.StoreRakuten: req.RakutenURL,
+			product.StoreYahoo:   req.YahooURL,
+		}
+	}
+
+	product, err := h.adminProductUsecase.UpdateProduct(id, input, handler.AuditActor(c))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
@@ -102,8 +117,67 @@ func (h *AdminProductHandler) DeleteProduct(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
 	}
 
-	if err := h.adminProductUsecase.DeleteProduct(id); err != nil {
+	if err := h.adminProductUsecase.DeleteProduct(id, handler.AuditActor(c)); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+// toStoreLinkInputs - ストアリンク入力へ変換（storeLinks 未指定なら旧フィールドから組み立てる）
+func toStoreLinkInputs(reqs []dto.StoreLinkRequest, amazonURL, rakutenURL, yahooURL *string) []adminusecase.StoreLinkInput {
+	inputs := make([]adminusecase.StoreLinkInput, 0, len(reqs))
+	if reqs != nil {
+		for _, r := range reqs {
+			inputs = append(inputs, adminusecase.StoreLinkInput{StoreCode: r.StoreCode, URL: r.URL})
+		}
+		return inputs
+	}
+
+	legacy := []struct {
+		code string
+		url  *string
+	}{
+		{product.StoreAmazon, amazonURL},
+		{product.StoreRakuten, rakutenURL},
+		{product.StoreYahoo, yahooURL},
+	}
+	for _, l := range legacy {
+		if l.url != nil && strings.TrimSpace(*l.url) != "" {
+			inputs = append(inputs, adminusecase.StoreLinkInput{StoreCode: l.code, URL: *l.url})
+		}
+	}
+	return inputs
+}
+
+// toCertificationInputs - 認証情報入力へ変換（未指定は nil のまま渡し、更新時は既存の認証情報を残す）
+func toCertificationInputs(reqs []dto.CertificationRequest) []adminusecase.CertificationInput {
+	if reqs == nil {
+		return nil
+	}
+	inputs := make([]adminusecase.CertificationInput, 0, len(reqs))
+	for _, r := range reqs {
+		inputs = append(inputs, adminusecase.CertificationInput{
+			Name:              r.Name,
+			IssuingBody:       r.IssuingBody,
+			CertificateNumber: r.CertificateNumber,
+			IssuedAt:          r.IssuedAt,
+			ExpiresAt:         r.ExpiresAt,
+		})
+	}
+	return inputs
+}
+
+func toNutritionInput(req *dto.NutritionRequest) *adminusecase.NutritionInput {
+	if req == nil {
+		return nil
+	}
+	return &adminusecase.NutritionInput{
+		Basis:             req.Basis,
+		ServingSizeGrams:  req.ServingSizeGrams,
+		CaloriesKcal:      req.CaloriesKcal,
+		ProteinGrams:      req.ProteinGrams,
+		FatGrams:          req.FatGrams,
+		CarbohydrateGrams: req.CarbohydrateGrams,
+		SaltGrams:         req.SaltGrams,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"backend/interfaces/handler"
 	adminusecase "backend/usecase/admin"
 
 	"github.com/labstack/echo/v4"
@@ -36,7 +37,7 @@ func (h *AdminReviewHandler) DeleteReview(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid review ID"})
 	}
 
-	if err := h.adminReviewUsecase.DeleteReview(id); err != nil {
+	if err := h.adminReviewUsecase.DeleteReview(id, handler.AuditActor(c)); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -0,0 +1,130 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/admin"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminRoleHandler - 管理者ロール・権限ハンドラー
+type AdminRoleHandler struct {
+	adminRoleUsecase *adminusecase.AdminRoleUsecase
+}
+
+// NewAdminRoleHandler - 管理者ロール・権限ハンドラーの生成
+func NewAdminRoleHandler(adminRoleUsecase *adminusecase.AdminRoleUsecase) *AdminRoleHandler {
+	return &AdminRoleHandler{adminRoleUsecase: adminRoleUsecase}
+}
+
+// GetRoles - ロール一覧取得
+func (h *AdminRoleHandler) GetRoles(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	roles, err := h.adminRoleUsecase.GetRoles(handler.AuditActor(c))
+	if err != nil {
+		return roleError(c, err)
+	}
+	return c.JSON(http.StatusOK, roles)
+}
+
+// GetPermissions - 付与できる権限の一覧取得
+func (h *AdminRoleHandler) GetPermissions(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	permissions, err := h.adminRoleUsecase.GetPermissions(handler.AuditActor(c))
+	if err != nil {
+		return roleError(c, err)
+	}
+	return c.JSON(http.StatusOK, permissions)
+}
+
+// CreateRole - カスタムロール作成
+func (h *AdminRoleHandler) CreateRole(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	var req dto.RoleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	role, err := h.adminRoleUsecase.CreateRole(toRoleInput(req), handler.AuditActor(c))
+	if err != nil {
+		return roleError(c, err)
+	}
+	return c.JSON(http.StatusCreated, role)
+}
+
+// UpdateRole - ロール更新
+func (h *AdminRoleHandler) UpdateRole(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid role ID"})
+	}
+	var req dto.RoleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	role, err := h.adminRoleUsecase.UpdateRole(id, toRoleInput(req), handler.AuditActor(c))
+	if err != nil {
+		return roleError(c, err)
+	}
+	return c.JSON(http.StatusOK, role)
+}
+
+// DeleteRole - カスタムロール削除
+func (h *AdminRoleHandler) DeleteRole(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); !isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": adminusecase.ErrAdminForbidden.Error()})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid role ID"})
+	}
+
+	if err := h.adminRoleUsecase.DeleteRole(id, handler.AuditActor(c)); err != nil {
+		return roleError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toRoleInput(req dto.RoleRequest) adminusecase.RoleInput {
+	return adminusecase.RoleInput{
+		Name:        req.Name,
+		NameJa:      req.NameJa,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+}
+
+func roleError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, adminusecase.ErrAdminForbidden):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, adminusecase.ErrAdminRoleNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, adminusecase.ErrRoleNameTaken),
+		errors.Is(err, adminusecase.ErrRoleInUse),
+		errors.Is(err, admin.ErrRoleBuiltIn):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, admin.ErrUnknownPermission),
+		errors.Is(err, admin.ErrRoleNameInvalid),
+		errors.Is(err, admin.ErrRoleNameJaRequired):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
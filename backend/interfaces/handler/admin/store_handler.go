@@ -0,0 +1,115 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminStoreHandler - 管理者向けストアマスタハンドラー
+type AdminStoreHandler struct {
+	adminStoreUsecase *adminusecase.AdminStoreUsecase
+}
+
+// NewAdminStoreHandler - 管理者向けストアマスタハンドラーの生成
+func NewAdminStoreHandler(adminStoreUsecase *adminusecase.AdminStoreUsecase) *AdminStoreHandler {
+	return &AdminStoreHandler{adminStoreUsecase: adminStoreUsecase}
+}
+
+// GetAllStores - ストア一覧取得（無効化済みを含む）
+func (h *AdminStoreHandler) GetAllStores(c echo.Context) error {
+	stores, err := h.adminStoreUsecase.GetAllStores()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, stores)
+}
+
+// CreateStore - ストア作成
+func (h *AdminStoreHandler) CreateStore(c echo.Context) error {
+	var req dto.CreateStoreRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var adminID *int64
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		userID := c.Get("userId").(int64)
+		adminID = &userID
+	}
+
+	input := adminusecase.CreateStoreInput{
+		Code:             req.Code,
+		Name:             req.Name,
+		NameJa:           req.NameJa,
+		DisplayOrder:     req.DisplayOrder,
+		CreatedByAdminID: adminID,
+	}
+
+	store, err := h.adminStoreUsecase.CreateStore(input, handler.AuditActor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusCreated, store)
+}
+
+// UpdateStore - ストア更新
+func (h *AdminStoreHandler) UpdateStore(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid store ID"})
+	}
+
+	var req dto.UpdateStoreRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var adminID *int64
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		userID := c.Get("userId").(int64)
+		adminID = &userID
+	}
+
+	input := adminusecase.UpdateStoreInput{
+		Code:             req.Code,
+		Name:             req.Name,
+		NameJa:           req.NameJa,
+		DisplayOrder:     req.DisplayOrder,
+		IsActive:         req.IsActive,
+		UpdatedByAdminID: adminID,
+	}
+
+	store, err := h.adminStoreUsecase.UpdateStore(id, input, handler.AuditActor(c))
+	if err != nil {
+		if errors.Is(err, adminusecase.ErrStoreCodeImmutable) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, store)
+}
+
+// DeleteStore - ストア削除
+func (h *AdminStoreHandler) DeleteStore(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid store ID"})
+	}
+
+	if err := h.adminStoreUsecase.DeleteStore(id, handler.AuditActor(c)); err != nil {
+		if errors.Is(err, adminusecase.ErrStoreInUse) || errors.Is(err, adminusecase.ErrStoreHasOffers) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
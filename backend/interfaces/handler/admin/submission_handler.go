@@ -0,0 +1,98 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/submission"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminSubmissionHandler - 管理者向け新商品の提案の審査ハンドラー
+type AdminSubmissionHandler struct {
+	adminSubmissionUsecase *adminusecase.AdminSubmissionUsecase
+}
+
+// NewAdminSubmissionHandler - 管理者向け新商品の提案の審査ハンドラーの生成
+func NewAdminSubmissionHandler(adminSubmissionUsecase *adminusecase.AdminSubmissionUsecase) *AdminSubmissionHandler {
+	return &AdminSubmissionHandler{adminSubmissionUsecase: adminSubmissionUsecase}
+}
+
+// GetSubmissions - 審査キュー（?status=pending|approved|rejected、既定は pending）
+func (h *AdminSubmissionHandler) GetSubmissions(c echo.Context) error {
+	submissions, err := h.adminSubmissionUsecase.GetSubmissions(c.QueryParam("status"))
+	if err != nil {
+		return submissionError(c, err)
+	}
+	return c.JSON(http.StatusOK, submissions)
+}
+
+// ApproveSubmission - 提案を承認して商品を登録（本文は商品作成と同じ形式で、省略した名前・説明・画像は提案の内容を使う）
+func (h *AdminSubmissionHandler) ApproveSubmission(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid submission ID"})
+	}
+
+	var req dto.CreateProductRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	input := adminusecase.CreateProductInput{
+		Name:              req.Name,
+		NameJa:            req.NameJa,
+		Description:       req.Description,
+		DescriptionJa:     req.DescriptionJa,
+		ImageURL:          req.ImageURL,
+		AffiliateURL:      req.AffiliateURL,
+		StoreLinks:        toStoreLinkInputs(req.StoreLinks, req.AmazonURL, req.RakutenURL, req.YahooURL),
+		CategoryIDs:       req.CategoryIDs,
+		DietaryAttributes: req.DietaryAttributes,
+		Certifications:    toCertificationInputs(req.Certifications),
+		Nutrition:         toNutritionInput(req.Nutrition),
+	}
+
+	s, err := h.adminSubmissionUsecase.ApproveSubmission(id, input, handler.AuditActor(c))
+	if err != nil {
+		return submissionError(c, err)
+	}
+	return c.JSON(http.StatusOK, s)
+}
+
+// RejectSubmission - 理由を付けて提案を却下
+func (h *AdminSubmissionHandler) RejectSubmission(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid submission ID"})
+	}
+
+	var req dto.RejectSubmissionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	s, err := h.adminSubmissionUsecase.RejectSubmission(id, req.Reason, handler.AuditActor(c))
+	if err != nil {
+		return submissionError(c, err)
+	}
+	return c.JSON(http.StatusOK, s)
+}
+
+func submissionError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, submission.ErrSubmissionNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, submission.ErrNotPending),
+		errors.Is(err, submission.ErrDuplicateProduct):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		// 商品のバリデーションエラー等
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+}
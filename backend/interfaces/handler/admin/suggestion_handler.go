@@ -0,0 +1,98 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/product"
+	"backend/domain/suggestion"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminSuggestionHandler - 管理者向け商品情報の修正提案の審査ハンドラー
+type AdminSuggestionHandler struct {
+	adminSuggestionUsecase *adminusecase.AdminSuggestionUsecase
+}
+
+// NewAdminSuggestionHandler - 管理者向け商品情報の修正提案の審査ハンドラーの生成
+func NewAdminSuggestionHandler(adminSuggestionUsecase *adminusecase.AdminSuggestionUsecase) *AdminSuggestionHandler {
+	return &AdminSuggestionHandler{adminSuggestionUsecase: adminSuggestionUsecase}
+}
+
+// GetSuggestions - 審査キュー（?status=pending|approved|rejected、既定は pending）
+func (h *AdminSuggestionHandler) GetSuggestions(c echo.Context) error {
+	suggestions, err := h.adminSuggestionUsecase.GetSuggestions(c.QueryParam("status"))
+	if err != nil {
+		return suggestionError(c, err)
+	}
+	return c.JSON(http.StatusOK, suggestions)
+}
+
+// GetSuggestion - 修正提案の詳細（現在の商品との項目ごとの比較を含む）
+func (h *AdminSuggestionHandler) GetSuggestion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid suggestion ID"})
+	}
+
+	s, err := h.adminSuggestionUsecase.GetSuggestion(id)
+	if err != nil {
+		return suggestionError(c, err)
+	}
+	return c.JSON(http.StatusOK, s)
+}
+
+// ApproveSuggestion - 修正提案を承認して商品に反映（fields で反映する項目を選択）
+func (h *AdminSuggestionHandler) ApproveSuggestion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid suggestion ID"})
+	}
+
+	var req dto.ApproveSuggestionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	s, err := h.adminSuggestionUsecase.ApproveSuggestion(id, req.Fields, handler.AuditActor(c))
+	if err != nil {
+		return suggestionError(c, err)
+	}
+	return c.JSON(http.StatusOK, s)
+}
+
+// RejectSuggestion - 理由を付けて修正提案を却下
+func (h *AdminSuggestionHandler) RejectSuggestion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid suggestion ID"})
+	}
+
+	var req dto.RejectSuggestionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	s, err := h.adminSuggestionUsecase.RejectSuggestion(id, req.Reason, handler.AuditActor(c))
+	if err != nil {
+		return suggestionError(c, err)
+	}
+	return c.JSON(http.StatusOK, s)
+}
+
+func suggestionError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, suggestion.ErrSuggestionNotFound), errors.Is(err, product.ErrProductNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, suggestion.ErrNotPending), errors.Is(err, suggestion.ErrFieldOutdated):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		// 商品のバリデーションエラー等
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+}
@@ -0,0 +1,161 @@
+package adminhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/webhook"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminWebhookHandler - 管理者向け Webhook ハンドラー
+type AdminWebhookHandler struct {
+	adminWebhookUsecase *adminusecase.AdminWebhookUsecase
+}
+
+// NewAdminWebhookHandler - 管理者向け Webhook ハンドラーの生成
+func NewAdminWebhookHandler(adminWebhookUsecase *adminusecase.AdminWebhookUsecase) *AdminWebhookHandler {
+	return &AdminWebhookHandler{adminWebhookUsecase: adminWebhookUsecase}
+}
+
+// GetAllWebhooks - Webhook 一覧取得
+func (h *AdminWebhookHandler) GetAllWebhooks(c echo.Context) error {
+	webhooks, err := h.adminWebhookUsecase.GetAllWebhooks()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, webhooks)
+}
+
+// CreateWebhook - Webhook 作成（シークレットはこの応答でのみ返す）
+func (h *AdminWebhookHandler) CreateWebhook(c echo.Context) error {
+	var req dto.CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var adminID *int64
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		userID := c.Get("userId").(int64)
+		adminID = &userID
+	}
+
+	input := adminusecase.CreateWebhookInput{
+		Name:             req.Name,
+		URL:              req.URL,
+		Secret:           req.Secret,
+		EventTypes:       req.EventTypes,
+		ProductIDs:       req.ProductIDs,
+		CreatedByAdminID: adminID,
+	}
+
+	created, err := h.adminWebhookUsecase.CreateWebhook(input, handler.AuditActor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusCreated, created)
+}
+
+// UpdateWebhook - Webhook 更新
+func (h *AdminWebhookHandler) UpdateWebhook(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook ID"})
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var adminID *int64
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		userID := c.Get("userId").(int64)
+		adminID = &userID
+	}
+
+	input := adminusecase.UpdateWebhookInput{
+		Name:             req.Name,
+		URL:              req.URL,
+		Secret:           req.Secret,
+		EventTypes:       req.EventTypes,
+		ProductIDs:       req.ProductIDs,
+		IsActive:         req.IsActive,
+		UpdatedByAdminID: adminID,
+	}
+
+	updated, err := h.adminWebhookUsecase.UpdateWebhook(id, input, handler.AuditActor(c))
+	if err != nil {
+		if errors.Is(err, webhook.ErrWebhookNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, updated)
+}
+
+// DeleteWebhook - Webhook 削除
+func (h *AdminWebhookHandler) DeleteWebhook(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook ID"})
+	}
+
+	if err := h.adminWebhookUsecase.DeleteWebhook(id, handler.AuditActor(c)); err != nil {
+		if errors.Is(err, webhook.ErrWebhookNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetDeliveries - 配信ログ取得（?subscriptionId=&status=pending|succeeded|dead）
+func (h *AdminWebhookHandler) GetDeliveries(c echo.Context) error {
+	var filter webhook.DeliveryFilter
+	if subscriptionIDStr := c.QueryParam("subscriptionId"); subscriptionIDStr != "" {
+		subscriptionID, err := strconv.ParseInt(subscriptionIDStr, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid subscription ID"})
+		}
+		filter.SubscriptionID = subscriptionID
+	}
+	filter.Status = c.QueryParam("status")
+
+	deliveries, err := h.adminWebhookUsecase.GetDeliveries(filter)
+	if err != nil {
+		if errors.Is(err, adminusecase.ErrDeliveryStatus) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// ReplayDelivery - 配信を再送
+func (h *AdminWebhookHandler) ReplayDelivery(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid delivery ID"})
+	}
+
+	delivery, err := h.adminWebhookUsecase.ReplayDelivery(id, handler.AuditActor(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrDeliveryNotFound), errors.Is(err, webhook.ErrWebhookNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		case errors.Is(err, adminusecase.ErrDeliveryInProgress), errors.Is(err, adminusecase.ErrWebhookInactive):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusAccepted, delivery)
+}
@@ -0,0 +1,89 @@
+package customerhandler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"backend/domain/customer"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AccountHandler - 個人データのエクスポートと退会ハンドラー
+type AccountHandler struct {
+	accountUsecase *customerusecase.AccountUsecase
+}
+
+// NewAccountHandler - 個人データのエクスポートと退会ハンドラーの生成
+func NewAccountHandler(accountUsecase *customerusecase.AccountUsecase) *AccountHandler {
+	return &AccountHandler{accountUsecase: accountUsecase}
+}
+
+// ExportData - 個人データのダウンロード（?format=json|zip、既定は json）
+func (h *AccountHandler) ExportData(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "zip" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be json or zip"})
+	}
+
+	export, err := h.accountUsecase.ExportData(c.Get("userId").(int64))
+	if err != nil {
+		return accountError(c, err)
+	}
+
+	filename := fmt.Sprintf("veganbite-export-%d-%s.%s", export.Profile.ID, export.ExportedAt.Format("20060102"), format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	if format == "json" {
+		return c.JSON(http.StatusOK, export)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, "application/zip")
+	c.Response().WriteHeader(http.StatusOK)
+	return export.WriteZip(c.Response())
+}
+
+// RequestDeletion - 退会申請（猶予期間後に匿名化）
+func (h *AccountHandler) RequestDeletion(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	profile, err := h.accountUsecase.RequestDeletion(c.Get("userId").(int64))
+	if err != nil {
+		return accountError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, profile)
+}
+
+// CancelDeletion - 猶予期間中の退会申請の取り消し
+func (h *AccountHandler) CancelDeletion(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	profile, err := h.accountUsecase.CancelDeletion(c.Get("userId").(int64))
+	if err != nil {
+		return accountError(c, err)
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+func accountError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, customerusecase.ErrProfileNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, customer.ErrDeletionAlreadyRequested),
+		errors.Is(err, customer.ErrDeletionNotRequested):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
@@ -0,0 +1,52 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ClickHandler - アフィリエイトリンクのクリック計測ハンドラー
+type ClickHandler struct {
+	clickUsecase *customerusecase.ClickUsecase
+}
+
+// NewClickHandler - クリック計測ハンドラーの生成
+func NewClickHandler(clickUsecase *customerusecase.ClickUsecase) *ClickHandler {
+	return &ClickHandler{clickUsecase: clickUsecase}
+}
+
+// GoToStore - クリックを記録してストアのページへリダイレクト
+func (h *ClickHandler) GoToStore(c echo.Context) error {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	input := customerusecase.TrackClickInput{
+		ProductID: productID,
+		Store:     c.Param("store"),
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+		Referrer:  c.Request().Referer(),
+	}
+
+	target, err := h.clickUsecase.TrackClick(input)
+	if target == "" {
+		if errors.Is(err, customerusecase.ErrClickProductNotFound) || errors.Is(err, customerusecase.ErrClickLinkNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	if err != nil {
+		// 記録に失敗しても遷移は妨げない
+		c.Logger().Error(err)
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.Redirect(http.StatusFound, target)
+}
@@ -0,0 +1,262 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/favorite"
+	"backend/interfaces/dto"
+	"backend/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CollectionHandler - お気に入りリストハンドラー
+type CollectionHandler struct {
+	collectionUsecase *usecase.CollectionUsecase
+}
+
+// NewCollectionHandler - お気に入りリストハンドラーの生成
+func NewCollectionHandler(collectionUsecase *usecase.CollectionUsecase) *CollectionHandler {
+	return &CollectionHandler{collectionUsecase: collectionUsecase}
+}
+
+// GetCustomerCollections - カスタマーのリスト一覧取得
+func (h *CollectionHandler) GetCustomerCollections(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	collections, err := h.collectionUsecase.GetCustomerCollections(customerID, requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, collections)
+}
+
+// CreateCollection - リスト作成
+func (h *CollectionHandler) CreateCollection(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	var req dto.CollectionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	collection, err := h.collectionUsecase.CreateCollection(customerID, toCollectionInput(req), requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusCreated, collection)
+}
+
+// GetCollection - リスト取得
+func (h *CollectionHandler) GetCollection(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	collection, err := h.collectionUsecase.GetCollection(collectionID, requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, collection)
+}
+
+// UpdateCollection - リスト更新
+func (h *CollectionHandler) UpdateCollection(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	var req dto.CollectionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	collection, err := h.collectionUsecase.UpdateCollection(collectionID, toCollectionInput(req), requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, collection)
+}
+
+// DeleteCollection - リスト削除
+func (h *CollectionHandler) DeleteCollection(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	if err := h.collectionUsecase.DeleteCollection(collectionID, requestCustomerID); err != nil {
+		return collectionError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AddItem - リストに商品を追加
+func (h *CollectionHandler) AddItem(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	var req dto.AddCollectionItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if req.ProductID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	item, err := h.collectionUsecase.AddItem(collectionID, usecase.CollectionItemInput{
+		ProductID: req.ProductID,
+		Note:      req.Note,
+	}, requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusCreated, item)
+}
+
+// UpdateItem - リスト内商品のメモ更新
+func (h *CollectionHandler) UpdateItem(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	productID, err := strconv.ParseInt(c.Param("productId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	var req dto.UpdateCollectionItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := h.collectionUsecase.UpdateItemNote(collectionID, productID, req.Note, requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, item)
+}
+
+// RemoveItem - リストから商品を外す
+func (h *CollectionHandler) RemoveItem(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	productID, err := strconv.ParseInt(c.Param("productId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	if err := h.collectionUsecase.RemoveItem(collectionID, productID, requestCustomerID); err != nil {
+		return collectionError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ReorderItems - リスト内の並び替え
+func (h *CollectionHandler) ReorderItems(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	collectionID, err := strconv.ParseInt(c.Param("collectionId"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid collection ID"})
+	}
+	requestCustomerID := c.Get("userId").(int64)
+
+	var req dto.ReorderCollectionItemsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	collection, err := h.collectionUsecase.ReorderItems(collectionID, req.ProductIDs, requestCustomerID)
+	if err != nil {
+		return collectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, collection)
+}
+
+// GetSharedCollection - 共有リンクから公開リストを取得（認証不要）
+func (h *CollectionHandler) GetSharedCollection(c echo.Context) error {
+	collection, err := h.collectionUsecase.GetSharedCollection(c.Param("token"))
+	if err != nil {
+		return collectionError(c, err)
+	}
+	// 共有閲覧では所有者や共有トークンを返さない
+	collection.CustomerID = 0
+	collection.ShareToken = nil
+	return c.JSON(http.StatusOK, collection)
+}
+
+func toCollectionInput(req dto.CollectionRequest) usecase.CollectionInput {
+	return usecase.CollectionInput{
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	}
+}
+
+// collectionError - ユースケースのエラーをHTTPステータスに変換
+func collectionError(c echo.Context, err error) error {
+	switch {
+	case err.Error() == "permission denied":
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, usecase.ErrCollectionNotFound), errors.Is(err, usecase.ErrCollectionItemNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, usecase.ErrCollectionItemExists), errors.Is(err, usecase.ErrDefaultCollectionDelete):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, usecase.ErrCollectionOrderInvalid),
+		errors.Is(err, favorite.ErrCollectionNameEmpty),
+		errors.Is(err, favorite.ErrCollectionNameTooLong),
+		errors.Is(err, favorite.ErrCollectionDescriptionTooLong),
+		errors.Is(err, favorite.ErrCollectionItemNoteTooLong):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
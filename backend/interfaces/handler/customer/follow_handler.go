@@ -0,0 +1,94 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/follow"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// FollowHandler - レビュアーのフォローとフィードハンドラー
+type FollowHandler struct {
+	followUsecase *customerusecase.FollowUsecase
+}
+
+// NewFollowHandler - レビュアーのフォローとフィードハンドラーの生成
+func NewFollowHandler(followUsecase *customerusecase.FollowUsecase) *FollowHandler {
+	return &FollowHandler{followUsecase: followUsecase}
+}
+
+// Follow - カスタマーをフォロー
+func (h *FollowHandler) Follow(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	followeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+
+	if err := h.followUsecase.Follow(c.Get("userId").(int64), followeeID); err != nil {
+		switch {
+		case errors.Is(err, follow.ErrCannotFollowSelf):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		case errors.Is(err, customerusecase.ErrProfileNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		default:
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Unfollow - フォロー解除
+func (h *FollowHandler) Unfollow(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	followeeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+
+	if err := h.followUsecase.Unfollow(c.Get("userId").(int64), followeeID); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetFeed - フォロー中のカスタマーの新しいレビュー（?before=<レビューID>&limit=）
+func (h *FollowHandler) GetFeed(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	var beforeID int64
+	if v := c.QueryParam("before"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid before"})
+		}
+		beforeID = id
+	}
+	limit := 0
+	if v := c.QueryParam("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit"})
+		}
+		limit = parsed
+	}
+
+	page, err := h.followUsecase.GetFeed(c.Get("userId").(int64), beforeID, limit)
+	if err != nil {
+		if errors.Is(err, customerusecase.ErrFeedLimitInvalid) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, page)
+}
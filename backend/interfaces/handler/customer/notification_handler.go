@@ -0,0 +1,122 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/notification"
+	"backend/interfaces/dto"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationHandler - 通知ハンドラー
+type NotificationHandler struct {
+	notificationUsecase *customerusecase.NotificationUsecase
+}
+
+// NewNotificationHandler - 通知ハンドラーの生成
+func NewNotificationHandler(notificationUsecase *customerusecase.NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{notificationUsecase: notificationUsecase}
+}
+
+// GetNotifications - 通知一覧取得（?unread=true で未読のみ、?limit=）
+func (h *NotificationHandler) GetNotifications(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID := c.Get("userId").(int64)
+	unreadOnly := c.QueryParam("unread") == "true"
+	limit := 0
+	if v := c.QueryParam("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit"})
+		}
+		limit = parsed
+	}
+
+	inbox, err := h.notificationUsecase.GetInbox(customerID, unreadOnly, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, inbox)
+}
+
+// MarkRead - 指定した通知を既読にする
+func (h *NotificationHandler) MarkRead(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID := c.Get("userId").(int64)
+
+	var req dto.MarkNotificationsReadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if len(req.IDs) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ids is required"})
+	}
+
+	updated, err := h.notificationUsecase.MarkRead(customerID, req.IDs)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]int64{"updated": updated})
+}
+
+// MarkAllRead - 全ての未読通知を既読にする
+func (h *NotificationHandler) MarkAllRead(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID := c.Get("userId").(int64)
+
+	updated, err := h.notificationUsecase.MarkAllRead(customerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]int64{"updated": updated})
+}
+
+// GetPreferences - 受信設定取得
+func (h *NotificationHandler) GetPreferences(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID := c.Get("userId").(int64)
+
+	prefs, err := h.notificationUsecase.GetPreferences(customerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, prefs)
+}
+
+// UpdatePreferences - 受信設定更新
+func (h *NotificationHandler) UpdatePreferences(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID := c.Get("userId").(int64)
+
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	inputs := make([]customerusecase.PreferenceInput, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		inputs = append(inputs, customerusecase.PreferenceInput{Type: p.Type, InApp: p.InApp, Email: p.Email})
+	}
+
+	prefs, err := h.notificationUsecase.UpdatePreferences(customerID, inputs)
+	if err != nil {
+		if errors.Is(err, notification.ErrTypeInvalid) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, prefs)
+}
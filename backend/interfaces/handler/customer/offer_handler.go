@@ -0,0 +1,34 @@
+package customerhandler
+
+import (
+	"net/http"
+	"strconv"
+
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OfferHandler - 利用者向けストア価格ハンドラー
+type OfferHandler struct {
+	offerUsecase *customerusecase.OfferUsecase
+}
+
+// NewOfferHandler - 利用者向けストア価格ハンドラーの生成
+func NewOfferHandler(offerUsecase *customerusecase.OfferUsecase) *OfferHandler {
+	return &OfferHandler{offerUsecase: offerUsecase}
+}
+
+// GetProductOffers - 商品のストア価格・最安値・価格推移を取得
+func (h *OfferHandler) GetProductOffers(c echo.Context) error {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	offers, err := h.offerUsecase.GetProductOffers(productID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, offers)
+}
@@ -3,7 +3,9 @@ package customerhandler
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
+	"backend/domain/product"
 	customerusecase "backend/usecase/customer"
 
 	"github.com/labstack/echo/v4"
@@ -28,6 +30,15 @@ func (h *ProductHandler) GetCategories(c echo.Context) error {
 	return c.JSON(http.StatusOK, categories)
 }
 
+// GetStores - ストア一覧取得
+func (h *ProductHandler) GetStores(c echo.Context) error {
+	stores, err := h.productUsecase.GetActiveStores()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, stores)
+}
+
 // GetProducts - 商品一覧取得
 func (h *ProductHandler) GetProducts(c echo.Context) error {
 	categoryStr := c.QueryParam("category")
@@ -42,7 +53,49 @@ func (h *ProductHandler) GetProducts(c echo.Context) error {
 		}
 	}
 
-	products, err := h.productUsecase.GetAllProducts(categoryID, search)
+	filter := product.ProductFilter{
+		CategoryID: categoryID,
+		Search:     search,
+	}
+
+	if attributesStr := c.QueryParam("attributes"); attributesStr != "" {
+		attributes, err := product.NewDietaryAttributes(strings.Split(attributesStr, ","))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		filter.DietaryAttributes = attributes
+	}
+
+	if certifiedStr := c.QueryParam("certified"); certifiedStr != "" {
+		certified, err := strconv.ParseBool(certifiedStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid certified flag"})
+		}
+		filter.CertifiedOnly = certified
+	}
+
+	sort, err := product.NewProductSort(c.QueryParam("sort"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	filter.Sort = sort
+
+	if highProteinStr := c.QueryParam("highProtein"); highProteinStr != "" {
+		highProtein, err := strconv.ParseBool(highProteinStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid highProtein flag"})
+		}
+		filter.HighProtein = highProtein
+	}
+
+	if filter.MinProteinPer100g, err = parseOptionalFloat(c.QueryParam("minProtein")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid minProtein"})
+	}
+	if filter.MaxCaloriesPer100g, err = parseOptionalFloat(c.QueryParam("maxCalories")); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid maxCalories"})
+	}
+
+	products, err := h.productUsecase.GetAllProducts(filter)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
@@ -63,3 +116,15 @@ func (h *ProductHandler) GetProduct(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, product)
 }
+
+// parseOptionalFloat - 空文字ならnil、それ以外は数値に変換
+func parseOptionalFloat(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
@@ -0,0 +1,119 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/customer"
+	"backend/interfaces/dto"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ProfileHandler - 本人のプロフィールハンドラー
+type ProfileHandler struct {
+	profileUsecase *customerusecase.ProfileUsecase
+}
+
+// NewProfileHandler - 本人のプロフィールハンドラーの生成
+func NewProfileHandler(profileUsecase *customerusecase.ProfileUsecase) *ProfileHandler {
+	return &ProfileHandler{profileUsecase: profileUsecase}
+}
+
+// GetMe - 本人のプロフィール取得
+func (h *ProfileHandler) GetMe(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	profile, err := h.profileUsecase.GetProfile(c.Get("userId").(int64))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// UpdateMe - 本人のプロフィール部分更新
+func (h *ProfileHandler) UpdateMe(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	var req dto.UpdateProfileRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	profile, err := h.profileUsecase.UpdateProfile(c.Get("userId").(int64), customerusecase.UpdateProfileInput{
+		Name:              req.Name,
+		Avatar:            req.Avatar,
+		Bio:               req.Bio,
+		PreferredLanguage: req.PreferredLanguage,
+		DietaryPreference: req.DietaryPreference,
+		ProfileVisibility: req.ProfileVisibility,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customerusecase.ErrProfileNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		case errors.Is(err, customer.ErrDisplayNameEmpty),
+			errors.Is(err, customer.ErrDisplayNameTooLong),
+			errors.Is(err, customer.ErrDisplayNameInvalid),
+			errors.Is(err, customer.ErrBioTooLong),
+			errors.Is(err, customer.ErrAvatarURLInvalid),
+			errors.Is(err, customer.ErrLanguageInvalid),
+			errors.Is(err, customer.ErrDietaryPreferenceValue),
+			errors.Is(err, customer.ErrVisibilityInvalid):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		default:
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// GetPublicProfile - 公開プロフィール取得（非公開の場合は本人以外 404）
+func (h *ProfileHandler) GetPublicProfile(c echo.Context) error {
+	customerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+
+	profile, err := h.profileUsecase.GetPublicProfile(customerID, viewerID(c))
+	if err != nil {
+		return publicProfileError(c, err)
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
+// GetCustomerReviews - カスタマーのレビュー一覧取得（公開範囲はプロフィールと同じ）
+func (h *ProfileHandler) GetCustomerReviews(c echo.Context) error {
+	customerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
+	}
+
+	reviews, err := h.profileUsecase.GetPublicReviews(customerID, viewerID(c))
+	if err != nil {
+		return publicProfileError(c, err)
+	}
+	return c.JSON(http.StatusOK, reviews)
+}
+
+// viewerID - 閲覧中のカスタマーID（未ログイン・管理者は 0。管理者IDはカスタマーIDと別の採番のため本人扱いしない）
+func viewerID(c echo.Context) int64 {
+	if isAdmin, _ := c.Get("isAdmin").(bool); isAdmin {
+		return 0
+	}
+	id, _ := c.Get("userId").(int64)
+	return id
+}
+
+func publicProfileError(c echo.Context, err error) error {
+	if errors.Is(err, customerusecase.ErrProfileNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
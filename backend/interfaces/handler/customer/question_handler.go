@@ -0,0 +1,223 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/admin"
+	"backend/domain/product"
+	"backend/domain/question"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// QuestionHandler - 商品Q&Aハンドラー
+type QuestionHandler struct {
+	questionUsecase      *customerusecase.QuestionUsecase
+	adminQuestionUsecase *adminusecase.AdminQuestionUsecase
+	authorizer           *admin.Authorizer
+}
+
+// NewQuestionHandler - 商品Q&Aハンドラーの生成（管理者の回答は question:answer 権限で公式回答、削除は question:moderate 権限で監査ログを残す）
+func NewQuestionHandler(questionUsecase *customerusecase.QuestionUsecase, adminQuestionUsecase *adminusecase.AdminQuestionUsecase, authorizer *admin.Authorizer) *QuestionHandler {
+	return &QuestionHandler{questionUsecase: questionUsecase, adminQuestionUsecase: adminQuestionUsecase, authorizer: authorizer}
+}
+
+// GetProductQuestions - 商品への質問一覧
+func (h *QuestionHandler) GetProductQuestions(c echo.Context) error {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	questions, err := h.questionUsecase.GetProductQuestions(productID)
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.JSON(http.StatusOK, questions)
+}
+
+// GetQuestion - 質問と回答の取得（ログイン中のカスタマーには賛成済みかを含める）
+func (h *QuestionHandler) GetQuestion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid question ID"})
+	}
+
+	q, err := h.questionUsecase.GetQuestion(id, viewerID(c))
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.JSON(http.StatusOK, q)
+}
+
+// AskQuestion - 商品に質問を投稿
+func (h *QuestionHandler) AskQuestion(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	var req dto.AskQuestionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	q, err := h.questionUsecase.AskQuestion(productID, c.Get("userId").(int64), req.Body)
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.JSON(http.StatusCreated, q)
+}
+
+// DeleteQuestion - 質問削除（質問者本人、または question:moderate 権限を持つ管理者）
+func (h *QuestionHandler) DeleteQuestion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid question ID"})
+	}
+
+	userID := c.Get("userId").(int64)
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		if err = h.authorizer.Authorize(userID, admin.PermQuestionModerate); err == nil {
+			err = h.adminQuestionUsecase.DeleteQuestion(id, handler.AuditActor(c))
+		}
+	} else {
+		err = h.questionUsecase.DeleteQuestion(id, userID)
+	}
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AnswerQuestion - 質問に回答（question:answer 権限を持つ管理者の回答は公式回答）
+func (h *QuestionHandler) AnswerQuestion(c echo.Context) error {
+	questionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid question ID"})
+	}
+
+	var req dto.AnswerQuestionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	userID := c.Get("userId").(int64)
+	var a *question.Answer
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		if err = h.authorizer.Authorize(userID, admin.PermQuestionAnswer); err == nil {
+			a, err = h.adminQuestionUsecase.CreateOfficialAnswer(questionID, req.Body, handler.AuditActor(c))
+		}
+	} else {
+		a, err = h.questionUsecase.AnswerQuestion(questionID, userID, req.Body)
+	}
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.JSON(http.StatusCreated, a)
+}
+
+// DeleteAnswer - 回答削除（回答者本人、または question:moderate 権限を持つ管理者）
+func (h *QuestionHandler) DeleteAnswer(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid answer ID"})
+	}
+
+	userID := c.Get("userId").(int64)
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		if err = h.authorizer.Authorize(userID, admin.PermQuestionModerate); err == nil {
+			err = h.adminQuestionUsecase.DeleteAnswer(id, handler.AuditActor(c))
+		}
+	} else {
+		err = h.questionUsecase.DeleteAnswer(id, userID)
+	}
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AcceptAnswer - 質問者が回答を採用（answerId が null なら採用を取り消す）
+func (h *QuestionHandler) AcceptAnswer(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	questionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid question ID"})
+	}
+
+	var req dto.AcceptAnswerRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	q, err := h.questionUsecase.AcceptAnswer(questionID, c.Get("userId").(int64), req.AnswerID)
+	if err != nil {
+		return questionError(c, err)
+	}
+	return c.JSON(http.StatusOK, q)
+}
+
+// UpvoteAnswer - 回答に賛成
+func (h *QuestionHandler) UpvoteAnswer(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid answer ID"})
+	}
+
+	if err := h.questionUsecase.UpvoteAnswer(id, c.Get("userId").(int64)); err != nil {
+		return questionError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RemoveUpvote - 回答への賛成を取り消す
+func (h *QuestionHandler) RemoveUpvote(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid answer ID"})
+	}
+
+	if err := h.questionUsecase.RemoveUpvote(id, c.Get("userId").(int64)); err != nil {
+		return questionError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func questionError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, question.ErrBodyEmpty),
+		errors.Is(err, question.ErrQuestionBodyTooLong),
+		errors.Is(err, question.ErrAnswerBodyTooLong),
+		errors.Is(err, question.ErrAnswerMismatch),
+		errors.Is(err, question.ErrCannotUpvoteOwnAnswer):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, product.ErrProductNotFound),
+		errors.Is(err, question.ErrQuestionNotFound),
+		errors.Is(err, question.ErrAnswerNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, customerusecase.ErrQuestionPermissionDenied),
+		errors.Is(err, question.ErrNotAsker),
+		errors.Is(err, admin.ErrPermissionDenied):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
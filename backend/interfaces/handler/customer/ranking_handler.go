@@ -0,0 +1,43 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/ranking"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RankingHandler - 利用者向けランキングハンドラー
+type RankingHandler struct {
+	rankingUsecase *customerusecase.RankingUsecase
+}
+
+// NewRankingHandler - 利用者向けランキングハンドラーの生成
+func NewRankingHandler(rankingUsecase *customerusecase.RankingUsecase) *RankingHandler {
+	return &RankingHandler{rankingUsecase: rankingUsecase}
+}
+
+// GetRanking - ランキング取得（?type=trending|top&category=）
+func (h *RankingHandler) GetRanking(c echo.Context) error {
+	var categoryID int64
+	if categoryStr := c.QueryParam("category"); categoryStr != "" {
+		id, err := strconv.ParseInt(categoryStr, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid category ID"})
+		}
+		categoryID = id
+	}
+
+	result, err := h.rankingUsecase.GetRanking(c.QueryParam("type"), categoryID)
+	if err != nil {
+		if errors.Is(err, ranking.ErrTypeInvalid) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, result)
+}
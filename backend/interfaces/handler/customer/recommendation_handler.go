@@ -0,0 +1,53 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RecommendationHandler - 利用者向け推薦ハンドラー
+type RecommendationHandler struct {
+	recommendationUsecase *customerusecase.RecommendationUsecase
+}
+
+// NewRecommendationHandler - 利用者向け推薦ハンドラーの生成
+func NewRecommendationHandler(recommendationUsecase *customerusecase.RecommendationUsecase) *RecommendationHandler {
+	return &RecommendationHandler{recommendationUsecase: recommendationUsecase}
+}
+
+// GetSimilarProducts - 類似商品一覧取得
+func (h *RecommendationHandler) GetSimilarProducts(c echo.Context) error {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	products, err := h.recommendationUsecase.GetSimilarProducts(productID)
+	if err != nil {
+		if errors.Is(err, customerusecase.ErrRecommendationProductNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Product not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, products)
+}
+
+// GetRecommendations - ログイン中の顧客向け推薦商品一覧取得
+func (h *RecommendationHandler) GetRecommendations(c echo.Context) error {
+	// 管理者トークンの userId は管理者のIDのため、同じIDの顧客の推薦を返さない
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+	customerID := c.Get("userId").(int64)
+
+	products, err := h.recommendationUsecase.GetRecommendations(customerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, products)
+}
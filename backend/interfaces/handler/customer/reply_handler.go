@@ -0,0 +1,108 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/admin"
+	"backend/domain/review"
+	"backend/interfaces/dto"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReplyHandler - レビューへの返信ハンドラー
+type ReplyHandler struct {
+	replyUsecase       *customerusecase.ReplyUsecase
+	adminReviewUsecase *adminusecase.AdminReviewUsecase
+	authorizer         *admin.Authorizer
+}
+
+// NewReplyHandler - レビューへの返信ハンドラーの生成（管理者の返信は review:reply 権限で公式返信、削除は review:moderate 権限で監査ログを残す）
+func NewReplyHandler(replyUsecase *customerusecase.ReplyUsecase, adminReviewUsecase *adminusecase.AdminReviewUsecase, authorizer *admin.Authorizer) *ReplyHandler {
+	return &ReplyHandler{replyUsecase: replyUsecase, adminReviewUsecase: adminReviewUsecase, authorizer: authorizer}
+}
+
+// GetReplies - レビューへの返信をスレッドで取得
+func (h *ReplyHandler) GetReplies(c echo.Context) error {
+	reviewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid review ID"})
+	}
+
+	replies, err := h.replyUsecase.GetReplies(reviewID)
+	if err != nil {
+		return replyError(c, err)
+	}
+	return c.JSON(http.StatusOK, replies)
+}
+
+// CreateReply - レビューまたは返信への返信（parentId を省略するとレビューへの直接の返信）
+func (h *ReplyHandler) CreateReply(c echo.Context) error {
+	reviewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid review ID"})
+	}
+
+	var req dto.CreateReplyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	userID := c.Get("userId").(int64)
+	var reply *review.Reply
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		if err = h.authorizer.Authorize(userID, admin.PermReviewReply); err == nil {
+			reply, err = h.adminReviewUsecase.CreateOfficialReply(reviewID, req.ParentID, req.Body, handler.AuditActor(c))
+		}
+	} else {
+		reply, err = h.replyUsecase.CreateReply(reviewID, userID, req.ParentID, req.Body)
+	}
+	if err != nil {
+		return replyError(c, err)
+	}
+	return c.JSON(http.StatusCreated, reply)
+}
+
+// DeleteReply - 返信削除（本人、または review:moderate 権限を持つ管理者）
+func (h *ReplyHandler) DeleteReply(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid reply ID"})
+	}
+
+	userID := c.Get("userId").(int64)
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		if err = h.authorizer.Authorize(userID, admin.PermReviewModerate); err == nil {
+			err = h.adminReviewUsecase.DeleteReply(id, handler.AuditActor(c))
+		}
+	} else {
+		err = h.replyUsecase.DeleteReply(id, userID)
+	}
+	if err != nil {
+		return replyError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func replyError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, review.ErrReplyBodyEmpty),
+		errors.Is(err, review.ErrReplyBodyTooLong),
+		errors.Is(err, review.ErrReplyTooDeep),
+		errors.Is(err, review.ErrReplyParentMismatch):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, review.ErrReviewNotFound),
+		errors.Is(err, review.ErrReplyNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, customerusecase.ErrReplyPermissionDenied),
+		errors.Is(err, admin.ErrPermissionDenied):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
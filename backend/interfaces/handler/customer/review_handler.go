@@ -4,7 +4,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"backend/domain/admin"
 	"backend/domain/review"
+	"backend/interfaces/handler"
+	adminusecase "backend/usecase/admin"
 	customerusecase "backend/usecase/customer"
 
 	"github.com/labstack/echo/v4"
@@ -12,12 +15,14 @@ import (
 
 // ReviewHandler - レビューハンドラー
 type ReviewHandler struct {
-	reviewUsecase *customerusecase.ReviewUsecase
+	reviewUsecase      *customerusecase.ReviewUsecase
+	adminReviewUsecase *adminusecase.AdminReviewUsecase
+	authorizer         *admin.Authorizer
 }
 
-// NewReviewHandler - レビューハンドラーの生成
-func NewReviewHandler(reviewUsecase *customerusecase.ReviewUsecase) *ReviewHandler {
-	return &ReviewHandler{reviewUsecase: reviewUsecase}
+// NewReviewHandler - レビューハンドラーの生成（管理者による削除は review:moderate 権限を確認し、監査ログを残すため adminReviewUsecase で処理）
+func NewReviewHandler(reviewUsecase *customerusecase.ReviewUsecase, adminReviewUsecase *adminusecase.AdminReviewUsecase, authorizer *admin.Authorizer) *ReviewHandler {
+	return &ReviewHandler{reviewUsecase: reviewUsecase, adminReviewUsecase: adminReviewUsecase, authorizer: authorizer}
 }
 
 // GetProductReviews - 商品のレビュー一覧取得
@@ -35,21 +40,6 @@ func (h *ReviewHandler) GetProductReviews(c echo.Context) error {
 	return c.JSON(http.StatusOK, reviews)
 }
 
-// GetCustomerReviews - カスタマーのレビュー一覧取得
-func (h *ReviewHandler) GetCustomerReviews(c echo.Context) error {
-	customerIDStr := c.Param("id")
-	customerID, err := strconv.ParseInt(customerIDStr, 10, 64)
-	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid customer ID"})
-	}
-
-	reviews, err := h.reviewUsecase.GetCustomerReviews(customerID)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
-	}
-	return c.JSON(http.StatusOK, reviews)
-}
-
 // CreateReview - レビュー作成
 func (h *ReviewHandler) CreateReview(c echo.Context) error {
 	productIDStr := c.Param("id")
@@ -91,7 +81,14 @@ func (h *ReviewHandler) DeleteReview(c echo.Context) error {
 	customerID := c.Get("userId").(int64)
 	isAdmin := c.Get("isAdmin").(bool)
 
-	if err := h.reviewUsecase.DeleteReview(id, customerID, isAdmin); err != nil {
+	if isAdmin {
+		if err = h.authorizer.Authorize(customerID, admin.PermReviewModerate); err == nil {
+			err = h.adminReviewUsecase.DeleteReview(id, handler.AuditActor(c))
+		}
+	} else {
+		err = h.reviewUsecase.DeleteReview(id, customerID, false)
+	}
+	if err != nil {
 		switch err.Error() {
 		case "review not found":
 			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
@@ -0,0 +1,67 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"backend/domain/submission"
+	"backend/interfaces/dto"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SubmissionHandler - 新商品の提案ハンドラー
+type SubmissionHandler struct {
+	submissionUsecase *customerusecase.SubmissionUsecase
+}
+
+// NewSubmissionHandler - 新商品の提案ハンドラーの生成
+func NewSubmissionHandler(submissionUsecase *customerusecase.SubmissionUsecase) *SubmissionHandler {
+	return &SubmissionHandler{submissionUsecase: submissionUsecase}
+}
+
+// SubmitProduct - 新商品を提案（管理者の審査後に商品として登録）
+func (h *SubmissionHandler) SubmitProduct(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	var req dto.SubmitProductRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	s, err := h.submissionUsecase.SubmitProduct(c.Get("userId").(int64), customerusecase.SubmitProductInput{
+		Name:          req.Name,
+		NameJa:        req.NameJa,
+		Description:   req.Description,
+		DescriptionJa: req.DescriptionJa,
+		ImageURL:      req.ImageURL,
+		StoreURL:      req.StoreURL,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, submission.ErrDuplicateProduct):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		case errors.Is(err, submission.ErrTooManyPending):
+			return c.JSON(http.StatusTooManyRequests, map[string]string{"error": err.Error()})
+		default:
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+	}
+	return c.JSON(http.StatusCreated, s)
+}
+
+// GetMySubmissions - 自分の提案一覧（審査結果と却下理由を含む）
+func (h *SubmissionHandler) GetMySubmissions(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	submissions, err := h.submissionUsecase.GetMySubmissions(c.Get("userId").(int64))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, submissions)
+}
@@ -0,0 +1,69 @@
+package customerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/domain/suggestion"
+	"backend/interfaces/dto"
+	customerusecase "backend/usecase/customer"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SuggestionHandler - 商品情報の修正提案ハンドラー
+type SuggestionHandler struct {
+	suggestionUsecase *customerusecase.SuggestionUsecase
+}
+
+// NewSuggestionHandler - 商品情報の修正提案ハンドラーの生成
+func NewSuggestionHandler(suggestionUsecase *customerusecase.SuggestionUsecase) *SuggestionHandler {
+	return &SuggestionHandler{suggestionUsecase: suggestionUsecase}
+}
+
+// SuggestEdit - 商品情報の修正を提案（管理者の審査後に商品へ反映）
+func (h *SuggestionHandler) SuggestEdit(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+
+	var req dto.SuggestEditRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	s, err := h.suggestionUsecase.SuggestEdit(c.Get("userId").(int64), productID, customerusecase.SuggestEditInput{
+		Changes: req.Changes,
+		Comment: req.Comment,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customerusecase.ErrSuggestionProductNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		case errors.Is(err, suggestion.ErrTooManyPending):
+			return c.JSON(http.StatusTooManyRequests, map[string]string{"error": err.Error()})
+		default:
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+	}
+	return c.JSON(http.StatusCreated, s)
+}
+
+// GetMySuggestions - 自分の修正提案一覧（審査結果と反映された項目を含む）
+func (h *SuggestionHandler) GetMySuggestions(c echo.Context) error {
+	if isAdmin := c.Get("isAdmin").(bool); isAdmin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Customer account required"})
+	}
+
+	suggestions, err := h.suggestionUsecase.GetMySuggestions(c.Get("userId").(int64))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, suggestions)
+}
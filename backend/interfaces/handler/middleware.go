@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"strings"
 
+	"backend/domain/admin"
+	"backend/domain/audit"
 	"backend/infrastructure/auth"
 
 	"github.com/labstack/echo/v4"
@@ -39,6 +41,50 @@ func JWTMiddleware(jwtService *auth.JWTService) echo.MiddlewareFunc {
 	}
 }
 
+// OptionalJWTMiddleware - 公開ルート用の JWT 認証（トークンが無い・不正なら未ログインとして続行）
+func OptionalJWTMiddleware(jwtService *auth.JWTService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			tokenString := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+			if tokenString == "" {
+				return next(c)
+			}
+			if claims, err := jwtService.ValidateToken(tokenString); err == nil {
+				c.Set("userId", claims.UserID)
+				c.Set("email", claims.Email)
+				c.Set("isAdmin", claims.IsAdmin)
+				c.Set("role", claims.Role)
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequirePermission - 管理者の権限チェックミドルウェア（JWTMiddleware の後に使う）
+func RequirePermission(authorizer *admin.Authorizer, permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isAdmin, _ := c.Get("isAdmin").(bool); !isAdmin {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Admin access required"})
+			}
+			if err := authorizer.Authorize(c.Get("userId").(int64), permission); err != nil {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": permission + " permission is required"})
+			}
+			return next(c)
+		}
+	}
+}
+
+// AuditActor - 監査ログに記録する操作者（JWTMiddleware 通過後に使う）
+func AuditActor(c echo.Context) audit.Actor {
+	role, _ := c.Get("role").(string)
+	return audit.Actor{
+		AdminID: c.Get("userId").(int64),
+		Role:    role,
+		IP:      c.RealIP(),
+	}
+}
+
 // HealthCheck - ヘルスチェック
 func HealthCheck(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
@@ -1,12 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
+	"time"
 
 	"backend/config"
+	"backend/domain/admin"
+	domainnotification "backend/domain/notification"
+	"backend/domain/product"
+	domainwebhook "backend/domain/webhook"
 	"backend/infrastructure/auth"
+	"backend/infrastructure/linkcheck"
+	"backend/infrastructure/notification"
 	"backend/infrastructure/persistence"
+	"backend/infrastructure/scheduler"
+	"backend/infrastructure/webhook"
 	"backend/interfaces/handler"
 	adminhandler "backend/interfaces/handler/admin"
 	customerhandler "backend/interfaces/handler/customer"
@@ -32,11 +42,34 @@ func main() {
 
 	// Initialize repositories
 	customerRepo := persistence.NewCustomerRepository(db)
+	sanctionRepo := persistence.NewSanctionRepository(db)
+	erasureRepo := persistence.NewErasureRepository(db)
+	followRepo := persistence.NewFollowRepository(db)
 	adminRepo := persistence.NewAdminRepository(db)
+	adminRoleRepo := persistence.NewAdminRoleRepository(db)
+	authorizer := admin.NewAuthorizer(adminRepo)
 	productRepo := persistence.NewProductRepository(db)
 	categoryRepo := persistence.NewCategoryRepository(db)
 	reviewRepo := persistence.NewReviewRepository(db)
+	replyRepo := persistence.NewReplyRepository(db)
+	questionRepo := persistence.NewQuestionRepository(db)
+	answerRepo := persistence.NewAnswerRepository(db)
+	submissionRepo := persistence.NewSubmissionRepository(db)
+	suggestionRepo := persistence.NewSuggestionRepository(db)
 	favoriteRepo := persistence.NewFavoriteRepository(db)
+	collectionRepo := persistence.NewCollectionRepository(db)
+	certificationRepo := persistence.NewCertificationRepository(db)
+	offerRepo := persistence.NewOfferRepository(db)
+	storeRepo := persistence.NewStoreRepository(db)
+	clickRepo := persistence.NewClickRepository(db)
+	linkCheckRepo := persistence.NewLinkCheckRepository(db)
+	recommendationRepo := persistence.NewRecommendationRepository(db)
+	rankingRepo := persistence.NewRankingRepository(db)
+	notificationRepo := persistence.NewNotificationRepository(db)
+	outboxRepo := persistence.NewOutboxRepository(db)
+	webhookRepo := persistence.NewWebhookRepository(db)
+	auditLogRepo := persistence.NewAuditLogRepository(db)
+	unitOfWork := persistence.NewUnitOfWork(db)
 
 	// Initialize services
 	jwtService := auth.NewJWTService(cfg.JWTSecret)
@@ -47,15 +80,64 @@ func main() {
 		cfg.OAuthAdminRedirectURL,
 	)
 
+	linkChecker := linkcheck.NewHTTPChecker(10*time.Second, 2*time.Second)
+	webhookSender := webhook.NewHTTPSender(10 * time.Second)
+	storeURLRules := product.NewStoreURLRules(map[string]string{
+		product.StoreAmazon:  cfg.AmazonAssociateTag,
+		product.StoreRakuten: cfg.RakutenAffiliateID,
+	})
+
+	var notificationSenders []domainnotification.Sender
+	if cfg.SMTPHost != "" {
+		notificationSenders = append(notificationSenders, notification.NewSMTPSender(
+			cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom, cfg.FrontendURL,
+		))
+	}
+	notificationDispatcher := usecase.NewNotificationDispatcher(notificationRepo, notificationSenders...)
+
+	// Domain event subscribers
+	eventBus := usecase.NewEventBus(outboxRepo)
+	usecase.NewNotificationSubscriber(notificationDispatcher, productRepo).Register(eventBus)
+	adminWebhookUsecase := adminusecase.NewAdminWebhookUsecase(webhookRepo, persistence.NewWebhookUnitOfWork(db), webhookSender)
+	for _, name := range domainwebhook.EventTypes() {
+		eventBus.Subscribe(name, adminWebhookUsecase.HandleEvent)
+	}
+
 	// Initialize use cases
 	authUsecase := usecase.NewAuthUsecase(customerRepo, adminRepo)
-	favoriteUsecase := usecase.NewFavoriteUsecase(favoriteRepo)
-	adminProductUsecase := adminusecase.NewAdminProductUsecase(productRepo, categoryRepo)
-	adminCategoryUsecase := adminusecase.NewAdminCategoryUsecase(categoryRepo)
-	adminCustomerUsecase := adminusecase.NewAdminCustomerUsecase(customerRepo)
-	adminReviewUsecase := adminusecase.NewAdminReviewUsecase(reviewRepo, productRepo)
-	customerProductUsecase := customerusecase.NewProductUsecase(productRepo, categoryRepo)
-	customerReviewUsecase := customerusecase.NewReviewUsecase(reviewRepo, productRepo)
+	favoriteUsecase := usecase.NewFavoriteUsecase(favoriteRepo, unitOfWork)
+	collectionUsecase := usecase.NewCollectionUsecase(collectionRepo, favoriteRepo, unitOfWork)
+	adminProductUsecase := adminusecase.NewAdminProductUsecase(productRepo, categoryRepo, storeRepo, storeURLRules, unitOfWork)
+	adminCategoryUsecase := adminusecase.NewAdminCategoryUsecase(categoryRepo, unitOfWork)
+	adminCustomerUsecase := adminusecase.NewAdminCustomerUsecase(customerRepo, sanctionRepo, unitOfWork)
+	adminReviewUsecase := adminusecase.NewAdminReviewUsecase(reviewRepo, replyRepo, unitOfWork)
+	adminQuestionUsecase := adminusecase.NewAdminQuestionUsecase(questionRepo, answerRepo, unitOfWork)
+	adminSubmissionUsecase := adminusecase.NewAdminSubmissionUsecase(submissionRepo, adminProductUsecase, unitOfWork)
+	adminSuggestionUsecase := adminusecase.NewAdminSuggestionUsecase(suggestionRepo, adminProductUsecase, unitOfWork)
+	adminCertificationUsecase := adminusecase.NewAdminCertificationUsecase(certificationRepo)
+	adminOfferUsecase := adminusecase.NewAdminOfferUsecase(offerRepo, productRepo, unitOfWork)
+	adminStoreUsecase := adminusecase.NewAdminStoreUsecase(storeRepo, unitOfWork)
+	adminClickUsecase := adminusecase.NewAdminClickUsecase(clickRepo)
+	adminLinkCheckUsecase := adminusecase.NewAdminLinkCheckUsecase(linkCheckRepo, productRepo, linkChecker)
+	adminRecommendationUsecase := adminusecase.NewAdminRecommendationUsecase(recommendationRepo, productRepo)
+	adminRankingUsecase := adminusecase.NewAdminRankingUsecase(rankingRepo, productRepo)
+	adminAuditUsecase := adminusecase.NewAdminAuditUsecase(auditLogRepo, authorizer)
+	adminUserUsecase := adminusecase.NewAdminUserUsecase(adminRepo, adminRoleRepo, unitOfWork, authorizer)
+	adminRoleUsecase := adminusecase.NewAdminRoleUsecase(adminRoleRepo, unitOfWork, authorizer)
+	customerProductUsecase := customerusecase.NewProductUsecase(productRepo, categoryRepo, storeRepo)
+	customerReviewUsecase := customerusecase.NewReviewUsecase(reviewRepo, unitOfWork)
+	customerReplyUsecase := customerusecase.NewReplyUsecase(reviewRepo, replyRepo, unitOfWork)
+	customerQuestionUsecase := customerusecase.NewQuestionUsecase(productRepo, questionRepo, answerRepo, unitOfWork)
+	customerSubmissionUsecase := customerusecase.NewSubmissionUsecase(submissionRepo)
+	customerSuggestionUsecase := customerusecase.NewSuggestionUsecase(productRepo, storeRepo, suggestionRepo)
+	customerOfferUsecase := customerusecase.NewOfferUsecase(offerRepo)
+	customerClickUsecase := customerusecase.NewClickUsecase(clickRepo, productRepo, cfg.ClickHashSecret)
+	customerRecommendationUsecase := customerusecase.NewRecommendationUsecase(recommendationRepo, productRepo)
+	customerRankingUsecase := customerusecase.NewRankingUsecase(rankingRepo, productRepo)
+	customerNotificationUsecase := customerusecase.NewNotificationUsecase(notificationRepo)
+	customerProfileUsecase := customerusecase.NewProfileUsecase(customerRepo, reviewRepo, followRepo)
+	customerFollowUsecase := customerusecase.NewFollowUsecase(followRepo, customerRepo)
+	customerAccountUsecase := customerusecase.NewAccountUsecase(customerRepo, erasureRepo, reviewRepo, favoriteRepo, collectionRepo)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authUsecase, oauthService, jwtService, cfg.FrontendURL)
@@ -63,9 +145,127 @@ func main() {
 	adminCategoryHandler := adminhandler.NewAdminCategoryHandler(adminCategoryUsecase)
 	adminCustomerHandler := adminhandler.NewAdminCustomerHandler(adminCustomerUsecase)
 	adminReviewHandler := adminhandler.NewAdminReviewHandler(adminReviewUsecase)
+	adminSubmissionHandler := adminhandler.NewAdminSubmissionHandler(adminSubmissionUsecase)
+	adminSuggestionHandler := adminhandler.NewAdminSuggestionHandler(adminSuggestionUsecase)
+	adminCertificationHandler := adminhandler.NewAdminCertificationHandler(adminCertificationUsecase)
+	adminOfferHandler := adminhandler.NewAdminOfferHandler(adminOfferUsecase)
+	adminStoreHandler := adminhandler.NewAdminStoreHandler(adminStoreUsecase)
+	adminClickHandler := adminhandler.NewAdminClickHandler(adminClickUsecase)
+	adminLinkCheckHandler := adminhandler.NewAdminLinkCheckHandler(adminLinkCheckUsecase)
+	adminWebhookHandler := adminhandler.NewAdminWebhookHandler(adminWebhookUsecase)
+	adminAuditHandler := adminhandler.NewAdminAuditHandler(adminAuditUsecase)
+	adminUserHandler := adminhandler.NewAdminUserHandler(adminUserUsecase)
+	adminRoleHandler := adminhandler.NewAdminRoleHandler(adminRoleUsecase)
 	customerProductHandler := customerhandler.NewProductHandler(customerProductUsecase)
-	customerReviewHandler := customerhandler.NewReviewHandler(customerReviewUsecase)
+	customerReviewHandler := customerhandler.NewReviewHandler(customerReviewUsecase, adminReviewUsecase, authorizer)
+	customerReplyHandler := customerhandler.NewReplyHandler(customerReplyUsecase, adminReviewUsecase, authorizer)
+	customerQuestionHandler := customerhandler.NewQuestionHandler(customerQuestionUsecase, adminQuestionUsecase, authorizer)
+	customerSubmissionHandler := customerhandler.NewSubmissionHandler(customerSubmissionUsecase)
+	customerSuggestionHandler := customerhandler.NewSuggestionHandler(customerSuggestionUsecase)
 	customerFavoriteHandler := customerhandler.NewFavoriteHandler(favoriteUsecase)
+	customerCollectionHandler := customerhandler.NewCollectionHandler(collectionUsecase)
+	customerOfferHandler := customerhandler.NewOfferHandler(customerOfferUsecase)
+	customerClickHandler := customerhandler.NewClickHandler(customerClickUsecase)
+	customerRecommendationHandler := customerhandler.NewRecommendationHandler(customerRecommendationUsecase)
+	customerRankingHandler := customerhandler.NewRankingHandler(customerRankingUsecase)
+	customerNotificationHandler := customerhandler.NewNotificationHandler(customerNotificationUsecase)
+	customerProfileHandler := customerhandler.NewProfileHandler(customerProfileUsecase)
+	customerAccountHandler := customerhandler.NewAccountHandler(customerAccountUsecase)
+	customerFollowHandler := customerhandler.NewFollowHandler(customerFollowUsecase)
+
+	// Normalize names of existing products before serving so duplicate detection covers them
+	normalized, err := adminSubmissionUsecase.NormalizeProductNames()
+	if err != nil {
+		log.Fatal("Failed to normalize product names:", err)
+	}
+	if normalized > 0 {
+		log.Printf("Normalized names of %d products", normalized)
+	}
+
+	// Background jobs
+	jobScheduler := scheduler.NewScheduler()
+	jobScheduler.Register(scheduler.Job{
+		Name:     "dispatch-domain-events",
+		Interval: 10 * time.Second,
+		Run: func(ctx context.Context) error {
+			summary, err := eventBus.DispatchPending(ctx)
+			if summary != nil && (summary.Retrying > 0 || summary.Dead > 0) {
+				log.Printf("Domain events: %d dispatched, %d retrying, %d dead", summary.Dispatched, summary.Retrying, summary.Dead)
+			}
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "deliver-webhooks",
+		Interval: 30 * time.Second,
+		Run: func(ctx context.Context) error {
+			summary, err := adminWebhookUsecase.DeliverDue(ctx)
+			if summary != nil && (summary.Retrying > 0 || summary.Dead > 0) {
+				log.Printf("Webhooks: %d delivered, %d retrying, %d dead", summary.Delivered, summary.Retrying, summary.Dead)
+			}
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "flag-expired-certifications",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			flagged, err := adminCertificationUsecase.FlagExpiredCertifications()
+			if flagged > 0 {
+				log.Printf("Flagged %d expired certifications", flagged)
+			}
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "check-product-links",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			summary, err := adminLinkCheckUsecase.CheckDueLinks(ctx)
+			if summary != nil && summary.Failing > 0 {
+				log.Printf("Link check: %d checked, %d failing", summary.Checked, summary.Failing)
+			}
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "recompute-recommendations",
+		Interval: 6 * time.Hour,
+		Run: func(ctx context.Context) error {
+			_, err := adminRecommendationUsecase.RecomputeRecommendations()
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "refresh-rankings",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			return adminRankingUsecase.RefreshRankings()
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "expire-suspensions",
+		Interval: 10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			expired, err := adminCustomerUsecase.ExpireSuspensions()
+			if expired > 0 {
+				log.Printf("Lifted %d expired suspensions", expired)
+			}
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "erase-deleted-accounts",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			erased, err := customerAccountUsecase.EraseDueAccounts()
+			if erased > 0 {
+				log.Printf("Anonymized %d deleted accounts", erased)
+			}
+			return err
+		},
+	})
+	jobScheduler.Start(context.Background())
 
 	// Echo instance
 	e := echo.New()
@@ -75,7 +275,7 @@ func main() {
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:5173", "http://localhost:3000", cfg.FrontendURL},
-		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
 		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
 		AllowCredentials: true,
 	}))
@@ -92,52 +292,181 @@ func main() {
 	// Category routes (public)
 	e.GET("/api/categories", customerProductHandler.GetCategories)
 
+	// Store routes (public)
+	e.GET("/api/stores", customerProductHandler.GetStores)
+
+	// Ranking routes (public)
+	e.GET("/api/rankings", customerRankingHandler.GetRanking)
+
 	// Product routes (public read)
 	e.GET("/api/products", customerProductHandler.GetProducts)
 	e.GET("/api/products/:id", customerProductHandler.GetProduct)
+	e.GET("/api/products/:id/offers", customerOfferHandler.GetProductOffers)
+	e.GET("/api/products/:id/go/:store", customerClickHandler.GoToStore)
+	e.GET("/api/products/:id/similar", customerRecommendationHandler.GetSimilarProducts)
 
 	// Review routes (public read)
 	e.GET("/api/products/:id/reviews", customerReviewHandler.GetProductReviews)
+	e.GET("/api/reviews/:id/replies", customerReplyHandler.GetReplies)
+
+	// Shared collection routes (public, via share link)
+	e.GET("/api/shared/collections/:token", customerCollectionHandler.GetSharedCollection)
+
+	optionalAuth := handler.OptionalJWTMiddleware(jwtService)
+
+	// Product Q&A routes (public read)
+	e.GET("/api/products/:id/questions", customerQuestionHandler.GetProductQuestions)
+	e.GET("/api/questions/:id", customerQuestionHandler.GetQuestion, optionalAuth)
+
+	// Customer profile routes (public; private profiles are visible only to the owner)
+	e.GET("/api/customers/:id/profile", customerProfileHandler.GetPublicProfile, optionalAuth)
+	e.GET("/api/customers/:id/reviews", customerProfileHandler.GetCustomerReviews, optionalAuth)
 
 	// Protected routes - require authentication
 	authGroup := e.Group("/api")
 	authGroup.Use(handler.JWTMiddleware(jwtService))
+	requirePermission := func(permission string) echo.MiddlewareFunc {
+		return handler.RequirePermission(authorizer, permission)
+	}
 
 	// Auth info
 	authGroup.GET("/auth/me", authHandler.GetMe)
 	authGroup.POST("/auth/logout", authHandler.HandleLogout)
 
+	// Profile routes (customer self-service)
+	authGroup.GET("/me", customerProfileHandler.GetMe)
+	authGroup.PATCH("/me", customerProfileHandler.UpdateMe)
+	authGroup.GET("/me/export", customerAccountHandler.ExportData)
+	authGroup.POST("/me/deletion", customerAccountHandler.RequestDeletion)
+	authGroup.DELETE("/me/deletion", customerAccountHandler.CancelDeletion)
+
+	// Follow and feed routes (protected)
+	authGroup.POST("/customers/:id/follow", customerFollowHandler.Follow)
+	authGroup.DELETE("/customers/:id/follow", customerFollowHandler.Unfollow)
+	authGroup.GET("/feed", customerFollowHandler.GetFeed)
+
+	// Recommendation routes (protected)
+	authGroup.GET("/recommendations", customerRecommendationHandler.GetRecommendations)
+
 	// Product routes (protected write - admin)
-	authGroup.POST("/products", adminProductHandler.CreateProduct)
-	authGroup.PUT("/products/:id", adminProductHandler.UpdateProduct)
-	authGroup.DELETE("/products/:id", adminProductHandler.DeleteProduct)
+	authGroup.POST("/products", adminProductHandler.CreateProduct, requirePermission(admin.PermProductWrite))
+	authGroup.PUT("/products/:id", adminProductHandler.UpdateProduct, requirePermission(admin.PermProductWrite))
+	authGroup.DELETE("/products/:id", adminProductHandler.DeleteProduct, requirePermission(admin.PermProductWrite))
+
+	// Product submission routes (customer proposals, admin review queue)
+	authGroup.POST("/product-submissions", customerSubmissionHandler.SubmitProduct)
+	authGroup.GET("/me/product-submissions", customerSubmissionHandler.GetMySubmissions)
+	authGroup.GET("/admin/product-submissions", adminSubmissionHandler.GetSubmissions, requirePermission(admin.PermProductWrite))
+	authGroup.POST("/admin/product-submissions/:id/approve", adminSubmissionHandler.ApproveSubmission, requirePermission(admin.PermProductWrite))
+	authGroup.POST("/admin/product-submissions/:id/reject", adminSubmissionHandler.RejectSubmission, requirePermission(admin.PermProductWrite))
+
+	// Product edit suggestion routes (customer corrections, admin review queue)
+	authGroup.POST("/products/:id/suggestions", customerSuggestionHandler.SuggestEdit)
+	authGroup.GET("/me/product-suggestions", customerSuggestionHandler.GetMySuggestions)
+	authGroup.GET("/admin/product-suggestions", adminSuggestionHandler.GetSuggestions, requirePermission(admin.PermProductWrite))
+	authGroup.GET("/admin/product-suggestions/:id", adminSuggestionHandler.GetSuggestion, requirePermission(admin.PermProductWrite))
+	authGroup.POST("/admin/product-suggestions/:id/approve", adminSuggestionHandler.ApproveSuggestion, requirePermission(admin.PermProductWrite))
+	authGroup.POST("/admin/product-suggestions/:id/reject", adminSuggestionHandler.RejectSuggestion, requirePermission(admin.PermProductWrite))
 
 	// Category routes (protected write - admin)
-	authGroup.POST("/categories", adminCategoryHandler.CreateCategory)
-	authGroup.PUT("/categories/:id", adminCategoryHandler.UpdateCategory)
-	authGroup.DELETE("/categories/:id", adminCategoryHandler.DeleteCategory)
+	authGroup.POST("/categories", adminCategoryHandler.CreateCategory, requirePermission(admin.PermCategoryWrite))
+	authGroup.PUT("/categories/:id", adminCategoryHandler.UpdateCategory, requirePermission(admin.PermCategoryWrite))
+	authGroup.DELETE("/categories/:id", adminCategoryHandler.DeleteCategory, requirePermission(admin.PermCategoryWrite))
 
 	// Customer routes (admin)
-	authGroup.GET("/admin/customers", adminCustomerHandler.GetAllCustomers)
-	authGroup.POST("/admin/customers/:id/ban", adminCustomerHandler.BanCustomer)
-	authGroup.POST("/admin/customers/:id/suspend", adminCustomerHandler.SuspendCustomer)
-	authGroup.POST("/admin/customers/:id/unban", adminCustomerHandler.UnbanCustomer)
+	authGroup.GET("/admin/customers", adminCustomerHandler.GetAllCustomers, requirePermission(admin.PermCustomerRead))
+	authGroup.POST("/admin/customers/:id/ban", adminCustomerHandler.BanCustomer, requirePermission(admin.PermCustomerBan))
+	authGroup.POST("/admin/customers/:id/suspend", adminCustomerHandler.SuspendCustomer, requirePermission(admin.PermCustomerBan))
+	authGroup.POST("/admin/customers/:id/unban", adminCustomerHandler.UnbanCustomer, requirePermission(admin.PermCustomerBan))
+	authGroup.GET("/admin/customers/:id/sanctions", adminCustomerHandler.GetCustomerSanctions, requirePermission(admin.PermCustomerRead))
+
+	// Certification routes (admin)
+	authGroup.GET("/admin/certifications/expired", adminCertificationHandler.GetExpiredCertifications, requirePermission(admin.PermProductWrite))
+
+	// Offer routes (admin)
+	authGroup.PUT("/admin/products/:id/offers/:store", adminOfferHandler.UpsertOffer, requirePermission(admin.PermOfferWrite))
+	authGroup.POST("/admin/offers/import", adminOfferHandler.ImportOffers, requirePermission(admin.PermOfferWrite))
+
+	// Store routes (admin)
+	authGroup.GET("/admin/stores", adminStoreHandler.GetAllStores, requirePermission(admin.PermStoreWrite))
+	authGroup.POST("/admin/stores", adminStoreHandler.CreateStore, requirePermission(admin.PermStoreWrite))
+	authGroup.PUT("/admin/stores/:id", adminStoreHandler.UpdateStore, requirePermission(admin.PermStoreWrite))
+	authGroup.DELETE("/admin/stores/:id", adminStoreHandler.DeleteStore, requirePermission(admin.PermStoreWrite))
+
+	// Link check routes (admin)
+	authGroup.GET("/admin/links/broken", adminLinkCheckHandler.GetBrokenLinks, requirePermission(admin.PermProductWrite))
+
+	// Webhook routes (admin)
+	authGroup.GET("/admin/webhooks", adminWebhookHandler.GetAllWebhooks, requirePermission(admin.PermWebhookManage))
+	authGroup.POST("/admin/webhooks", adminWebhookHandler.CreateWebhook, requirePermission(admin.PermWebhookManage))
+	authGroup.PUT("/admin/webhooks/:id", adminWebhookHandler.UpdateWebhook, requirePermission(admin.PermWebhookManage))
+	authGroup.DELETE("/admin/webhooks/:id", adminWebhookHandler.DeleteWebhook, requirePermission(admin.PermWebhookManage))
+	authGroup.GET("/admin/webhooks/deliveries", adminWebhookHandler.GetDeliveries, requirePermission(admin.PermWebhookManage))
+	authGroup.POST("/admin/webhooks/deliveries/:id/replay", adminWebhookHandler.ReplayDelivery, requirePermission(admin.PermWebhookManage))
+
+	// Audit log routes (super admin)
+	authGroup.GET("/admin/audit-logs", adminAuditHandler.GetAuditLogs)
+
+	// Admin account routes (super admin)
+	authGroup.GET("/admin/admins", adminUserHandler.GetAllAdmins)
+	authGroup.POST("/admin/admins", adminUserHandler.InviteAdmin)
+	authGroup.PUT("/admin/admins/:id/role", adminUserHandler.ChangeRole)
+	authGroup.POST("/admin/admins/:id/deactivate", adminUserHandler.DeactivateAdmin)
+	authGroup.POST("/admin/admins/:id/reactivate", adminUserHandler.ReactivateAdmin)
+
+	// Role and permission routes (super admin)
+	authGroup.GET("/admin/roles", adminRoleHandler.GetRoles)
+	authGroup.POST("/admin/roles", adminRoleHandler.CreateRole)
+	authGroup.PUT("/admin/roles/:id", adminRoleHandler.UpdateRole)
+	authGroup.DELETE("/admin/roles/:id", adminRoleHandler.DeleteRole)
+	authGroup.GET("/admin/permissions", adminRoleHandler.GetPermissions)
+
+	// Click report routes (admin)
+	authGroup.GET("/admin/clicks", adminClickHandler.GetClickReport, requirePermission(admin.PermReportRead))
 
 	// Review routes (admin)
-	authGroup.GET("/reviews", adminReviewHandler.GetAllReviews)
+	authGroup.GET("/reviews", adminReviewHandler.GetAllReviews, requirePermission(admin.PermReviewModerate))
 
 	// Review routes (protected write)
 	authGroup.POST("/products/:id/reviews", customerReviewHandler.CreateReview)
 	authGroup.PUT("/reviews/:id", customerReviewHandler.UpdateReview)
 	authGroup.DELETE("/reviews/:id", customerReviewHandler.DeleteReview)
 
+	// Reply routes (protected write; admin replies are official)
+	authGroup.POST("/reviews/:id/replies", customerReplyHandler.CreateReply)
+	authGroup.DELETE("/replies/:id", customerReplyHandler.DeleteReply)
+
+	// Product Q&A routes (protected write; admin answers are official)
+	authGroup.POST("/products/:id/questions", customerQuestionHandler.AskQuestion)
+	authGroup.DELETE("/questions/:id", customerQuestionHandler.DeleteQuestion)
+	authGroup.PUT("/questions/:id/accepted-answer", customerQuestionHandler.AcceptAnswer)
+	authGroup.POST("/questions/:id/answers", customerQuestionHandler.AnswerQuestion)
+	authGroup.DELETE("/answers/:id", customerQuestionHandler.DeleteAnswer)
+	authGroup.POST("/answers/:id/upvote", customerQuestionHandler.UpvoteAnswer)
+	authGroup.DELETE("/answers/:id/upvote", customerQuestionHandler.RemoveUpvote)
+
 	// Favorite routes (all protected)
 	authGroup.GET("/customers/:id/favorites", customerFavoriteHandler.GetCustomerFavorites)
 	authGroup.POST("/customers/:id/favorites", customerFavoriteHandler.AddFavorite)
 	authGroup.DELETE("/customers/:id/favorites/:productId", customerFavoriteHandler.RemoveFavorite)
 
-	// Customer routes (protected)
-	authGroup.GET("/customers/:id/reviews", customerReviewHandler.GetCustomerReviews)
+	// Collection routes (all protected)
+	authGroup.GET("/customers/:id/collections", customerCollectionHandler.GetCustomerCollections)
+	authGroup.POST("/customers/:id/collections", customerCollectionHandler.CreateCollection)
+	authGroup.GET("/collections/:collectionId", customerCollectionHandler.GetCollection)
+	authGroup.PUT("/collections/:collectionId", customerCollectionHandler.UpdateCollection)
+	authGroup.DELETE("/collections/:collectionId", customerCollectionHandler.DeleteCollection)
+	authGroup.POST("/collections/:collectionId/items", customerCollectionHandler.AddItem)
+	authGroup.PUT("/collections/:collectionId/items/order", customerCollectionHandler.ReorderItems)
+	authGroup.PUT("/collections/:collectionId/items/:productId", customerCollectionHandler.UpdateItem)
+	authGroup.DELETE("/collections/:collectionId/items/:productId", customerCollectionHandler.RemoveItem)
+
+	// Notification routes (protected)
+	authGroup.GET("/notifications", customerNotificationHandler.GetNotifications)
+	authGroup.POST("/notifications/read", customerNotificationHandler.MarkRead)
+	authGroup.POST("/notifications/read-all", customerNotificationHandler.MarkAllRead)
+	authGroup.GET("/notifications/preferences", customerNotificationHandler.GetPreferences)
+	authGroup.PUT("/notifications/preferences", customerNotificationHandler.UpdatePreferences)
 
 	// Start server
 	log.Println("Server starting on :8080")
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS product_certifications;
+DROP INDEX IF EXISTS idx_products_dietary_attributes;
+ALTER TABLE products DROP COLUMN IF EXISTS dietary_attributes;
@@ -0,0 +1,32 @@
+-- =============================================
+-- products に食事属性（グルテンフリー等）を追加
+-- =============================================
+ALTER TABLE products ADD COLUMN dietary_attributes TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_products_dietary_attributes ON products USING GIN (dietary_attributes);
+
+COMMENT ON COLUMN products.dietary_attributes IS '食事属性: gluten_free, palm_oil_free, organic, soy_free, nut_free, sugar_free, non_gmo';
+
+-- =============================================
+-- product_certifications: 商品の認証情報
+-- =============================================
+CREATE TABLE product_certifications (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    issuing_body VARCHAR(100) NOT NULL,
+    certificate_number VARCHAR(100),
+    issued_at DATE,
+    expires_at DATE,
+    is_expired BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (expires_at IS NULL OR issued_at IS NULL OR expires_at > issued_at)
+);
+
+CREATE INDEX idx_product_certifications_product_id ON product_certifications(product_id);
+CREATE INDEX idx_product_certifications_expires_at ON product_certifications(expires_at) WHERE is_expired = FALSE;
+
+COMMENT ON TABLE product_certifications IS '商品の認証情報（Vegan Society、有機JAS等）';
+COMMENT ON COLUMN product_certifications.issuing_body IS '発行団体';
+COMMENT ON COLUMN product_certifications.is_expired IS '有効期限切れフラグ（定期ジョブで更新）';
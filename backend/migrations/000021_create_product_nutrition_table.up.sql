@@ -0,0 +1,28 @@
+-- =============================================
+-- product_nutrition: 商品の栄養成分（1商品につき1件）
+-- =============================================
+CREATE TABLE product_nutrition (
+    product_id BIGINT PRIMARY KEY REFERENCES products(id) ON DELETE CASCADE,
+    basis VARCHAR(20) NOT NULL CHECK (basis IN ('per_100g', 'per_serving')),
+    serving_size_grams NUMERIC(7,2) CHECK (serving_size_grams > 0 AND serving_size_grams <= 2000),
+    calories_kcal NUMERIC(7,2) NOT NULL DEFAULT 0 CHECK (calories_kcal >= 0),
+    protein_grams NUMERIC(7,2) NOT NULL DEFAULT 0 CHECK (protein_grams >= 0),
+    fat_grams NUMERIC(7,2) NOT NULL DEFAULT 0 CHECK (fat_grams >= 0),
+    carbohydrate_grams NUMERIC(7,2) NOT NULL DEFAULT 0 CHECK (carbohydrate_grams >= 0),
+    salt_grams NUMERIC(7,2) NOT NULL DEFAULT 0 CHECK (salt_grams >= 0),
+    -- 並び替え・絞り込み用に100gあたりへ換算した値
+    calories_per_100g NUMERIC(9,2) GENERATED ALWAYS AS (
+        CASE WHEN basis = 'per_serving' THEN calories_kcal * 100 / serving_size_grams ELSE calories_kcal END
+    ) STORED,
+    protein_per_100g NUMERIC(9,2) GENERATED ALWAYS AS (
+        CASE WHEN basis = 'per_serving' THEN protein_grams * 100 / serving_size_grams ELSE protein_grams END
+    ) STORED,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (basis <> 'per_serving' OR serving_size_grams IS NOT NULL)
+);
+
+CREATE INDEX idx_product_nutrition_protein_per_100g ON product_nutrition(protein_per_100g DESC);
+CREATE INDEX idx_product_nutrition_calories_per_100g ON product_nutrition(calories_per_100g);
+
+COMMENT ON TABLE product_nutrition IS '商品の栄養成分（エネルギーはkcal、その他はg）';
+COMMENT ON COLUMN product_nutrition.basis IS 'per_100g: 100gあたり, per_serving: 1食あたり';
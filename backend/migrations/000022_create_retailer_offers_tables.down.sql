@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS retailer_offer_price_history;
+DROP TABLE IF EXISTS retailer_offers;
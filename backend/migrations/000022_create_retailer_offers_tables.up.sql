@@ -0,0 +1,37 @@
+-- =============================================
+-- retailer_offers: ストアごとの現在価格・在庫
+-- =============================================
+CREATE TABLE retailer_offers (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    store VARCHAR(50) NOT NULL,
+    price_amount BIGINT NOT NULL CHECK (price_amount >= 0),
+    currency CHAR(3) NOT NULL DEFAULT 'JPY',
+    availability VARCHAR(20) NOT NULL DEFAULT 'unknown' CHECK (availability IN ('in_stock', 'out_of_stock', 'unknown')),
+    last_checked_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (product_id, store)
+);
+
+COMMENT ON TABLE retailer_offers IS 'ストアリンクごとの現在価格・在庫';
+COMMENT ON COLUMN retailer_offers.store IS 'ストアコード: amazon, rakuten, yahoo';
+COMMENT ON COLUMN retailer_offers.price_amount IS '価格（通貨の補助単位。JPYは円、USDはセント）';
+
+-- =============================================
+-- retailer_offer_price_history: 価格履歴（価格・在庫が変化した時のみ記録）
+-- =============================================
+CREATE TABLE retailer_offer_price_history (
+    id BIGSERIAL PRIMARY KEY,
+    offer_id BIGINT NOT NULL REFERENCES retailer_offers(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    store VARCHAR(50) NOT NULL,
+    price_amount BIGINT NOT NULL CHECK (price_amount >= 0),
+    currency CHAR(3) NOT NULL,
+    availability VARCHAR(20) NOT NULL,
+    recorded_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_retailer_offer_price_history_product_recorded ON retailer_offer_price_history(product_id, recorded_at);
+
+COMMENT ON TABLE retailer_offer_price_history IS 'ストア価格の履歴';
@@ -0,0 +1,28 @@
+ALTER TABLE retailer_offer_price_history DROP CONSTRAINT IF EXISTS fk_retailer_offer_price_history_store;
+ALTER TABLE retailer_offers DROP CONSTRAINT IF EXISTS fk_retailer_offers_store;
+
+COMMENT ON COLUMN retailer_offers.store IS 'ストアコード: amazon, rakuten, yahoo';
+
+ALTER TABLE products ADD COLUMN amazon_url TEXT;
+ALTER TABLE products ADD COLUMN rakuten_url TEXT;
+ALTER TABLE products ADD COLUMN yahoo_url TEXT;
+
+COMMENT ON COLUMN products.amazon_url IS 'もしもアフィリエイト経由Amazonリンク';
+COMMENT ON COLUMN products.rakuten_url IS 'もしもアフィリエイト経由楽天リンク';
+COMMENT ON COLUMN products.yahoo_url IS 'もしもアフィリエイト経由Yahoo!ショッピングリンク';
+
+-- 固定カラムに対応するストアのリンクのみ書き戻す（追加ストアのリンクは失われる）
+UPDATE products p SET amazon_url = l.url
+FROM product_store_links l JOIN stores s ON s.id = l.store_id
+WHERE l.product_id = p.id AND s.code = 'amazon';
+
+UPDATE products p SET rakuten_url = l.url
+FROM product_store_links l JOIN stores s ON s.id = l.store_id
+WHERE l.product_id = p.id AND s.code = 'rakuten';
+
+UPDATE products p SET yahoo_url = l.url
+FROM product_store_links l JOIN stores s ON s.id = l.store_id
+WHERE l.product_id = p.id AND s.code = 'yahoo';
+
+DROP TABLE IF EXISTS product_store_links;
+DROP TABLE IF EXISTS stores;
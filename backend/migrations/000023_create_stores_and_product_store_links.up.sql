@@ -0,0 +1,66 @@
+-- =============================================
+-- stores: ストア（マーケットプレイス）マスタ
+-- 新しいストアの追加にマイグレーションを不要にする
+-- =============================================
+CREATE TABLE stores (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE CHECK (code ~ '^[a-z0-9_]{2,50}$'),
+    name VARCHAR(100) NOT NULL,
+    name_ja VARCHAR(100) NOT NULL,
+    display_order INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    updated_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE stores IS 'ストアマスタ - 管理者のみ作成・編集可能';
+COMMENT ON COLUMN stores.code IS 'ストアコード（retailer_offers.store から参照）';
+
+-- 既存の固定カラムに対応するストア（created_by_admin_idはNULL = システム作成）
+INSERT INTO stores (code, name, name_ja, display_order) VALUES
+    ('amazon', 'Amazon', 'Amazon', 1),
+    ('rakuten', 'Rakuten', '楽天市場', 2),
+    ('yahoo', 'Yahoo! Shopping', 'Yahoo!ショッピング', 3);
+
+-- =============================================
+-- product_store_links: 商品ごとのストア販売ページリンク
+-- =============================================
+CREATE TABLE product_store_links (
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    store_id BIGINT NOT NULL REFERENCES stores(id) ON DELETE RESTRICT,
+    url TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (product_id, store_id)
+);
+
+CREATE INDEX idx_product_store_links_store_id ON product_store_links(store_id);
+
+COMMENT ON TABLE product_store_links IS '商品のストア別リンク（もしもアフィリエイト経由リンク等）';
+
+-- 既存のリンクを移行
+INSERT INTO product_store_links (product_id, store_id, url)
+SELECT p.id, s.id, p.amazon_url FROM products p JOIN stores s ON s.code = 'amazon'
+WHERE p.amazon_url IS NOT NULL AND p.amazon_url <> '';
+
+INSERT INTO product_store_links (product_id, store_id, url)
+SELECT p.id, s.id, p.rakuten_url FROM products p JOIN stores s ON s.code = 'rakuten'
+WHERE p.rakuten_url IS NOT NULL AND p.rakuten_url <> '';
+
+INSERT INTO product_store_links (product_id, store_id, url)
+SELECT p.id, s.id, p.yahoo_url FROM products p JOIN stores s ON s.code = 'yahoo'
+WHERE p.yahoo_url IS NOT NULL AND p.yahoo_url <> '';
+
+ALTER TABLE products DROP COLUMN amazon_url;
+ALTER TABLE products DROP COLUMN rakuten_url;
+ALTER TABLE products DROP COLUMN yahoo_url;
+
+-- ストア価格はストアマスタのコードを参照（コード変更に追従）
+ALTER TABLE retailer_offers
+    ADD CONSTRAINT fk_retailer_offers_store FOREIGN KEY (store) REFERENCES stores(code) ON UPDATE CASCADE;
+ALTER TABLE retailer_offer_price_history
+    ADD CONSTRAINT fk_retailer_offer_price_history_store FOREIGN KEY (store) REFERENCES stores(code) ON UPDATE CASCADE;
+
+COMMENT ON COLUMN retailer_offers.store IS 'ストアコード（stores.code）';
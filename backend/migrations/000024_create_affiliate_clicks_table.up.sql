@@ -0,0 +1,19 @@
+-- =============================================
+-- affiliate_clicks: アフィリエイトリンクのクリックログ
+-- =============================================
+CREATE TABLE affiliate_clicks (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    store VARCHAR(50) NOT NULL,
+    visitor_id CHAR(32) NOT NULL,
+    referrer VARCHAR(500),
+    clicked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_affiliate_clicks_clicked_at ON affiliate_clicks(clicked_at);
+CREATE INDEX idx_affiliate_clicks_product_clicked_at ON affiliate_clicks(product_id, clicked_at);
+
+COMMENT ON TABLE affiliate_clicks IS 'アフィリエイトリンクのクリックログ（/api/products/:id/go/:store）';
+COMMENT ON COLUMN affiliate_clicks.store IS 'ストアコード（stores.code）または affiliate（汎用アフィリエイトURL）';
+COMMENT ON COLUMN affiliate_clicks.visitor_id IS 'IPアドレスとUser-Agentを日付ごとにHMACで匿名化した訪問者ID';
+COMMENT ON COLUMN affiliate_clicks.referrer IS 'リファラー（クエリ・フラグメントは除去）';
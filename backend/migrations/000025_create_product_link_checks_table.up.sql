@@ -0,0 +1,23 @@
+-- =============================================
+-- product_link_checks: 商品URL（画像・アフィリエイト・ストアリンク）の死活チェック結果
+-- =============================================
+CREATE TABLE product_link_checks (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    target VARCHAR(50) NOT NULL,
+    url TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('ok', 'broken', 'unreachable')),
+    http_status INT,
+    error_message VARCHAR(500),
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    last_checked_at TIMESTAMP NOT NULL,
+    next_check_at TIMESTAMP NOT NULL,
+    UNIQUE (product_id, target)
+);
+
+CREATE INDEX idx_product_link_checks_status ON product_link_checks(status) WHERE status <> 'ok';
+
+COMMENT ON TABLE product_link_checks IS '商品URLの死活チェック結果（定期ジョブで更新）';
+COMMENT ON COLUMN product_link_checks.target IS 'image, affiliate またはストアコード';
+COMMENT ON COLUMN product_link_checks.status IS 'ok: 正常, broken: 4xx（リンク切れ）, unreachable: 5xx・タイムアウト等';
+COMMENT ON COLUMN product_link_checks.next_check_at IS '次回チェック時刻（失敗が続くほど間隔を延ばす）';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_recommendations;
+DROP TABLE IF EXISTS product_similarities;
@@ -0,0 +1,34 @@
+-- =============================================
+-- product_similarities: 商品間の類似度（定期ジョブで全件再計算）
+-- =============================================
+CREATE TABLE product_similarities (
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    similar_product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    rank INT NOT NULL,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('similar', 'popular')),
+    computed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (product_id, similar_product_id)
+);
+
+CREATE INDEX idx_product_similarities_rank ON product_similarities(product_id, rank);
+
+COMMENT ON TABLE product_similarities IS '類似商品（お気に入り・高評価レビューの共起、不足分は同カテゴリの人気商品）';
+COMMENT ON COLUMN product_similarities.score IS '共起のコサイン類似度（reason = popular は 0）';
+
+-- =============================================
+-- customer_recommendations: 顧客ごとの推薦商品（定期ジョブで全件再計算）
+-- =============================================
+CREATE TABLE customer_recommendations (
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    rank INT NOT NULL,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('similar', 'popular')),
+    computed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (customer_id, product_id)
+);
+
+CREATE INDEX idx_customer_recommendations_rank ON customer_recommendations(customer_id, rank);
+
+COMMENT ON TABLE customer_recommendations IS '顧客ごとの推薦商品（類似商品スコアの合計、不足分は関心カテゴリの人気商品）';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_favorites_created_at;
+DROP TABLE IF EXISTS product_rankings;
@@ -0,0 +1,17 @@
+-- =============================================
+-- product_rankings: 集計済みランキング（定期ジョブで種類ごとに全件入れ替え）
+-- =============================================
+CREATE TABLE product_rankings (
+    type VARCHAR(20) NOT NULL CHECK (type IN ('trending', 'top')),
+    category_id BIGINT NOT NULL DEFAULT 0,
+    rank INT NOT NULL,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    score DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (type, category_id, rank)
+);
+
+COMMENT ON TABLE product_rankings IS '商品ランキング（trending: 直近7日のレビュー・お気に入り、top: 直近1年のベイズ平均評価）';
+COMMENT ON COLUMN product_rankings.category_id IS 'カテゴリID（0 = 全カテゴリ）';
+
+CREATE INDEX idx_favorites_created_at ON favorites(created_at);
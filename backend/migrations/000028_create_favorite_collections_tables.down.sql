@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS favorite_collection_items;
+DROP TABLE IF EXISTS favorite_collections;
@@ -0,0 +1,46 @@
+-- =============================================
+-- favorite_collections: 名前付きお気に入りリスト（共有リンクで公開可能）
+-- =============================================
+CREATE TABLE favorite_collections (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    description TEXT,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    share_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE favorite_collections IS 'お気に入りリスト（既定のリストは顧客ごとに1つ、削除不可）';
+COMMENT ON COLUMN favorite_collections.share_token IS '共有リンク用トークン（公開中のみ設定）';
+
+CREATE INDEX idx_favorite_collections_customer_id ON favorite_collections(customer_id);
+CREATE UNIQUE INDEX idx_favorite_collections_default ON favorite_collections(customer_id) WHERE is_default;
+
+-- =============================================
+-- favorite_collection_items: リスト内の商品（メモ・並び順付き）
+-- =============================================
+CREATE TABLE favorite_collection_items (
+    id BIGSERIAL PRIMARY KEY,
+    collection_id BIGINT NOT NULL REFERENCES favorite_collections(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    note TEXT,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (collection_id, product_id)
+);
+
+CREATE INDEX idx_favorite_collection_items_product_id ON favorite_collection_items(product_id);
+
+-- 既存のお気に入りを既定のリストへ移行（登録順に並べる）
+INSERT INTO favorite_collections (customer_id, name, is_default)
+SELECT DISTINCT customer_id, 'お気に入り', TRUE FROM favorites;
+
+INSERT INTO favorite_collection_items (collection_id, product_id, position, created_at)
+SELECT c.id, f.product_id,
+       ROW_NUMBER() OVER (PARTITION BY f.customer_id ORDER BY f.created_at, f.id) - 1,
+       f.created_at
+FROM favorites f
+JOIN favorite_collections c ON c.customer_id = f.customer_id AND c.is_default;
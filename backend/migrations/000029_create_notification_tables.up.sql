@@ -0,0 +1,32 @@
+-- =============================================
+-- notifications: アプリ内通知（お気に入り商品の新着レビュー・値下がり・再入荷）
+-- =============================================
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('new_review', 'price_drop', 'back_in_stock')),
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE notifications IS 'アプリ内通知（受信箱）';
+COMMENT ON COLUMN notifications.read_at IS '既読日時（NULL = 未読）';
+
+CREATE INDEX idx_notifications_customer_created ON notifications(customer_id, created_at DESC);
+CREATE INDEX idx_notifications_customer_unread ON notifications(customer_id) WHERE read_at IS NULL;
+
+-- =============================================
+-- notification_preferences: 通知の種類ごとの受信設定（未設定時はアプリ内のみ）
+-- =============================================
+CREATE TABLE notification_preferences (
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('new_review', 'price_drop', 'back_in_stock')),
+    in_app BOOLEAN NOT NULL DEFAULT TRUE,
+    email BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (customer_id, type)
+);
+
+COMMENT ON TABLE notification_preferences IS '通知の受信設定（チャネル別）';
@@ -0,0 +1,21 @@
+-- =============================================
+-- outbox_events: ドメインイベントの transactional outbox
+-- 業務データと同一トランザクションで書き込み、バックグラウンドワーカーが購読者へ配信する
+-- =============================================
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_name VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processed', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    processed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE outbox_events IS 'ドメインイベントの outbox（少なくとも1回配信、失敗時は指数バックオフで再試行し上限で dead）';
+COMMENT ON COLUMN outbox_events.event_name IS 'イベント名（例: review.created, customer.banned）';
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events(next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_dead ON outbox_events(created_at) WHERE status = 'dead';
@@ -0,0 +1,48 @@
+-- =============================================
+-- webhook_subscriptions: パートナー向け Webhook の購読（管理者が登録）
+-- =============================================
+CREATE TABLE webhook_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL,
+    product_ids BIGINT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    updated_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE webhook_subscriptions IS 'パートナー向け Webhook の購読（本文は secret による HMAC-SHA256 で署名）';
+COMMENT ON COLUMN webhook_subscriptions.event_types IS '購読するイベント名（例: review.created, offer.price_dropped）';
+COMMENT ON COLUMN webhook_subscriptions.product_ids IS '対象商品の絞り込み（空なら全商品）';
+
+-- =============================================
+-- webhook_deliveries: Webhook の配信ログ（失敗時は指数バックオフで再試行し上限で dead）
+-- =============================================
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL,
+    event_name VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_status_code INT,
+    last_error TEXT,
+    last_attempt_at TIMESTAMP,
+    delivered_at TIMESTAMP,
+    replay_of_id BIGINT REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE webhook_deliveries IS 'Webhook の配信ログ（再送は replay_of_id に元の配信を記録した新しい行）';
+COMMENT ON COLUMN webhook_deliveries.event_id IS 'outbox_events.id（受信側の重複排除に使うため本文にも含める）';
+
+-- イベントの再配信で同じ購読に二重に作成しない（再送の行は対象外）
+CREATE UNIQUE INDEX idx_webhook_deliveries_event ON webhook_deliveries(subscription_id, event_id) WHERE replay_of_id IS NULL;
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription_id ON webhook_deliveries(subscription_id, id DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admin_audit_logs;
+DROP FUNCTION IF EXISTS reject_admin_audit_log_change();
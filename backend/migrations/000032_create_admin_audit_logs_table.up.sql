@@ -0,0 +1,38 @@
+-- =============================================
+-- admin_audit_logs: 管理者操作の監査ログ（追記のみ）
+-- 管理者の削除・ロール変更後も記録を残すため、admins への外部キーは張らず操作時のロールを保存する
+-- =============================================
+CREATE TABLE admin_audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    admin_id BIGINT NOT NULL,
+    admin_role VARCHAR(50) NOT NULL DEFAULT '',
+    action VARCHAR(50) NOT NULL,
+    target_type VARCHAR(50) NOT NULL,
+    target_id VARCHAR(100) NOT NULL,
+    before JSONB,
+    after JSONB,
+    changes JSONB,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE admin_audit_logs IS '管理者操作の監査ログ（更新・削除は不可）';
+COMMENT ON COLUMN admin_audit_logs.action IS '操作（例: category.delete, customer.unban）';
+COMMENT ON COLUMN admin_audit_logs.before IS '操作前のスナップショット（作成時は NULL）';
+COMMENT ON COLUMN admin_audit_logs.after IS '操作後のスナップショット（削除時は NULL）';
+COMMENT ON COLUMN admin_audit_logs.changes IS '変更されたフィールドごとの {before, after}';
+
+CREATE INDEX idx_admin_audit_logs_created_at ON admin_audit_logs(created_at DESC);
+CREATE INDEX idx_admin_audit_logs_admin_id ON admin_audit_logs(admin_id, created_at DESC);
+CREATE INDEX idx_admin_audit_logs_target ON admin_audit_logs(target_type, target_id, created_at DESC);
+
+-- 追記のみ: 更新・削除を拒否
+CREATE FUNCTION reject_admin_audit_log_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'admin_audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_admin_audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON admin_audit_logs
+    FOR EACH ROW EXECUTE FUNCTION reject_admin_audit_log_change();
@@ -0,0 +1,35 @@
+-- =============================================
+-- customer_sanctions: カスタマーへの制裁（BAN・一時停止）の履歴
+-- customers.status / status_reason / suspended_until は有効な制裁から再計算されるキャッシュ
+-- =============================================
+CREATE TABLE customer_sanctions (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('ban', 'suspension')),
+    reason TEXT NOT NULL,
+    issued_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    starts_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ends_at TIMESTAMP,
+    lifted_at TIMESTAMP,
+    lifted_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    lift_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((type = 'ban' AND ends_at IS NULL) OR (type = 'suspension' AND ends_at IS NOT NULL))
+);
+
+COMMENT ON TABLE customer_sanctions IS 'カスタマーへの制裁履歴（解除後も行は残す）';
+COMMENT ON COLUMN customer_sanctions.ends_at IS '一時停止の終了日時（BAN は NULL = 無期限）';
+COMMENT ON COLUMN customer_sanctions.lifted_at IS '手動解除日時（NULL なら未解除）';
+
+CREATE INDEX idx_customer_sanctions_customer_id ON customer_sanctions(customer_id, created_at DESC);
+CREATE INDEX idx_customer_sanctions_active ON customer_sanctions(customer_id) WHERE lifted_at IS NULL;
+
+-- 既存の BAN・一時停止を履歴へ移行（発行者は不明）
+INSERT INTO customer_sanctions (customer_id, type, reason, starts_at, ends_at)
+SELECT id,
+       CASE status WHEN 1 THEN 'ban' ELSE 'suspension' END,
+       COALESCE(NULLIF(status_reason, ''), 'migrated'),
+       updated_at,
+       CASE status WHEN 1 THEN NULL ELSE COALESCE(suspended_until, updated_at) END
+FROM customers
+WHERE status IN (1, 2);
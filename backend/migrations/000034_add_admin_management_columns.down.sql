@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_admins_email_lower;
+ALTER TABLE admins DROP COLUMN IF EXISTS invited_by_admin_id;
+ALTER TABLE admins DROP COLUMN IF EXISTS last_login_at;
+ALTER TABLE admins DROP COLUMN IF EXISTS deactivated_at;
+ALTER TABLE admins DROP COLUMN IF EXISTS is_active;
@@ -0,0 +1,16 @@
+-- =============================================
+-- admins: 招待・無効化・最終ログインのカラムを追加
+-- 招待中（未ログイン）の管理者は google_id が NULL
+-- =============================================
+ALTER TABLE admins ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE admins ADD COLUMN deactivated_at TIMESTAMP;
+ALTER TABLE admins ADD COLUMN last_login_at TIMESTAMP;
+ALTER TABLE admins ADD COLUMN invited_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL;
+
+UPDATE admins SET google_id = NULL WHERE google_id = '';
+
+COMMENT ON COLUMN admins.is_active IS 'FALSE なら無効化済み（ログイン不可）';
+COMMENT ON COLUMN admins.last_login_at IS '最終ログイン日時（招待後未ログインなら NULL）';
+COMMENT ON COLUMN admins.invited_by_admin_id IS '招待したスーパー管理者';
+
+CREATE UNIQUE INDEX idx_admins_email_lower ON admins(LOWER(email));
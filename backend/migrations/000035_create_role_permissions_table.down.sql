@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS role_permissions;
+ALTER TABLE admin_roles DROP COLUMN IF EXISTS is_built_in;
@@ -0,0 +1,35 @@
+-- =============================================
+-- role_permissions: ロールごとの権限（"<対象>:<操作>"）
+-- super_admin は常に全権限を持つため行を持たない。admin:manage はロールに付与できない
+-- =============================================
+ALTER TABLE admin_roles ADD COLUMN is_built_in BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE admin_roles SET is_built_in = TRUE WHERE name IN ('super_admin', 'admin', 'moderator');
+
+COMMENT ON COLUMN admin_roles.is_built_in IS '組み込みロール（名前の変更・削除不可）';
+
+CREATE TABLE role_permissions (
+    role_id BIGINT NOT NULL REFERENCES admin_roles(id) ON DELETE CASCADE,
+    permission VARCHAR(50) NOT NULL,
+    PRIMARY KEY (role_id, permission)
+);
+
+COMMENT ON TABLE role_permissions IS 'ロールに付与された権限（例: product:write, customer:ban）';
+
+-- 既存ロールの権限（admin: 商品・レビュー管理など、moderator: レビュー管理とカスタマー対応）
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM admin_roles r
+JOIN (VALUES
+    ('admin', 'product:write'),
+    ('admin', 'category:write'),
+    ('admin', 'store:write'),
+    ('admin', 'offer:write'),
+    ('admin', 'review:moderate'),
+    ('admin', 'customer:read'),
+    ('admin', 'customer:ban'),
+    ('admin', 'webhook:manage'),
+    ('admin', 'report:read'),
+    ('moderator', 'review:moderate'),
+    ('moderator', 'customer:read'),
+    ('moderator', 'customer:ban')
+) AS p(role_name, permission) ON p.role_name = r.name;
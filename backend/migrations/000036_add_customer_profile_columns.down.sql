@@ -0,0 +1,5 @@
+ALTER TABLE customers DROP COLUMN IF EXISTS dietary_preference;
+ALTER TABLE customers DROP COLUMN IF EXISTS preferred_language;
+ALTER TABLE customers DROP COLUMN IF EXISTS bio;
+ALTER TABLE customers DROP COLUMN IF EXISTS avatar_customized;
+ALTER TABLE customers DROP COLUMN IF EXISTS name_customized;
@@ -0,0 +1,13 @@
+-- =============================================
+-- customers: 本人が編集できるプロフィール項目を追加
+-- *_customized が TRUE の項目は Google ログイン時に上書きしない
+-- =============================================
+ALTER TABLE customers ADD COLUMN name_customized BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE customers ADD COLUMN avatar_customized BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE customers ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE customers ADD COLUMN preferred_language VARCHAR(5) NOT NULL DEFAULT 'ja' CHECK (preferred_language IN ('ja', 'en'));
+ALTER TABLE customers ADD COLUMN dietary_preference VARCHAR(20) NOT NULL DEFAULT '' CHECK (dietary_preference IN ('', 'vegan', 'vegetarian', 'flexitarian'));
+
+COMMENT ON COLUMN customers.name_customized IS '本人が表示名を編集済み（Google の名前で上書きしない）';
+COMMENT ON COLUMN customers.avatar_customized IS '本人がアバターを編集済み（Google のアバターで上書きしない）';
+COMMENT ON COLUMN customers.dietary_preference IS '食生活の志向（vegan, vegetarian, flexitarian、空文字は未設定）';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_customers_deletion_scheduled_at;
+UPDATE customers SET email = 'deleted-' || id || '@invalid' WHERE email IS NULL;
+ALTER TABLE customers ALTER COLUMN email SET NOT NULL;
+ALTER TABLE customers DROP COLUMN IF EXISTS anonymized_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS deletion_scheduled_at;
+ALTER TABLE customers DROP COLUMN IF EXISTS deletion_requested_at;
@@ -0,0 +1,14 @@
+-- =============================================
+-- customers: 退会申請（猶予期間）と匿名化
+-- 匿名化したカスタマーの行はレビューの評価を残すために削除せず、google_id / email を NULL にする
+-- =============================================
+ALTER TABLE customers ADD COLUMN deletion_requested_at TIMESTAMP;
+ALTER TABLE customers ADD COLUMN deletion_scheduled_at TIMESTAMP;
+ALTER TABLE customers ADD COLUMN anonymized_at TIMESTAMP;
+ALTER TABLE customers ALTER COLUMN email DROP NOT NULL;
+
+COMMENT ON COLUMN customers.deletion_scheduled_at IS '退会申請中の匿名化予定日時（申請から30日後、取り消すと NULL）';
+COMMENT ON COLUMN customers.anonymized_at IS '匿名化日時（退会済み）';
+
+CREATE INDEX idx_customers_deletion_scheduled_at ON customers(deletion_scheduled_at)
+    WHERE deletion_scheduled_at IS NOT NULL AND anonymized_at IS NULL;
@@ -0,0 +1,7 @@
+-- =============================================
+-- customers: 公開プロフィールの公開範囲
+-- private の場合、公開プロフィールとレビュー一覧は本人にしか表示しない
+-- =============================================
+ALTER TABLE customers ADD COLUMN profile_visibility VARCHAR(10) NOT NULL DEFAULT 'public' CHECK (profile_visibility IN ('public', 'private'));
+
+COMMENT ON COLUMN customers.profile_visibility IS '公開プロフィールの公開範囲（public, private）';
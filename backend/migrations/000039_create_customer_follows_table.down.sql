@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_reviews_customer_id_id;
+DROP TABLE IF EXISTS customer_follows;
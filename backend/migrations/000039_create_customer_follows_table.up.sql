@@ -0,0 +1,18 @@
+-- =============================================
+-- customer_follows: カスタマー間のフォロー関係
+-- =============================================
+CREATE TABLE customer_follows (
+    follower_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    followee_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+COMMENT ON TABLE customer_follows IS 'レビュアーのフォロー（follower_id が followee_id をフォロー）';
+
+-- フォロワー数の集計用
+CREATE INDEX idx_customer_follows_followee_id ON customer_follows(followee_id);
+
+-- フィード（フォロー先ごとの新しいレビュー）の fan-out-on-read 用
+CREATE INDEX idx_reviews_customer_id_id ON reviews(customer_id, id DESC);
@@ -0,0 +1,2 @@
+DELETE FROM role_permissions WHERE permission = 'review:reply';
+DROP TABLE IF EXISTS review_replies;
@@ -0,0 +1,27 @@
+-- =============================================
+-- review_replies: レビューへの返信（スレッド形式）
+-- カスタマーの返信は customer_id、管理者による公式返信は admin_id を持つ
+-- =============================================
+CREATE TABLE review_replies (
+    id BIGSERIAL PRIMARY KEY,
+    review_id BIGINT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    parent_id BIGINT REFERENCES review_replies(id) ON DELETE CASCADE,
+    depth SMALLINT NOT NULL DEFAULT 1 CHECK (depth BETWEEN 1 AND 3),
+    customer_id BIGINT REFERENCES customers(id) ON DELETE CASCADE,
+    admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    is_official BOOLEAN NOT NULL DEFAULT FALSE,
+    body TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (is_official OR customer_id IS NOT NULL)
+);
+
+COMMENT ON TABLE review_replies IS 'レビューへの返信（parent_id が NULL ならレビューへの直接の返信）';
+COMMENT ON COLUMN review_replies.is_official IS 'ブランド・運営による公式返信';
+COMMENT ON COLUMN review_replies.body IS '本文（退会したカスタマーの返信は NULL）';
+
+CREATE INDEX idx_review_replies_review_id ON review_replies(review_id, id);
+CREATE INDEX idx_review_replies_customer_id ON review_replies(customer_id);
+
+-- 公式返信の権限（admin ロールに付与）
+INSERT INTO role_permissions (role_id, permission)
+SELECT id, 'review:reply' FROM admin_roles WHERE name = 'admin';
@@ -0,0 +1,5 @@
+DELETE FROM role_permissions WHERE permission IN ('question:answer', 'question:moderate');
+DROP TABLE IF EXISTS answer_upvotes;
+ALTER TABLE product_questions DROP CONSTRAINT IF EXISTS fk_product_questions_accepted_answer;
+DROP TABLE IF EXISTS product_answers;
+DROP TABLE IF EXISTS product_questions;
@@ -0,0 +1,62 @@
+-- =============================================
+-- product_questions / product_answers / answer_upvotes: 商品Q&A（レビューとは別）
+-- =============================================
+CREATE TABLE product_questions (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    body TEXT,
+    accepted_answer_id BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE product_questions IS '商品への質問';
+COMMENT ON COLUMN product_questions.body IS '本文（退会したカスタマーの質問は NULL）';
+COMMENT ON COLUMN product_questions.accepted_answer_id IS '質問者が採用した回答';
+
+CREATE INDEX idx_product_questions_product_id ON product_questions(product_id, id DESC);
+CREATE INDEX idx_product_questions_customer_id ON product_questions(customer_id);
+
+-- カスタマーの回答は customer_id、管理者による公式回答は admin_id を持つ
+CREATE TABLE product_answers (
+    id BIGSERIAL PRIMARY KEY,
+    question_id BIGINT NOT NULL REFERENCES product_questions(id) ON DELETE CASCADE,
+    customer_id BIGINT REFERENCES customers(id) ON DELETE CASCADE,
+    admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    is_official BOOLEAN NOT NULL DEFAULT FALSE,
+    body TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (is_official OR customer_id IS NOT NULL)
+);
+
+COMMENT ON TABLE product_answers IS '商品への質問の回答';
+COMMENT ON COLUMN product_answers.is_official IS 'ブランド・運営による公式回答';
+COMMENT ON COLUMN product_answers.body IS '本文（退会したカスタマーの回答は NULL）';
+
+CREATE INDEX idx_product_answers_question_id ON product_answers(question_id);
+CREATE INDEX idx_product_answers_customer_id ON product_answers(customer_id);
+
+ALTER TABLE product_questions
+    ADD CONSTRAINT fk_product_questions_accepted_answer
+    FOREIGN KEY (accepted_answer_id) REFERENCES product_answers(id) ON DELETE SET NULL;
+
+CREATE TABLE answer_upvotes (
+    answer_id BIGINT NOT NULL REFERENCES product_answers(id) ON DELETE CASCADE,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (answer_id, customer_id)
+);
+
+COMMENT ON TABLE answer_upvotes IS '回答への賛成（1人1回）';
+
+CREATE INDEX idx_answer_upvotes_customer_id ON answer_upvotes(customer_id);
+
+-- Q&A の権限（admin: 公式回答とモデレーション、moderator: モデレーション）
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM admin_roles r
+JOIN (VALUES
+    ('admin', 'question:answer'),
+    ('admin', 'question:moderate'),
+    ('moderator', 'question:moderate')
+) AS p(role_name, permission) ON p.role_name = r.name;
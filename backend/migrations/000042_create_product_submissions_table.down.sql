@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_products_normalized_name_ja;
+DROP INDEX IF EXISTS idx_products_normalized_name;
+DROP TABLE IF EXISTS product_submissions;
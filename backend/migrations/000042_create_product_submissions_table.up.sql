@@ -0,0 +1,32 @@
+-- =============================================
+-- product_submissions: カスタマーによる新商品の提案（管理者が承認・却下）
+-- =============================================
+CREATE TABLE product_submissions (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    name_ja VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    description_ja TEXT NOT NULL,
+    image_url TEXT NOT NULL,
+    store_url TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    rejection_reason TEXT NOT NULL DEFAULT '',
+    product_id BIGINT REFERENCES products(id) ON DELETE SET NULL,
+    reviewed_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE product_submissions IS 'カスタマーによる新商品の提案';
+COMMENT ON COLUMN product_submissions.store_url IS '購入できる店舗のURL（任意）';
+COMMENT ON COLUMN product_submissions.product_id IS '承認時に登録された商品';
+
+-- 審査キュー（ステータスごとに古い順）
+CREATE INDEX idx_product_submissions_status_id ON product_submissions(status, id);
+CREATE INDEX idx_product_submissions_customer_id ON product_submissions(customer_id, id DESC);
+
+-- 既存商品との重複判定（小文字化し、空白・記号を除いた名前で比較）
+CREATE INDEX idx_products_normalized_name ON products ((regexp_replace(lower(name), '[[:space:][:punct:]]', '', 'g')));
+CREATE INDEX idx_products_normalized_name_ja ON products ((regexp_replace(lower(name_ja), '[[:space:][:punct:]]', '', 'g')));
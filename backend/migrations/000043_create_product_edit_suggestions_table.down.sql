@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS product_edit_suggestion_changes;
+DROP TABLE IF EXISTS product_edit_suggestions;
@@ -0,0 +1,42 @@
+-- =============================================
+-- product_edit_suggestions: カスタマーによる商品情報の修正提案（管理者が承認・却下）
+-- =============================================
+CREATE TABLE product_edit_suggestions (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    comment TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    rejection_reason TEXT NOT NULL DEFAULT '',
+    reviewed_by_admin_id BIGINT REFERENCES admins(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE product_edit_suggestions IS 'カスタマーによる商品情報の修正提案';
+COMMENT ON COLUMN product_edit_suggestions.comment IS '提案の理由（任意）';
+
+-- 審査キュー（ステータスごとに古い順）
+CREATE INDEX idx_product_edit_suggestions_status_id ON product_edit_suggestions(status, id);
+CREATE INDEX idx_product_edit_suggestions_customer_id ON product_edit_suggestions(customer_id, id DESC);
+CREATE INDEX idx_product_edit_suggestions_product_id ON product_edit_suggestions(product_id);
+
+-- =============================================
+-- product_edit_suggestion_changes: 修正提案の項目ごとの変更案
+-- =============================================
+CREATE TABLE product_edit_suggestion_changes (
+    id BIGSERIAL PRIMARY KEY,
+    suggestion_id BIGINT NOT NULL REFERENCES product_edit_suggestions(id) ON DELETE CASCADE,
+    field VARCHAR(100) NOT NULL,
+    original_value TEXT NOT NULL DEFAULT '',
+    proposed_value TEXT NOT NULL DEFAULT '',
+    applied BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE (suggestion_id, field)
+);
+
+COMMENT ON TABLE product_edit_suggestion_changes IS '修正提案の項目ごとの変更案';
+COMMENT ON COLUMN product_edit_suggestion_changes.field IS '項目名（name, descriptionJa, storeLinks.<ストアコード> 等）';
+COMMENT ON COLUMN product_edit_suggestion_changes.original_value IS '提案時点の値';
+COMMENT ON COLUMN product_edit_suggestion_changes.proposed_value IS '提案された値（ストアリンク・アフィリエイトURLの空文字は削除）';
+COMMENT ON COLUMN product_edit_suggestion_changes.applied IS '承認時に商品へ反映したか';
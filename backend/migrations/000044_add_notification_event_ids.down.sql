@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notification_deliveries;
+DROP INDEX IF EXISTS idx_notifications_customer_event;
+ALTER TABLE notifications DROP COLUMN IF EXISTS event_id;
@@ -0,0 +1,26 @@
+-- =============================================
+-- notifications.event_id: 元になったイベント（outbox の再配信で同じ通知を二重に作らない）
+-- =============================================
+ALTER TABLE notifications ADD COLUMN event_id BIGINT;
+
+COMMENT ON COLUMN notifications.event_id IS 'outbox_events.id（NULL = 導入前の通知）';
+
+CREATE UNIQUE INDEX idx_notifications_customer_event ON notifications(customer_id, event_id) WHERE event_id IS NOT NULL;
+
+-- =============================================
+-- notification_deliveries: アプリ外チャネル（メール）の宛先ごとの配信記録
+-- =============================================
+CREATE TABLE notification_deliveries (
+    event_id BIGINT NOT NULL,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('email')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    sent_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (event_id, customer_id, channel)
+);
+
+COMMENT ON TABLE notification_deliveries IS 'メール等の配信記録（イベントの再配信時は送信済みの宛先を飛ばし、失敗した宛先だけ再送する）';
+COMMENT ON COLUMN notification_deliveries.event_id IS 'outbox_events.id';
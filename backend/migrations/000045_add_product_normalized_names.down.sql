@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_products_normalized_name_ja;
+DROP INDEX IF EXISTS idx_products_normalized_name;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS normalized_name_ja,
+    DROP COLUMN IF EXISTS normalized_name;
+
+CREATE INDEX idx_products_normalized_name ON products ((regexp_replace(lower(name), '[[:space:][:punct:]]', '', 'g')));
+CREATE INDEX idx_products_normalized_name_ja ON products ((regexp_replace(lower(name_ja), '[[:space:][:punct:]]', '', 'g')));
@@ -0,0 +1,17 @@
+-- =============================================
+-- products.normalized_name / normalized_name_ja: 重複判定用の正規化した商品名
+-- =============================================
+-- アプリの product.NormalizeName で計算して保存する（SQL の正規化とは記号の扱いが異なるため式インデックスをやめる）
+-- 既存の商品は NULL のまま追加し、バックエンドが起動時に埋める
+DROP INDEX IF EXISTS idx_products_normalized_name;
+DROP INDEX IF EXISTS idx_products_normalized_name_ja;
+
+ALTER TABLE products
+    ADD COLUMN normalized_name TEXT,
+    ADD COLUMN normalized_name_ja TEXT;
+
+COMMENT ON COLUMN products.normalized_name IS '重複判定用の英語名（小文字化し、空白・記号を除く）';
+COMMENT ON COLUMN products.normalized_name_ja IS '重複判定用の日本語名（小文字化し、空白・記号を除く）';
+
+CREATE INDEX idx_products_normalized_name ON products(normalized_name);
+CREATE INDEX idx_products_normalized_name_ja ON products(normalized_name_ja);
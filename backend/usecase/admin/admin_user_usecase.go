@@ -0,0 +1,230 @@
+package adminusecase
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"backend/domain/event"
+	"errors"
+	"time"
+)
+
+var (
+	ErrAdminForbidden      = errors.New("admin:manage permission is required")
+	ErrAdminNotFound       = errors.New("admin not found")
+	ErrAdminEmailTaken     = errors.New("an admin with this email already exists")
+	ErrAdminRoleNotFound   = errors.New("role not found")
+	ErrLastSuperAdmin      = errors.New("at least one active super admin must remain")
+	ErrAdminSelfDeactivate = errors.New("you cannot deactivate yourself")
+)
+
+// InviteAdminInput - 管理者招待の入力
+type InviteAdminInput struct {
+	Email  string
+	Name   string
+	RoleID int64
+}
+
+// AdminUserUsecase - 管理者アカウント管理ユースケース（admin:manage 権限が必要）
+type AdminUserUsecase struct {
+	adminRepo  admin.AdminRepository
+	roleRepo   admin.RoleRepository
+	uow        event.UnitOfWork
+	authorizer *admin.Authorizer
+	now        func() time.Time
+}
+
+// NewAdminUserUsecase - 管理者アカウント管理ユースケースの生成
+func NewAdminUserUsecase(adminRepo admin.AdminRepository, roleRepo admin.RoleRepository, uow event.UnitOfWork, authorizer *admin.Authorizer) *AdminUserUsecase {
+	return &AdminUserUsecase{
+		adminRepo:  adminRepo,
+		roleRepo:   roleRepo,
+		uow:        uow,
+		authorizer: authorizer,
+		now:        time.Now,
+	}
+}
+
+// GetAllAdmins - 管理者一覧取得（無効化済み・最終ログイン日時を含む）
+func (u *AdminUserUsecase) GetAllAdmins(actor audit.Actor) ([]admin.Admin, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+	return u.adminRepo.FindAll()
+}
+
+// InviteAdmin - メールアドレスで管理者を招待
+func (u *AdminUserUsecase) InviteAdmin(input InviteAdminInput, actor audit.Actor) (*admin.Admin, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+	role, err := findRole(u.roleRepo, input.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := admin.NewInvitation(input.Email, input.Name, role, actor.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(func(tx event.Tx) error {
+		_, err := tx.Admins().FindByEmail(a.Email)
+		if err == nil {
+			return ErrAdminEmailTaken
+		}
+		if !errors.Is(err, admin.ErrAdminNotFound) {
+			return err
+		}
+		if err := tx.Admins().Create(a); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionAdminInvite, audit.TargetAdmin, a.ID, nil, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ChangeRole - 管理者のロールを変更（最後の有効なスーパー管理者は降格できない）
+func (u *AdminUserUsecase) ChangeRole(id, roleID int64, actor audit.Actor) (*admin.Admin, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+
+	var a *admin.Admin
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if a, err = findAdmin(tx.Admins(), id); err != nil {
+			return err
+		}
+		role, err := findRole(tx.Roles(), roleID)
+		if err != nil {
+			return err
+		}
+		if a.RoleID == role.ID {
+			return nil
+		}
+		if role.Name != admin.RoleSuperAdmin {
+			if err := ensureNotLastSuperAdmin(tx.Admins(), a); err != nil {
+				return err
+			}
+		}
+
+		before := *a
+		a.RoleID = role.ID
+		a.Role = role
+		if err := tx.Admins().Update(a); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionAdminRoleChange, audit.TargetAdmin, a.ID, before, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// DeactivateAdmin - 管理者を無効化（自分自身・最後の有効なスーパー管理者は不可）
+func (u *AdminUserUsecase) DeactivateAdmin(id int64, actor audit.Actor) (*admin.Admin, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+	if id == actor.AdminID {
+		return nil, ErrAdminSelfDeactivate
+	}
+
+	var a *admin.Admin
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if a, err = findAdmin(tx.Admins(), id); err != nil {
+			return err
+		}
+		if !a.IsActive {
+			return nil
+		}
+		if err := ensureNotLastSuperAdmin(tx.Admins(), a); err != nil {
+			return err
+		}
+
+		before := *a
+		a.Deactivate(u.now())
+		if err := tx.Admins().Update(a); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionAdminDeactivate, audit.TargetAdmin, a.ID, before, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ReactivateAdmin - 無効化した管理者を再び有効化
+func (u *AdminUserUsecase) ReactivateAdmin(id int64, actor audit.Actor) (*admin.Admin, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+
+	var a *admin.Admin
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if a, err = findAdmin(tx.Admins(), id); err != nil {
+			return err
+		}
+		if a.IsActive {
+			return nil
+		}
+
+		before := *a
+		a.Reactivate()
+		if err := tx.Admins().Update(a); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionAdminReactivate, audit.TargetAdmin, a.ID, before, a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ensureNotLastSuperAdmin - 有効なスーパー管理者が a だけなら ErrLastSuperAdmin（行ロックで同時の降格・無効化を直列化する）
+func ensureNotLastSuperAdmin(repo admin.AdminRepository, a *admin.Admin) error {
+	if !a.IsActive || !a.IsSuperAdmin() {
+		return nil
+	}
+	count, err := repo.LockActiveByRole(a.RoleID)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return ErrLastSuperAdmin
+	}
+	return nil
+}
+
+// findAdmin - 該当なしを ErrAdminNotFound に変換し、それ以外のエラーはそのまま返す
+func findAdmin(repo admin.AdminRepository, id int64) (*admin.Admin, error) {
+	a, err := repo.FindByID(id)
+	if errors.Is(err, admin.ErrAdminNotFound) {
+		return nil, ErrAdminNotFound
+	}
+	return a, err
+}
+
+// findRole - 該当なしを ErrAdminRoleNotFound に変換し、それ以外のエラーはそのまま返す
+func findRole(repo admin.RoleRepository, id int64) (*admin.Role, error) {
+	r, err := repo.FindByID(id)
+	if errors.Is(err, admin.ErrRoleNotFound) {
+		return nil, ErrAdminRoleNotFound
+	}
+	return r, err
+}
+
+// authorizeManage - admin:manage 権限の確認
+func authorizeManage(authorizer *admin.Authorizer, actor audit.Actor) error {
+	if err := authorizer.Authorize(actor.AdminID, admin.PermAdminManage); err != nil {
+		return ErrAdminForbidden
+	}
+	return nil
+}
@@ -0,0 +1,348 @@
+package adminusecase
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testRoles = map[int64]*admin.Role{
+	1: {ID: 1, Name: admin.RoleSuperAdmin, IsBuiltIn: true},
+	2: {ID: 2, Name: admin.RoleAdmin, IsBuiltIn: true, Permissions: []string{admin.PermProductWrite, admin.PermReviewModerate}},
+	3: {ID: 3, Name: admin.RoleModerator, IsBuiltIn: true, Permissions: []string{admin.PermReviewModerate}},
+}
+
+// mockAdminRepository - テスト用モックリポジトリ
+type mockAdminRepository struct {
+	admins    map[int64]*admin.Admin
+	nextID    int64
+	emailErr  error
+	updateErr error
+}
+
+func newMockAdminRepo() *mockAdminRepository {
+	return &mockAdminRepository{
+		admins: map[int64]*admin.Admin{
+			1: {ID: 1, Email: "owner@example.com", RoleID: 1, Role: testRoles[1], IsActive: true},
+			2: {ID: 2, Email: "editor@example.com", RoleID: 2, Role: testRoles[2], IsActive: true},
+		},
+		nextID: 3,
+	}
+}
+
+func (m *mockAdminRepository) FindAll() ([]admin.Admin, error) {
+	var result []admin.Admin
+	for id := int64(1); id < m.nextID; id++ {
+		if a, ok := m.admins[id]; ok {
+			result = append(result, *a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAdminRepository) FindByID(id int64) (*admin.Admin, error) {
+	a, ok := m.admins[id]
+	if !ok {
+		return nil, admin.ErrAdminNotFound
+	}
+	copy := *a
+	return &copy, nil
+}
+
+func (m *mockAdminRepository) FindByEmail(email string) (*admin.Admin, error) {
+	if m.emailErr != nil {
+		return nil, m.emailErr
+	}
+	for _, a := range m.admins {
+		if strings.EqualFold(a.Email, email) {
+			copy := *a
+			return &copy, nil
+		}
+	}
+	return nil, admin.ErrAdminNotFound
+}
+
+func (m *mockAdminRepository) FindByGoogleIDOrEmail(_, email string) (*admin.Admin, error) {
+	return m.FindByEmail(email)
+}
+
+func (m *mockAdminRepository) LockActiveByRole(roleID int64) (int64, error) {
+	var count int64
+	for _, a := range m.admins {
+		if a.RoleID == roleID && a.IsActive {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockAdminRepository) CountByRole(roleID int64) (int64, error) {
+	var count int64
+	for _, a := range m.admins {
+		if a.RoleID == roleID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockAdminRepository) Create(a *admin.Admin) error {
+	a.ID = m.nextID
+	m.nextID++
+	copy := *a
+	m.admins[a.ID] = &copy
+	return nil
+}
+
+func (m *mockAdminRepository) Update(a *admin.Admin) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	copy := *a
+	m.admins[a.ID] = &copy
+	return nil
+}
+
+// mockRoleRepository - テスト用モックリポジトリ
+type mockRoleRepository struct {
+	roles  map[int64]*admin.Role
+	nextID int64
+}
+
+func newMockRoleRepo() *mockRoleRepository {
+	roles := make(map[int64]*admin.Role)
+	for id, r := range testRoles {
+		copy := *r
+		roles[id] = &copy
+	}
+	return &mockRoleRepository{roles: roles, nextID: 4}
+}
+
+func (m *mockRoleRepository) FindAll() ([]admin.Role, error) {
+	var result []admin.Role
+	for id := int64(1); id < m.nextID; id++ {
+		if r, ok := m.roles[id]; ok {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRoleRepository) FindByID(id int64) (*admin.Role, error) {
+	r, ok := m.roles[id]
+	if !ok {
+		return nil, admin.ErrRoleNotFound
+	}
+	copy := *r
+	return &copy, nil
+}
+
+func (m *mockRoleRepository) FindByName(name string) (*admin.Role, error) {
+	for _, r := range m.roles {
+		if r.Name == name {
+			copy := *r
+			return &copy, nil
+		}
+	}
+	return nil, admin.ErrRoleNotFound
+}
+
+func (m *mockRoleRepository) Create(r *admin.Role) error {
+	r.ID = m.nextID
+	m.nextID++
+	copy := *r
+	m.roles[r.ID] = &copy
+	return nil
+}
+
+func (m *mockRoleRepository) Update(r *admin.Role) error {
+	copy := *r
+	m.roles[r.ID] = &copy
+	return nil
+}
+
+func (m *mockRoleRepository) Delete(id int64) error {
+	delete(m.roles, id)
+	return nil
+}
+
+// newTestAdminUserUsecase - 管理者リポジトリを Authorizer と共有するユースケースを生成
+func newTestAdminUserUsecase(repo *mockAdminRepository) (*AdminUserUsecase, *mockUnitOfWork) {
+	roles := newMockRoleRepo()
+	uow := &mockUnitOfWork{admins: repo, roles: roles}
+	return NewAdminUserUsecase(repo, roles, uow, admin.NewAuthorizer(repo)), uow
+}
+
+func TestAdminUser_SuperAdminOnly(t *testing.T) {
+	uc, _ := newTestAdminUserUsecase(newMockAdminRepo())
+	actor := audit.Actor{AdminID: 2, Role: admin.RoleAdmin}
+
+	if _, err := uc.GetAllAdmins(actor); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+	if _, err := uc.InviteAdmin(InviteAdminInput{Email: "new@example.com", RoleID: 3}, actor); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+	if _, err := uc.ChangeRole(2, 1, actor); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+	if _, err := uc.DeactivateAdmin(1, actor); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+}
+
+func TestInviteAdmin(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, uow := newTestAdminUserUsecase(repo)
+
+	a, err := uc.InviteAdmin(InviteAdminInput{Email: "Mod@Example.com", RoleID: 3}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.ID != 3 || a.Email != "mod@example.com" || a.Name != "mod" || a.RoleID != 3 || !a.IsActive {
+		t.Errorf("unexpected admin: %+v", a)
+	}
+	if a.GoogleID != "" || a.LastLoginAt != nil {
+		t.Errorf("expected invited admin without login, got %+v", a)
+	}
+	if a.InvitedByAdminID == nil || *a.InvitedByAdminID != testActor.AdminID {
+		t.Errorf("expected invitedBy %d, got %v", testActor.AdminID, a.InvitedByAdminID)
+	}
+	if len(uow.audits) != 1 || uow.audits[0].Action != audit.ActionAdminInvite {
+		t.Errorf("expected invite audit entry, got %+v", uow.audits)
+	}
+
+	if _, err := uc.InviteAdmin(InviteAdminInput{Email: "MOD@example.com", RoleID: 2}, testActor); !errors.Is(err, ErrAdminEmailTaken) {
+		t.Errorf("expected ErrAdminEmailTaken, got %v", err)
+	}
+	if _, err := uc.InviteAdmin(InviteAdminInput{Email: "not-an-email", RoleID: 2}, testActor); !errors.Is(err, admin.ErrInvalidEmail) {
+		t.Errorf("expected ErrInvalidEmail, got %v", err)
+	}
+	if _, err := uc.InviteAdmin(InviteAdminInput{Email: "x@example.com", RoleID: 99}, testActor); !errors.Is(err, ErrAdminRoleNotFound) {
+		t.Errorf("expected ErrAdminRoleNotFound, got %v", err)
+	}
+}
+
+func TestChangeRole(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, uow := newTestAdminUserUsecase(repo)
+
+	a, err := uc.ChangeRole(2, 3, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.RoleID != 3 || a.Role.Name != admin.RoleModerator || repo.admins[2].RoleID != 3 {
+		t.Errorf("expected moderator role, got %+v", a)
+	}
+	changes := changedFields(t, uow.audits[0])
+	if string(changes["roleId"].Before) != "2" || string(changes["roleId"].After) != "3" {
+		t.Errorf("expected roleId diff, got %+v", changes["roleId"])
+	}
+
+	if _, err := uc.ChangeRole(999, 2, testActor); !errors.Is(err, ErrAdminNotFound) {
+		t.Errorf("expected ErrAdminNotFound, got %v", err)
+	}
+}
+
+func TestChangeRole_LastSuperAdmin(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, _ := newTestAdminUserUsecase(repo)
+
+	if _, err := uc.ChangeRole(1, 2, testActor); !errors.Is(err, ErrLastSuperAdmin) {
+		t.Fatalf("expected ErrLastSuperAdmin, got %v", err)
+	}
+
+	// 2人目のスーパー管理者がいれば降格できる
+	if _, err := uc.ChangeRole(2, 1, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.ChangeRole(1, 2, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.ChangeRole(2, 3, audit.Actor{AdminID: 2, Role: admin.RoleSuperAdmin}); !errors.Is(err, ErrLastSuperAdmin) {
+		t.Errorf("expected ErrLastSuperAdmin, got %v", err)
+	}
+}
+
+func TestDeactivateAdmin(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, _ := newTestAdminUserUsecase(repo)
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	a, err := uc.DeactivateAdmin(2, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.IsActive || a.DeactivatedAt == nil || !a.DeactivatedAt.Equal(now) {
+		t.Errorf("expected deactivated admin, got %+v", a)
+	}
+
+	a, err = uc.ReactivateAdmin(2, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !a.IsActive || a.DeactivatedAt != nil {
+		t.Errorf("expected reactivated admin, got %+v", a)
+	}
+
+	if _, err := uc.DeactivateAdmin(testActor.AdminID, testActor); !errors.Is(err, ErrAdminSelfDeactivate) {
+		t.Errorf("expected ErrAdminSelfDeactivate, got %v", err)
+	}
+}
+
+func TestDeactivateAdmin_RevokesAccess(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, _ := newTestAdminUserUsecase(repo)
+
+	// 2人目のスーパー管理者は、JWT のロールに関係なく無効化された時点で操作できなくなる
+	if _, err := uc.ChangeRole(2, 1, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second := audit.Actor{AdminID: 2, Role: admin.RoleSuperAdmin}
+	if _, err := uc.GetAllAdmins(second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.DeactivateAdmin(2, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.GetAllAdmins(second); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+	if _, err := uc.DeactivateAdmin(1, second); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+	if !repo.admins[1].IsActive {
+		t.Error("expected super admin to stay active")
+	}
+}
+
+func TestInviteAdmin_LookupErrorIsReturned(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, _ := newTestAdminUserUsecase(repo)
+	dbErr := errors.New("connection refused")
+	repo.emailErr = dbErr
+
+	if _, err := uc.InviteAdmin(InviteAdminInput{Email: "new@example.com", RoleID: 3}, testActor); !errors.Is(err, dbErr) {
+		t.Errorf("expected the lookup error, got %v", err)
+	}
+	if len(repo.admins) != 2 {
+		t.Errorf("expected no admin to be invited, got %d admins", len(repo.admins))
+	}
+}
+
+func TestAdminUser_AuditFailureFailsTheChange(t *testing.T) {
+	repo := newMockAdminRepo()
+	uc, uow := newTestAdminUserUsecase(repo)
+	uow.auditErr = errors.New("audit insert failed")
+
+	if _, err := uc.DeactivateAdmin(2, testActor); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(uow.audits) != 0 {
+		t.Errorf("expected no committed audit entries, got %+v", uow.audits)
+	}
+}
@@ -0,0 +1,49 @@
+package adminusecase
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"errors"
+)
+
+// auditLogMaxLimit - 監査ログの1回の取得件数の上限
+const auditLogMaxLimit = 500
+
+var (
+	ErrAuditLogForbidden    = errors.New("audit:read permission is required")
+	ErrAuditLogRangeInvalid = errors.New("from must be before to")
+)
+
+// AdminAuditUsecase - 管理者向け監査ログユースケース
+type AdminAuditUsecase struct {
+	auditRepo  audit.AuditLogRepository
+	authorizer *admin.Authorizer
+}
+
+// NewAdminAuditUsecase - 管理者向け監査ログユースケースの生成
+func NewAdminAuditUsecase(auditRepo audit.AuditLogRepository, authorizer *admin.Authorizer) *AdminAuditUsecase {
+	return &AdminAuditUsecase{auditRepo: auditRepo, authorizer: authorizer}
+}
+
+// GetAuditLogs - 監査ログ取得（audit:read 権限が必要、新しい順）
+func (u *AdminAuditUsecase) GetAuditLogs(actor audit.Actor, filter audit.Filter) ([]audit.Entry, error) {
+	if err := u.authorizer.Authorize(actor.AdminID, admin.PermAuditRead); err != nil {
+		return nil, ErrAuditLogForbidden
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, ErrAuditLogRangeInvalid
+	}
+	if filter.Limit > auditLogMaxLimit {
+		filter.Limit = auditLogMaxLimit
+	}
+	return u.auditRepo.Find(filter)
+}
+
+// recordAudit - 監査ログを記録（UnitOfWork を使う操作では tx.AuditLogs() を渡して同一トランザクションで記録）
+func recordAudit(repo audit.AuditLogRepository, actor audit.Actor, action, targetType string, targetID int64, before, after any) error {
+	e, err := audit.NewEntry(actor, action, targetType, targetID, before, after)
+	if err != nil {
+		return err
+	}
+	return repo.Create(e)
+}
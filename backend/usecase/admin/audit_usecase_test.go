@@ -0,0 +1,179 @@
+package adminusecase
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testActor - テスト用の操作者
+var testActor = audit.Actor{AdminID: 1, Role: admin.RoleSuperAdmin, IP: "192.0.2.1"}
+
+// mockAuditLogRepository - テスト用モックリポジトリ
+type mockAuditLogRepository struct {
+	entries   []audit.Entry
+	createErr error
+	lastQuery audit.Filter
+}
+
+func newMockAuditLogRepo() *mockAuditLogRepository {
+	return &mockAuditLogRepository{}
+}
+
+func (m *mockAuditLogRepository) Create(e *audit.Entry) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	e.ID = int64(len(m.entries) + 1)
+	m.entries = append(m.entries, *e)
+	return nil
+}
+
+func (m *mockAuditLogRepository) Find(filter audit.Filter) ([]audit.Entry, error) {
+	m.lastQuery = filter
+	var result []audit.Entry
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		e := m.entries[i]
+		if (filter.Action == "" || e.Action == filter.Action) && (filter.TargetID == "" || e.TargetID == filter.TargetID) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// changedFields - 監査ログの差分に含まれるフィールド
+func changedFields(t *testing.T, e audit.Entry) map[string]audit.Change {
+	t.Helper()
+	changes := map[string]audit.Change{}
+	if e.Changes == nil {
+		return changes
+	}
+	if err := json.Unmarshal([]byte(*e.Changes), &changes); err != nil {
+		t.Fatalf("invalid changes: %v", err)
+	}
+	return changes
+}
+
+func TestGetAuditLogs_SuperAdminOnly(t *testing.T) {
+	repo := newMockAuditLogRepo()
+	uc := NewAdminAuditUsecase(repo, admin.NewAuthorizer(newMockAdminRepo()))
+
+	// 権限は JWT のロールではなく管理者2の現在のロール（admin）で判定される
+	for _, role := range []string{admin.RoleAdmin, admin.RoleModerator, ""} {
+		if _, err := uc.GetAuditLogs(audit.Actor{AdminID: 2, Role: role}, audit.Filter{}); !errors.Is(err, ErrAuditLogForbidden) {
+			t.Errorf("role %q: expected ErrAuditLogForbidden, got %v", role, err)
+		}
+	}
+	if _, err := uc.GetAuditLogs(testActor, audit.Filter{Limit: 10000}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastQuery.Limit != auditLogMaxLimit {
+		t.Errorf("expected limit to be capped at %d, got %d", auditLogMaxLimit, repo.lastQuery.Limit)
+	}
+}
+
+func TestGetAuditLogs_InvalidRange(t *testing.T) {
+	uc := NewAdminAuditUsecase(newMockAuditLogRepo(), admin.NewAuthorizer(newMockAdminRepo()))
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := uc.GetAuditLogs(testActor, audit.Filter{From: &from, To: &to}); !errors.Is(err, ErrAuditLogRangeInvalid) {
+		t.Errorf("expected ErrAuditLogRangeInvalid, got %v", err)
+	}
+}
+
+func TestCategoryAudit_RecordsWhoDeletedWhat(t *testing.T) {
+	categories := &mockCategoryRepoForCategory{}
+	uow := &mockUnitOfWork{categories: categories}
+	uc := NewAdminCategoryUsecase(categories, uow)
+
+	created, err := uc.CreateCategory(CreateCategoryInput{Name: "Snacks", NameJa: "スナック"}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	moderator := audit.Actor{AdminID: 7, Role: admin.RoleAdmin, IP: "198.51.100.7"}
+	if err := uc.DeleteCategory(created.ID, moderator); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(uow.audits) != 2 {
+		t.Fatalf("expected 2 audit entries, got %d", len(uow.audits))
+	}
+	deleted := uow.audits[1]
+	if deleted.Action != audit.ActionCategoryDelete || deleted.AdminID != 7 || deleted.AdminRole != admin.RoleAdmin || deleted.IPAddress != "198.51.100.7" {
+		t.Errorf("unexpected delete entry: %+v", deleted)
+	}
+	if deleted.Before == nil || deleted.After != nil {
+		t.Errorf("expected before snapshot only, got before=%v after=%v", deleted.Before, deleted.After)
+	}
+	if uow.audits[0].Before != nil || uow.audits[0].After == nil {
+		t.Errorf("expected after snapshot only on create, got %+v", uow.audits[0])
+	}
+}
+
+func TestCategoryAudit_UpdateDiff(t *testing.T) {
+	categories := &mockCategoryRepoForCategory{}
+	uow := &mockUnitOfWork{categories: categories}
+	uc := NewAdminCategoryUsecase(categories, uow)
+
+	if _, err := uc.UpdateCategory(1, UpdateCategoryInput{Name: "Renamed", NameJa: "テスト"}, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changes := changedFields(t, uow.audits[0])
+	if len(changes) != 1 {
+		t.Fatalf("expected only name to change, got %v", changes)
+	}
+	if string(changes["name"].After) != `"Renamed"` {
+		t.Errorf("unexpected name change: %+v", changes["name"])
+	}
+}
+
+func TestCategoryAudit_FailureReturnsError(t *testing.T) {
+	categories := &mockCategoryRepoForCategory{}
+	uow := &mockUnitOfWork{categories: categories, auditErr: errors.New("audit unavailable")}
+	uc := NewAdminCategoryUsecase(categories, uow)
+
+	// 監査ログの失敗はトランザクションごとロールバックされる
+	if _, err := uc.CreateCategory(CreateCategoryInput{Name: "Snacks", NameJa: "スナック"}, testActor); !errors.Is(err, uow.auditErr) {
+		t.Fatalf("expected audit error, got %v", err)
+	}
+	if len(uow.audits) != 0 {
+		t.Errorf("expected no committed audit entries, got %d", len(uow.audits))
+	}
+}
+
+func TestCustomerAudit_RecordsSanctionsInTransaction(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, uow, _ := newTestCustomerUsecase(repo)
+
+	if _, err := uc.BanCustomer(1, "spam", testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.UnbanCustomer(1, "", audit.Actor{AdminID: 5, Role: admin.RoleModerator}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(uow.audits) != 2 {
+		t.Fatalf("expected 2 audit entries, got %d", len(uow.audits))
+	}
+	unban := uow.audits[1]
+	if unban.Action != audit.ActionCustomerUnban || unban.AdminID != 5 || unban.TargetType != audit.TargetCustomer || unban.TargetID != "1" {
+		t.Errorf("unexpected unban entry: %+v", unban)
+	}
+	changes := changedFields(t, unban)
+	if string(changes["statusReason"].Before) != `"spam"` || string(changes["statusReason"].After) != "null" {
+		t.Errorf("expected previous ban reason in diff, got %+v", changes["statusReason"])
+	}
+
+	// 更新に失敗した場合は監査ログも残らない
+	repo.updateErr = errors.New("update failed")
+	if _, err := uc.BanCustomer(1, "spam", testActor); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(uow.audits) != 2 {
+		t.Errorf("expected no audit entry after rollback, got %d", len(uow.audits))
+	}
+}
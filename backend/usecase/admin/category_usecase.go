@@ -1,6 +1,8 @@
 package adminusecase
 
 import (
+	"backend/domain/audit"
+	"backend/domain/event"
 	"backend/domain/product"
 	"fmt"
 )
@@ -8,6 +10,7 @@ import (
 // AdminCategoryUsecase - 管理者向けカテゴリユースケース
 type AdminCategoryUsecase struct {
 	categoryRepo product.CategoryRepository
+	uow          event.UnitOfWork
 }
 
 // CreateCategoryInput - カテゴリ作成の入力
@@ -25,12 +28,12 @@ type UpdateCategoryInput struct {
 }
 
 // NewAdminCategoryUsecase - 管理者向けカテゴリユースケースの生成
-func NewAdminCategoryUsecase(categoryRepo product.CategoryRepository) *AdminCategoryUsecase {
-	return &AdminCategoryUsecase{categoryRepo: categoryRepo}
+func NewAdminCategoryUsecase(categoryRepo product.CategoryRepository, uow event.UnitOfWork) *AdminCategoryUsecase {
+	return &AdminCategoryUsecase{categoryRepo: categoryRepo, uow: uow}
 }
 
 // CreateCategory - カテゴリ作成
-func (u *AdminCategoryUsecase) CreateCategory(input CreateCategoryInput) (*product.Category, error) {
+func (u *AdminCategoryUsecase) CreateCategory(input CreateCategoryInput, actor audit.Actor) (*product.Category, error) {
 	if err := u.validateCategoryFields(input.Name, input.NameJa); err != nil {
 		return nil, err
 	}
@@ -41,18 +44,25 @@ func (u *AdminCategoryUsecase) CreateCategory(input CreateCategoryInput) (*produ
 		CreatedByAdminID: input.CreatedByAdminID,
 	}
 
-	if err := u.categoryRepo.Create(c); err != nil {
+	err := u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Categories().Create(c); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionCategoryCreate, audit.TargetCategory, c.ID, nil, c)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
 // UpdateCategory - カテゴリ更新
-func (u *AdminCategoryUsecase) UpdateCategory(id int64, input UpdateCategoryInput) (*product.Category, error) {
+func (u *AdminCategoryUsecase) UpdateCategory(id int64, input UpdateCategoryInput, actor audit.Actor) (*product.Category, error) {
 	c, err := u.categoryRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
+	before := *c
 
 	if err := u.validateCategoryFields(input.Name, input.NameJa); err != nil {
 		return nil, err
@@ -62,15 +72,30 @@ func (u *AdminCategoryUsecase) UpdateCategory(id int64, input UpdateCategoryInpu
 	c.NameJa = input.NameJa
 	c.UpdatedByAdminID = input.UpdatedByAdminID
 
-	if err := u.categoryRepo.Update(c); err != nil {
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Categories().Update(c); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionCategoryUpdate, audit.TargetCategory, c.ID, before, c)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
 // DeleteCategory - カテゴリ削除
-func (u *AdminCategoryUsecase) DeleteCategory(id int64) error {
-	return u.categoryRepo.Delete(id)
+func (u *AdminCategoryUsecase) DeleteCategory(id int64, actor audit.Actor) error {
+	c, err := u.categoryRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Categories().Delete(id); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionCategoryDelete, audit.TargetCategory, id, c, nil)
+	})
 }
 
 // validateCategoryFields - カテゴリフィールドのバリデーション
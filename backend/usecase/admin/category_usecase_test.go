@@ -43,6 +43,11 @@ func (m *mockCategoryRepoForCategory) Delete(id int64) error {
 	return nil
 }
 
+// newTestCategoryUsecase - 書き込みを repo に流す UnitOfWork 付きでユースケースを生成
+func newTestCategoryUsecase(repo product.CategoryRepository) *AdminCategoryUsecase {
+	return NewAdminCategoryUsecase(repo, &mockUnitOfWork{categories: repo})
+}
+
 func validCreateCategoryInput() CreateCategoryInput {
 	return CreateCategoryInput{
 		Name:   "Meat Alternatives",
@@ -51,9 +56,9 @@ func validCreateCategoryInput() CreateCategoryInput {
 }
 
 func TestCreateCategory_Success(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
-	c, err := uc.CreateCategory(validCreateCategoryInput())
+	c, err := uc.CreateCategory(validCreateCategoryInput(), testActor)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -66,12 +71,12 @@ func TestCreateCategory_Success(t *testing.T) {
 }
 
 func TestCreateCategory_EmptyName(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := validCreateCategoryInput()
 	input.Name = ""
 
-	_, err := uc.CreateCategory(input)
+	_, err := uc.CreateCategory(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for empty name")
 	}
@@ -81,12 +86,12 @@ func TestCreateCategory_EmptyName(t *testing.T) {
 }
 
 func TestCreateCategory_EmptyNameJa(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := validCreateCategoryInput()
 	input.NameJa = ""
 
-	_, err := uc.CreateCategory(input)
+	_, err := uc.CreateCategory(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for empty nameJa")
 	}
@@ -96,12 +101,12 @@ func TestCreateCategory_EmptyNameJa(t *testing.T) {
 }
 
 func TestCreateCategory_NameTooLong(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := validCreateCategoryInput()
 	input.Name = strings.Repeat("a", 101)
 
-	_, err := uc.CreateCategory(input)
+	_, err := uc.CreateCategory(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for long name")
 	}
@@ -111,12 +116,12 @@ func TestCreateCategory_NameTooLong(t *testing.T) {
 }
 
 func TestCreateCategory_NameNoEnglish(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := validCreateCategoryInput()
 	input.Name = "代替肉"
 
-	_, err := uc.CreateCategory(input)
+	_, err := uc.CreateCategory(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for name without English")
 	}
@@ -126,12 +131,12 @@ func TestCreateCategory_NameNoEnglish(t *testing.T) {
 }
 
 func TestCreateCategory_NameJaNoJapanese(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := validCreateCategoryInput()
 	input.NameJa = "Meat Alternatives"
 
-	_, err := uc.CreateCategory(input)
+	_, err := uc.CreateCategory(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for nameJa without Japanese")
 	}
@@ -141,14 +146,14 @@ func TestCreateCategory_NameJaNoJapanese(t *testing.T) {
 }
 
 func TestUpdateCategory_Success(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := UpdateCategoryInput{
 		Name:   "Updated",
 		NameJa: "更新済み",
 	}
 
-	c, err := uc.UpdateCategory(1, input)
+	c, err := uc.UpdateCategory(1, input, testActor)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -163,28 +168,28 @@ func TestUpdateCategory_NotFound(t *testing.T) {
 			return nil, errors.New("not found")
 		},
 	}
-	uc := NewAdminCategoryUsecase(repo)
+	uc := newTestCategoryUsecase(repo)
 
 	input := UpdateCategoryInput{
 		Name:   "Updated",
 		NameJa: "更新済み",
 	}
 
-	_, err := uc.UpdateCategory(999, input)
+	_, err := uc.UpdateCategory(999, input, testActor)
 	if err == nil {
 		t.Fatal("expected error for not found category")
 	}
 }
 
 func TestUpdateCategory_ValidationError(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
 	input := UpdateCategoryInput{
 		Name:   "",
 		NameJa: "テスト",
 	}
 
-	_, err := uc.UpdateCategory(1, input)
+	_, err := uc.UpdateCategory(1, input, testActor)
 	if err == nil {
 		t.Fatal("expected validation error")
 	}
@@ -194,9 +199,9 @@ func TestUpdateCategory_ValidationError(t *testing.T) {
 }
 
 func TestDeleteCategory_Success(t *testing.T) {
-	uc := NewAdminCategoryUsecase(&mockCategoryRepoForCategory{})
+	uc := newTestCategoryUsecase(&mockCategoryRepoForCategory{})
 
-	err := uc.DeleteCategory(1)
+	err := uc.DeleteCategory(1, testActor)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -208,9 +213,9 @@ func TestDeleteCategory_RepoError(t *testing.T) {
 			return errors.New("delete failed")
 		},
 	}
-	uc := NewAdminCategoryUsecase(repo)
+	uc := newTestCategoryUsecase(repo)
 
-	err := uc.DeleteCategory(1)
+	err := uc.DeleteCategory(1, testActor)
 	if err == nil {
 		t.Fatal("expected error from repo")
 	}
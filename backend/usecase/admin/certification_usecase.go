@@ -0,0 +1,30 @@
+package adminusecase
+
+import (
+	"backend/domain/product"
+	"time"
+)
+
+// AdminCertificationUsecase - 管理者向け商品認証ユースケース
+type AdminCertificationUsecase struct {
+	certificationRepo product.CertificationRepository
+	now               func() time.Time
+}
+
+// NewAdminCertificationUsecase - 管理者向け商品認証ユースケースの生成
+func NewAdminCertificationUsecase(certificationRepo product.CertificationRepository) *AdminCertificationUsecase {
+	return &AdminCertificationUsecase{
+		certificationRepo: certificationRepo,
+		now:               time.Now,
+	}
+}
+
+// FlagExpiredCertifications - 有効期限切れの認証にフラグを立てる（定期実行）
+func (u *AdminCertificationUsecase) FlagExpiredCertifications() (int64, error) {
+	return u.certificationRepo.MarkExpired(u.now())
+}
+
+// GetExpiredCertifications - 有効期限切れの認証一覧取得
+func (u *AdminCertificationUsecase) GetExpiredCertifications() ([]product.Certification, error) {
+	return u.certificationRepo.FindExpired()
+}
@@ -0,0 +1,80 @@
+package adminusecase
+
+import (
+	"backend/domain/product"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockCertificationRepository - テスト用モックリポジトリ
+type mockCertificationRepository struct {
+	certifications []product.Certification
+	markExpiredErr error
+}
+
+func (m *mockCertificationRepository) FindExpired() ([]product.Certification, error) {
+	var result []product.Certification
+	for _, c := range m.certifications {
+		if c.IsExpired {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCertificationRepository) MarkExpired(now time.Time) (int64, error) {
+	if m.markExpiredErr != nil {
+		return 0, m.markExpiredErr
+	}
+	var count int64
+	for i := range m.certifications {
+		c := &m.certifications[i]
+		if !c.IsExpired && c.ExpiredAt(now) {
+			c.IsExpired = true
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestFlagExpiredCertifications(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := now.AddDate(0, 0, -1)
+	future := now.AddDate(1, 0, 0)
+
+	repo := &mockCertificationRepository{
+		certifications: []product.Certification{
+			{ID: 1, ProductID: 1, ExpiresAt: &past},
+			{ID: 2, ProductID: 1, ExpiresAt: &future},
+			{ID: 3, ProductID: 2},
+		},
+	}
+	uc := NewAdminCertificationUsecase(repo)
+	uc.now = func() time.Time { return now }
+
+	flagged, err := uc.FlagExpiredCertifications()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if flagged != 1 {
+		t.Errorf("expected 1 flagged certification, got %d", flagged)
+	}
+
+	expired, err := uc.GetExpiredCertifications()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(expired) != 1 || expired[0].ID != 1 {
+		t.Errorf("expected certification 1 to be expired, got %v", expired)
+	}
+}
+
+func TestFlagExpiredCertifications_RepoError(t *testing.T) {
+	repo := &mockCertificationRepository{markExpiredErr: errors.New("db error")}
+	uc := NewAdminCertificationUsecase(repo)
+
+	if _, err := uc.FlagExpiredCertifications(); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,81 @@
+package adminusecase
+
+import (
+	"backend/domain/click"
+	"errors"
+	"time"
+)
+
+const (
+	// ClickReportDefaultDays - 期間未指定時の集計日数
+	ClickReportDefaultDays = 30
+	// ClickReportMaxDays - 一度に集計できる最大日数
+	ClickReportMaxDays = 366
+)
+
+var ErrClickReportRangeInvalid = errors.New("report range must end after it starts and span at most 366 days")
+
+// AdminClickUsecase - 管理者向けクリック集計ユースケース
+type AdminClickUsecase struct {
+	clickRepo click.ClickRepository
+	now       func() time.Time
+}
+
+// ClickReportInput - クリック集計の入力（日付はUTCの日単位、To は当日を含む）
+type ClickReportInput struct {
+	From      *time.Time
+	To        *time.Time
+	ProductID *int64
+}
+
+// ClickReport - クリック集計結果
+type ClickReport struct {
+	From        string             `json:"from"`
+	To          string             `json:"to"`
+	TotalClicks int64              `json:"totalClicks"`
+	Rows        []click.DailyCount `json:"rows"`
+}
+
+// NewAdminClickUsecase - 管理者向けクリック集計ユースケースの生成
+func NewAdminClickUsecase(clickRepo click.ClickRepository) *AdminClickUsecase {
+	return &AdminClickUsecase{
+		clickRepo: clickRepo,
+		now:       time.Now,
+	}
+}
+
+// GetDailyReport - 商品・ストア・日ごとのクリック数を取得
+func (u *AdminClickUsecase) GetDailyReport(input ClickReportInput) (*ClickReport, error) {
+	to := truncateToDay(u.now())
+	if input.To != nil {
+		to = truncateToDay(*input.To)
+	}
+	from := to.AddDate(0, 0, -(ClickReportDefaultDays - 1))
+	if input.From != nil {
+		from = truncateToDay(*input.From)
+	}
+	if to.Before(from) || to.Sub(from) >= ClickReportMaxDays*24*time.Hour {
+		return nil, ErrClickReportRangeInvalid
+	}
+
+	rows, err := u.clickRepo.CountDaily(from, to.AddDate(0, 0, 1), input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ClickReport{
+		From: from.Format("2006-01-02"),
+		To:   to.Format("2006-01-02"),
+		Rows: rows,
+	}
+	for _, row := range rows {
+		report.TotalClicks += row.Clicks
+	}
+	return report, nil
+}
+
+// truncateToDay - UTCの日の始まりに切り捨て
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,62 @@
+package adminusecase
+
+import (
+	"backend/domain/click"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockClickRepository - テスト用モックリポジトリ
+type mockClickRepository struct {
+	rows      []click.DailyCount
+	from, to  time.Time
+	productID *int64
+}
+
+func (m *mockClickRepository) Create(c *click.Click) error {
+	return nil
+}
+func (m *mockClickRepository) CountDaily(from, to time.Time, productID *int64) ([]click.DailyCount, error) {
+	m.from, m.to, m.productID = from, to, productID
+	return m.rows, nil
+}
+
+func TestGetDailyReport_DefaultRange(t *testing.T) {
+	repo := &mockClickRepository{rows: []click.DailyCount{
+		{Date: "2025-06-10", ProductID: 1, Store: "amazon", Clicks: 5, UniqueVisitors: 3},
+		{Date: "2025-06-09", ProductID: 1, Store: "rakuten", Clicks: 2, UniqueVisitors: 2},
+	}}
+	uc := NewAdminClickUsecase(repo)
+	uc.now = func() time.Time { return time.Date(2025, 6, 10, 15, 30, 0, 0, time.UTC) }
+
+	report, err := uc.GetDailyReport(ClickReportInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.From != "2025-05-12" || report.To != "2025-06-10" {
+		t.Errorf("expected 30-day range ending today, got %s..%s", report.From, report.To)
+	}
+	if report.TotalClicks != 7 {
+		t.Errorf("expected 7 total clicks, got %d", report.TotalClicks)
+	}
+	// 終了日は当日を含むため翌日0時までを検索する
+	if !repo.to.Equal(time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected exclusive upper bound at next midnight, got %v", repo.to)
+	}
+}
+
+func TestGetDailyReport_InvalidRange(t *testing.T) {
+	uc := NewAdminClickUsecase(&mockClickRepository{})
+
+	from := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := uc.GetDailyReport(ClickReportInput{From: &from, To: &to}); !errors.Is(err, ErrClickReportRangeInvalid) {
+		t.Errorf("expected ErrClickReportRangeInvalid, got %v", err)
+	}
+
+	from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := uc.GetDailyReport(ClickReportInput{From: &from, To: &to}); !errors.Is(err, ErrClickReportRangeInvalid) {
+		t.Errorf("expected ErrClickReportRangeInvalid for too long range, got %v", err)
+	}
+}
@@ -1,103 +1,236 @@
 package adminusecase
 
 import (
+	"backend/domain/audit"
 	"backend/domain/customer"
+	"backend/domain/event"
 	"errors"
 	"time"
 )
 
-// CustomerWithReviewCount - カスタマーとレビュー数
+// ErrCustomerNotFound - 該当するカスタマーがない
+var ErrCustomerNotFound = errors.New("customer not found")
+
+// CustomerWithReviewCount - カスタマーとレビュー数・制裁回数
 type CustomerWithReviewCount struct {
 	customer.Customer
-	ReviewCount int
+	ReviewCount   int
+	SanctionCount int
 }
 
 // AdminCustomerUsecase - 管理者向けカスタマーユースケース
 type AdminCustomerUsecase struct {
 	customerRepo customer.CustomerRepository
+	sanctionRepo customer.SanctionRepository
+	uow          event.UnitOfWork
+	now          func() time.Time
 }
 
 // NewAdminCustomerUsecase - 管理者向けカスタマーユースケースの生成
-func NewAdminCustomerUsecase(customerRepo customer.CustomerRepository) *AdminCustomerUsecase {
-	return &AdminCustomerUsecase{customerRepo: customerRepo}
+func NewAdminCustomerUsecase(customerRepo customer.CustomerRepository, sanctionRepo customer.SanctionRepository, uow event.UnitOfWork) *AdminCustomerUsecase {
+	return &AdminCustomerUsecase{
+		customerRepo: customerRepo,
+		sanctionRepo: sanctionRepo,
+		uow:          uow,
+		now:          time.Now,
+	}
 }
 
-// GetAllCustomers - 全カスタマー一覧取得（レビュー数付き）
+// GetAllCustomers - 全カスタマー一覧取得（レビュー数・制裁回数付き、ステータスは有効な制裁から求める）
 func (u *AdminCustomerUsecase) GetAllCustomers() ([]CustomerWithReviewCount, error) {
 	customers, reviewCounts, err := u.customerRepo.FindAllWithReviewCount()
 	if err != nil {
 		return nil, err
 	}
+	sanctionCounts, err := u.sanctionRepo.CountByCustomer()
+	if err != nil {
+		return nil, err
+	}
+	now := u.now()
+	active, err := u.sanctionRepo.FindActive(now)
+	if err != nil {
+		return nil, err
+	}
+	activeByCustomer := make(map[int64][]customer.Sanction)
+	for _, s := range active {
+		activeByCustomer[s.CustomerID] = append(activeByCustomer[s.CustomerID], s)
+	}
 
 	result := make([]CustomerWithReviewCount, len(customers))
 	for i, c := range customers {
+		c.ApplySanctions(activeByCustomer[c.ID], now)
 		result[i] = CustomerWithReviewCount{
-			Customer:    c,
-			ReviewCount: reviewCounts[c.ID],
+			Customer:      c,
+			ReviewCount:   reviewCounts[c.ID],
+			SanctionCount: sanctionCounts[c.ID],
 		}
 	}
 	return result, nil
 }
 
-// BanCustomer - カスタマーをBANする
-func (u *AdminCustomerUsecase) BanCustomer(id int64, reason string) (*customer.Customer, error) {
-	if reason == "" {
-		return nil, errors.New("reason is required")
+// GetCustomerSanctions - カスタマーの制裁履歴（新しい順、解除済みを含む）
+func (u *AdminCustomerUsecase) GetCustomerSanctions(id int64) ([]customer.Sanction, error) {
+	if _, err := u.findCustomer(id); err != nil {
+		return nil, err
 	}
+	return u.sanctionRepo.FindByCustomerID(id)
+}
 
-	c, err := u.customerRepo.FindByID(id)
+// BanCustomer - カスタマーをBANする
+func (u *AdminCustomerUsecase) BanCustomer(id int64, reason string, actor audit.Actor) (*customer.Customer, error) {
+	s, err := customer.NewBan(id, reason, &actor.AdminID, u.now())
 	if err != nil {
-		return nil, errors.New("customer not found")
+		return nil, err
 	}
 
-	c.Status = customer.StatusBanned
-	c.StatusReason = &reason
-	c.SuspendedUntil = nil
-
-	if err := u.customerRepo.Update(c); err != nil {
+	var c *customer.Customer
+	err = u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if c, err = u.lockCustomer(tx, id); err != nil {
+			return err
+		}
+		before := *c
+		if err := tx.Sanctions().Create(s); err != nil {
+			return err
+		}
+		return u.saveWithEvent(tx, c, audit.ActionCustomerBan, before, actor, event.CustomerBanned{CustomerID: c.ID, Reason: s.Reason})
+	})
+	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
 // SuspendCustomer - カスタマーを一時停止する
-func (u *AdminCustomerUsecase) SuspendCustomer(id int64, durationDays int, reason string) (*customer.Customer, error) {
-	if reason == "" {
-		return nil, errors.New("reason is required")
-	}
-	if durationDays <= 0 {
-		return nil, errors.New("duration must be positive")
+func (u *AdminCustomerUsecase) SuspendCustomer(id int64, durationDays int, reason string, actor audit.Actor) (*customer.Customer, error) {
+	s, err := customer.NewSuspension(id, durationDays, reason, &actor.AdminID, u.now())
+	if err != nil {
+		return nil, err
 	}
 
-	c, err := u.customerRepo.FindByID(id)
+	var c *customer.Customer
+	err = u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if c, err = u.lockCustomer(tx, id); err != nil {
+			return err
+		}
+		before := *c
+		if err := tx.Sanctions().Create(s); err != nil {
+			return err
+		}
+		return u.saveWithEvent(tx, c, audit.ActionCustomerSuspend, before, actor, event.CustomerSuspended{
+			CustomerID:     c.ID,
+			Reason:         s.Reason,
+			SuspendedUntil: s.EndsAt.Format(time.RFC3339),
+		})
+	})
 	if err != nil {
-		return nil, errors.New("customer not found")
+		return nil, err
 	}
+	return c, nil
+}
 
-	suspendedUntil := time.Now().AddDate(0, 0, durationDays)
-	c.Status = customer.StatusSuspended
-	c.StatusReason = &reason
-	c.SuspendedUntil = &suspendedUntil
-
-	if err := u.customerRepo.Update(c); err != nil {
+// UnbanCustomer - カスタマーの有効な制裁をすべて解除する（履歴は解除理由付きで残る）
+func (u *AdminCustomerUsecase) UnbanCustomer(id int64, liftReason string, actor audit.Actor) (*customer.Customer, error) {
+	var c *customer.Customer
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if c, err = u.lockCustomer(tx, id); err != nil {
+			return err
+		}
+		before := *c
+		sanctions, err := tx.Sanctions().FindByCustomerID(c.ID)
+		if err != nil {
+			return err
+		}
+		now := u.now()
+		for i := range sanctions {
+			if !sanctions[i].IsActiveAt(now) {
+				continue
+			}
+			sanctions[i].Lift(liftReason, &actor.AdminID, now)
+			if err := tx.Sanctions().Update(&sanctions[i]); err != nil {
+				return err
+			}
+		}
+		return u.saveWithEvent(tx, c, audit.ActionCustomerUnban, before, actor, event.CustomerUnbanned{CustomerID: c.ID})
+	})
+	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
-// UnbanCustomer - カスタマーのBAN/停止を解除する
-func (u *AdminCustomerUsecase) UnbanCustomer(id int64) (*customer.Customer, error) {
-	c, err := u.customerRepo.FindByID(id)
+// ExpireSuspensions - 一時停止期限を過ぎたカスタマーのステータスを制裁履歴から再計算する（定期ジョブ、1件失敗しても残りは続ける）
+func (u *AdminCustomerUsecase) ExpireSuspensions() (int, error) {
+	now := u.now()
+	customers, err := u.customerRepo.FindSuspensionExpired(now)
 	if err != nil {
-		return nil, errors.New("customer not found")
+		return 0, err
 	}
 
-	c.Status = customer.StatusActive
-	c.StatusReason = nil
-	c.SuspendedUntil = nil
+	expired := 0
+	var firstErr error
+	for i := range customers {
+		id := customers[i].ID
+		err := u.uow.Do(func(tx event.Tx) error {
+			c, err := tx.Customers().FindByIDForUpdate(id)
+			if err != nil {
+				return err
+			}
+			sanctions, err := tx.Sanctions().FindByCustomerID(c.ID)
+			if err != nil {
+				return err
+			}
+			c.ApplySanctions(sanctions, now)
+			return tx.Customers().Update(c)
+		})
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		expired++
+	}
+	return expired, firstErr
+}
+
+func (u *AdminCustomerUsecase) findCustomer(id int64) (*customer.Customer, error) {
+	c, err := u.customerRepo.FindByID(id)
+	if errors.Is(err, customer.ErrCustomerNotFound) {
+		return nil, ErrCustomerNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
 
-	if err := u.customerRepo.Update(c); err != nil {
+// lockCustomer - トランザクション内でカスタマーを行ロックして読み直す（本人のプロフィール更新・退会申請を上書きしない）
+func (u *AdminCustomerUsecase) lockCustomer(tx event.Tx, id int64) (*customer.Customer, error) {
+	c, err := tx.Customers().FindByIDForUpdate(id)
+	if errors.Is(err, customer.ErrCustomerNotFound) {
+		return nil, ErrCustomerNotFound
+	}
+	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
+
+// saveWithEvent - 制裁履歴からステータスを再計算し、カスタマーの更新・監査ログ・イベントを同じトランザクションで記録する
+func (u *AdminCustomerUsecase) saveWithEvent(tx event.Tx, c *customer.Customer, action string, before customer.Customer, actor audit.Actor, e event.Event) error {
+	sanctions, err := tx.Sanctions().FindByCustomerID(c.ID)
+	if err != nil {
+		return err
+	}
+	c.ApplySanctions(sanctions, u.now())
+	if err := tx.Customers().Update(c); err != nil {
+		return err
+	}
+	if err := recordAudit(tx.AuditLogs(), actor, action, audit.TargetCustomer, c.ID, before, c); err != nil {
+		return err
+	}
+	return tx.Outbox().Add(e)
+}
@@ -1,12 +1,81 @@
 package adminusecase
 
 import (
+	"backend/domain/admin"
+	"backend/domain/audit"
 	"backend/domain/customer"
+	"backend/domain/event"
+	"backend/domain/favorite"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"backend/domain/question"
+	"backend/domain/review"
+	"backend/domain/submission"
+	"backend/domain/suggestion"
 	"errors"
 	"testing"
 	"time"
 )
 
+// mockUnitOfWork - テスト用 UnitOfWork（コミットされたイベントを events、監査ログを audits に記録）
+type mockUnitOfWork struct {
+	customers    customer.CustomerRepository
+	sanctions    customer.SanctionRepository
+	reviews      review.ReviewRepository
+	replies      review.ReplyRepository
+	questions    question.QuestionRepository
+	answers      question.AnswerRepository
+	submissions  submission.SubmissionRepository
+	suggestions  suggestion.SuggestionRepository
+	products     product.ProductRepository
+	categories   product.CategoryRepository
+	stores       product.StoreRepository
+	offers       offer.OfferRepository
+	admins       admin.AdminRepository
+	roles        admin.RoleRepository
+	pending      []event.Event
+	events       []event.Event
+	pendingAudit *mockAuditLogRepository
+	audits       []audit.Entry
+	auditErr     error
+}
+
+func (m *mockUnitOfWork) Do(fn func(tx event.Tx) error) error {
+	m.pending = nil
+	m.pendingAudit = newMockAuditLogRepo()
+	m.pendingAudit.createErr = m.auditErr
+	if err := fn(m); err != nil {
+		return err
+	}
+	m.events = append(m.events, m.pending...)
+	m.audits = append(m.audits, m.pendingAudit.entries...)
+	return nil
+}
+
+func (m *mockUnitOfWork) Customers() customer.CustomerRepository       { return m.customers }
+func (m *mockUnitOfWork) Sanctions() customer.SanctionRepository       { return m.sanctions }
+func (m *mockUnitOfWork) Reviews() review.ReviewRepository             { return m.reviews }
+func (m *mockUnitOfWork) Replies() review.ReplyRepository              { return m.replies }
+func (m *mockUnitOfWork) Questions() question.QuestionRepository       { return m.questions }
+func (m *mockUnitOfWork) Answers() question.AnswerRepository           { return m.answers }
+func (m *mockUnitOfWork) Submissions() submission.SubmissionRepository { return m.submissions }
+func (m *mockUnitOfWork) Suggestions() suggestion.SuggestionRepository { return m.suggestions }
+func (m *mockUnitOfWork) Products() product.ProductRepository          { return m.products }
+func (m *mockUnitOfWork) Categories() product.CategoryRepository       { return m.categories }
+func (m *mockUnitOfWork) Stores() product.StoreRepository              { return m.stores }
+func (m *mockUnitOfWork) Offers() offer.OfferRepository                { return m.offers }
+func (m *mockUnitOfWork) Favorites() favorite.FavoriteRepository       { return nil }
+func (m *mockUnitOfWork) Collections() favorite.CollectionRepository   { return nil }
+func (m *mockUnitOfWork) Admins() admin.AdminRepository                { return m.admins }
+func (m *mockUnitOfWork) Roles() admin.RoleRepository                  { return m.roles }
+func (m *mockUnitOfWork) AuditLogs() audit.AuditLogRepository          { return m.pendingAudit }
+func (m *mockUnitOfWork) Outbox() event.Outbox                         { return m }
+
+func (m *mockUnitOfWork) Add(events ...event.Event) error {
+	m.pending = append(m.pending, events...)
+	return nil
+}
+
 // mockCustomerRepository - テスト用モックリポジトリ
 type mockCustomerRepository struct {
 	customers           map[int64]*customer.Customer
@@ -15,6 +84,7 @@ type mockCustomerRepository struct {
 	updateErr           error
 	reviewCounts        map[int64]int
 	reviewCountsErr     error
+	locked              []int64
 }
 
 func strPtr(s string) *string { return &s }
@@ -37,12 +107,17 @@ func (m *mockCustomerRepository) FindByID(id int64) (*customer.Customer, error)
 	}
 	c, ok := m.customers[id]
 	if !ok {
-		return nil, errors.New("not found")
+		return nil, customer.ErrCustomerNotFound
 	}
 	copy := *c
 	return &copy, nil
 }
 
+func (m *mockCustomerRepository) FindByIDForUpdate(id int64) (*customer.Customer, error) {
+	m.locked = append(m.locked, id)
+	return m.FindByID(id)
+}
+
 func (m *mockCustomerRepository) FindByGoogleID(_ string) (*customer.Customer, error) {
 	return nil, errors.New("not implemented")
 }
@@ -61,6 +136,16 @@ func (m *mockCustomerRepository) FindAllWithReviewCount() ([]customer.Customer,
 	return results, m.reviewCounts, nil
 }
 
+func (m *mockCustomerRepository) FindSuspensionExpired(now time.Time) ([]customer.Customer, error) {
+	var results []customer.Customer
+	for _, c := range m.customers {
+		if c.Status == customer.StatusSuspended && c.SuspendedUntil != nil && !c.SuspendedUntil.After(now) {
+			results = append(results, *c)
+		}
+	}
+	return results, nil
+}
+
 func (m *mockCustomerRepository) Create(c *customer.Customer) error {
 	m.customers[c.ID] = c
 	return nil
@@ -74,9 +159,73 @@ func (m *mockCustomerRepository) Update(c *customer.Customer) error {
 	return nil
 }
 
+// mockSanctionRepository - テスト用制裁履歴リポジトリ
+type mockSanctionRepository struct {
+	sanctions []customer.Sanction
+	nextID    int64
+}
+
+func newMockSanctionRepo() *mockSanctionRepository {
+	return &mockSanctionRepository{nextID: 1}
+}
+
+func (m *mockSanctionRepository) FindByCustomerID(customerID int64) ([]customer.Sanction, error) {
+	var result []customer.Sanction
+	for i := len(m.sanctions) - 1; i >= 0; i-- {
+		if m.sanctions[i].CustomerID == customerID {
+			result = append(result, m.sanctions[i])
+		}
+	}
+	return result, nil
+}
+
+func (m *mockSanctionRepository) FindActive(now time.Time) ([]customer.Sanction, error) {
+	var result []customer.Sanction
+	for _, s := range m.sanctions {
+		if s.IsActiveAt(now) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockSanctionRepository) CountByCustomer() (map[int64]int, error) {
+	counts := make(map[int64]int)
+	for _, s := range m.sanctions {
+		counts[s.CustomerID]++
+	}
+	return counts, nil
+}
+
+func (m *mockSanctionRepository) Create(s *customer.Sanction) error {
+	s.ID = m.nextID
+	m.nextID++
+	m.sanctions = append(m.sanctions, *s)
+	return nil
+}
+
+func (m *mockSanctionRepository) Update(s *customer.Sanction) error {
+	for i := range m.sanctions {
+		if m.sanctions[i].ID == s.ID {
+			m.sanctions[i] = *s
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
+// newTestCustomerUsecase - BAN済みのカスタマー2の制裁履歴を持つユースケースを生成
+func newTestCustomerUsecase(repo *mockCustomerRepository) (*AdminCustomerUsecase, *mockUnitOfWork, *mockSanctionRepository) {
+	sanctions := newMockSanctionRepo()
+	ban, _ := customer.NewBan(2, "spam", nil, time.Now().Add(-time.Hour))
+	_ = sanctions.Create(ban)
+	uow := &mockUnitOfWork{customers: repo, sanctions: sanctions}
+	return NewAdminCustomerUsecase(repo, sanctions, uow), uow, sanctions
+}
+
 func TestGetAllCustomers(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
 	results, err := uc.GetAllCustomers()
 	if err != nil {
@@ -95,7 +244,7 @@ func TestGetAllCustomers(t *testing.T) {
 func TestGetAllCustomers_Error(t *testing.T) {
 	repo := newMockCustomerRepo()
 	repo.findAllErr = errors.New("db error")
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
 	_, err := uc.GetAllCustomers()
 	if err == nil {
@@ -105,9 +254,9 @@ func TestGetAllCustomers_Error(t *testing.T) {
 
 func TestBanCustomer(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	c, err := uc.BanCustomer(1, "spam")
+	c, err := uc.BanCustomer(1, "spam", testActor)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -120,13 +269,16 @@ func TestBanCustomer(t *testing.T) {
 	if c.SuspendedUntil != nil {
 		t.Error("expected SuspendedUntil to be nil")
 	}
+	if len(repo.locked) != 1 || repo.locked[0] != 1 {
+		t.Errorf("expected the customer to be re-read with a row lock, got %v", repo.locked)
+	}
 }
 
 func TestBanCustomer_EmptyReason(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.BanCustomer(1, "")
+	_, err := uc.BanCustomer(1, "", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -134,20 +286,20 @@ func TestBanCustomer_EmptyReason(t *testing.T) {
 
 func TestBanCustomer_NotFound(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.BanCustomer(999, "spam")
-	if err == nil {
-		t.Fatal("expected error, got nil")
+	_, err := uc.BanCustomer(999, "spam", testActor)
+	if !errors.Is(err, ErrCustomerNotFound) {
+		t.Fatalf("expected ErrCustomerNotFound, got %v", err)
 	}
 }
 
 func TestBanCustomer_UpdateError(t *testing.T) {
 	repo := newMockCustomerRepo()
 	repo.updateErr = errors.New("update failed")
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.BanCustomer(1, "spam")
+	_, err := uc.BanCustomer(1, "spam", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -155,9 +307,9 @@ func TestBanCustomer_UpdateError(t *testing.T) {
 
 func TestSuspendCustomer(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	c, err := uc.SuspendCustomer(1, 7, "warning")
+	c, err := uc.SuspendCustomer(1, 7, "warning", testActor)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -179,9 +331,9 @@ func TestSuspendCustomer(t *testing.T) {
 
 func TestSuspendCustomer_EmptyReason(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.SuspendCustomer(1, 7, "")
+	_, err := uc.SuspendCustomer(1, 7, "", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -189,9 +341,9 @@ func TestSuspendCustomer_EmptyReason(t *testing.T) {
 
 func TestSuspendCustomer_InvalidDuration(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.SuspendCustomer(1, 0, "test")
+	_, err := uc.SuspendCustomer(1, 0, "test", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -199,9 +351,9 @@ func TestSuspendCustomer_InvalidDuration(t *testing.T) {
 
 func TestSuspendCustomer_NotFound(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.SuspendCustomer(999, 7, "warning")
+	_, err := uc.SuspendCustomer(999, 7, "warning", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -209,9 +361,9 @@ func TestSuspendCustomer_NotFound(t *testing.T) {
 
 func TestUnbanCustomer(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	c, err := uc.UnbanCustomer(2)
+	c, err := uc.UnbanCustomer(2, "", testActor)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -228,9 +380,9 @@ func TestUnbanCustomer(t *testing.T) {
 
 func TestUnbanCustomer_NotFound(t *testing.T) {
 	repo := newMockCustomerRepo()
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.UnbanCustomer(999)
+	_, err := uc.UnbanCustomer(999, "", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -239,10 +391,180 @@ func TestUnbanCustomer_NotFound(t *testing.T) {
 func TestUnbanCustomer_UpdateError(t *testing.T) {
 	repo := newMockCustomerRepo()
 	repo.updateErr = errors.New("update failed")
-	uc := NewAdminCustomerUsecase(repo)
+	uc, _, _ := newTestCustomerUsecase(repo)
 
-	_, err := uc.UnbanCustomer(2)
+	_, err := uc.UnbanCustomer(2, "", testActor)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestCustomerSanctions_RecordEvents(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, uow, _ := newTestCustomerUsecase(repo)
+
+	if _, err := uc.BanCustomer(1, "spam", testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.SuspendCustomer(1, 7, "abuse", testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.UnbanCustomer(1, "", testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{event.NameCustomerBanned, event.NameCustomerSuspended, event.NameCustomerUnbanned}
+	if len(uow.events) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), uow.events)
+	}
+	for i, name := range want {
+		if uow.events[i].EventName() != name {
+			t.Errorf("event %d: expected %s, got %s", i, name, uow.events[i].EventName())
+		}
+	}
+	if banned := uow.events[0].(event.CustomerBanned); banned.CustomerID != 1 || banned.Reason != "spam" {
+		t.Errorf("unexpected ban event: %+v", banned)
+	}
+
+	// 更新に失敗した場合はイベントも記録されない
+	repo.updateErr = errors.New("update failed")
+	uow.events = nil
+	if _, err := uc.BanCustomer(1, "spam", testActor); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(uow.events) != 0 {
+		t.Errorf("expected no events after rollback, got %+v", uow.events)
+	}
+}
+
+func TestGetAllCustomers_StatusFromSanctions(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, _, sanctions := newTestCustomerUsecase(repo)
+	now := time.Now()
+
+	// 期限切れの停止は履歴に残るがステータスには影響しない
+	expired, _ := customer.NewSuspension(1, 3, "old warning", nil, now.AddDate(0, 0, -10))
+	_ = sanctions.Create(expired)
+
+	results, err := uc.GetAllCustomers()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, r := range results {
+		switch r.ID {
+		case 1:
+			if r.Status != customer.StatusActive || r.SanctionCount != 1 {
+				t.Errorf("expected active with 1 past sanction, got status %d count %d", r.Status, r.SanctionCount)
+			}
+		case 2:
+			if r.Status != customer.StatusBanned || r.SanctionCount != 1 {
+				t.Errorf("expected banned with 1 sanction, got status %d count %d", r.Status, r.SanctionCount)
+			}
+		}
+	}
+}
+
+func TestGetCustomerSanctions_RepeatOffender(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, _, _ := newTestCustomerUsecase(repo)
+	actor := testActor
+
+	if _, err := uc.SuspendCustomer(1, 3, "spam", actor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.UnbanCustomer(1, "appeal accepted", actor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.BanCustomer(1, "spam again", actor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	history, err := uc.GetCustomerSanctions(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 sanctions, got %d", len(history))
+	}
+	latest, first := history[0], history[1]
+	if latest.Type != customer.SanctionBan || latest.LiftedAt != nil {
+		t.Errorf("expected newest to be active ban, got %+v", latest)
+	}
+	if latest.IssuedByAdminID == nil || *latest.IssuedByAdminID != actor.AdminID {
+		t.Errorf("expected issuer %d, got %v", actor.AdminID, latest.IssuedByAdminID)
+	}
+	if first.Type != customer.SanctionSuspension || first.LiftedAt == nil {
+		t.Errorf("expected lifted suspension, got %+v", first)
+	}
+	if first.LiftReason == nil || *first.LiftReason != "appeal accepted" {
+		t.Errorf("expected lift reason, got %v", first.LiftReason)
+	}
+
+	if _, err := uc.GetCustomerSanctions(999); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestSuspendCustomer_BanTakesPrecedence(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, _, _ := newTestCustomerUsecase(repo)
+
+	c, err := uc.SuspendCustomer(2, 7, "more spam", testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Status != customer.StatusBanned || c.SuspendedUntil != nil {
+		t.Errorf("expected ban to remain in effect, got status %d until %v", c.Status, c.SuspendedUntil)
+	}
+	if c.StatusReason == nil || *c.StatusReason != "spam" {
+		t.Errorf("expected ban reason, got %v", c.StatusReason)
+	}
+}
+
+func TestExpireSuspensions(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, _, _ := newTestCustomerUsecase(repo)
+	now := time.Now()
+	uc.now = func() time.Time { return now }
+
+	if _, err := uc.SuspendCustomer(1, 3, "warning", testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n, err := uc.ExpireSuspensions(); err != nil || n != 0 {
+		t.Fatalf("expected no expired suspensions yet, got %d (err %v)", n, err)
+	}
+
+	uc.now = func() time.Time { return now.AddDate(0, 0, 4) }
+	n, err := uc.ExpireSuspensions()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 expired suspension, got %d", n)
+	}
+	c := repo.customers[1]
+	if c.Status != customer.StatusActive || c.StatusReason != nil || c.SuspendedUntil != nil {
+		t.Errorf("expected customer 1 to be active again, got status %d reason %v until %v", c.Status, c.StatusReason, c.SuspendedUntil)
+	}
+	if repo.customers[2].Status != customer.StatusBanned {
+		t.Errorf("expected customer 2 to remain banned, got status %d", repo.customers[2].Status)
+	}
+}
+
+func TestCustomerLookupErrors(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc, _, _ := newTestCustomerUsecase(repo)
+
+	if _, err := uc.GetCustomerSanctions(999); !errors.Is(err, ErrCustomerNotFound) {
+		t.Errorf("expected ErrCustomerNotFound, got %v", err)
+	}
+
+	dbErr := errors.New("connection refused")
+	repo.findByIDErr = dbErr
+	if _, err := uc.GetCustomerSanctions(1); !errors.Is(err, dbErr) {
+		t.Errorf("expected the lookup error to be returned, got %v", err)
+	}
+	if _, err := uc.BanCustomer(1, "spam", testActor); !errors.Is(err, dbErr) {
+		t.Errorf("expected the lookup error to be returned, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package adminusecase
+
+import (
+	"backend/domain/linkcheck"
+	"backend/domain/product"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LinkCheckBatchSize - 1回の実行でチェックするリンクの上限
+const LinkCheckBatchSize = 200
+
+// AdminLinkCheckUsecase - 商品URLの死活チェックユースケース
+type AdminLinkCheckUsecase struct {
+	linkCheckRepo linkcheck.LinkCheckRepository
+	productRepo   product.ProductRepository
+	checker       linkcheck.Checker
+	now           func() time.Time
+}
+
+// LinkCheckSummary - 定期チェックの実行結果
+type LinkCheckSummary struct {
+	Checked int
+	Failing int
+}
+
+// NewAdminLinkCheckUsecase - 商品URLの死活チェックユースケースの生成
+func NewAdminLinkCheckUsecase(linkCheckRepo linkcheck.LinkCheckRepository, productRepo product.ProductRepository, checker linkcheck.Checker) *AdminLinkCheckUsecase {
+	return &AdminLinkCheckUsecase{
+		linkCheckRepo: linkCheckRepo,
+		productRepo:   productRepo,
+		checker:       checker,
+		now:           time.Now,
+	}
+}
+
+// CheckDueLinks - チェック時刻に達した商品URLを確認して結果を記録（定期実行）
+func (u *AdminLinkCheckUsecase) CheckDueLinks(ctx context.Context) (*LinkCheckSummary, error) {
+	products, err := u.productRepo.FindAll(product.ProductFilter{})
+	if err != nil {
+		return nil, err
+	}
+	existing, err := u.linkCheckRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make(map[string]*linkcheck.LinkCheck, len(existing))
+	for i := range existing {
+		checks[linkCheckKey(existing[i].ProductID, existing[i].Target)] = &existing[i]
+	}
+
+	now := u.now()
+	var due []*linkcheck.LinkCheck
+	for _, p := range products {
+		for target, url := range productLinks(p) {
+			key := linkCheckKey(p.ID, target)
+			check, ok := checks[key]
+			delete(checks, key)
+			if !ok {
+				check = &linkcheck.LinkCheck{ProductID: p.ID, Target: target}
+			}
+			// URLが変わったら履歴をリセットして即時チェック
+			if check.URL != url {
+				*check = linkcheck.LinkCheck{ID: check.ID, ProductID: p.ID, Target: target, URL: url}
+			}
+			if check.IsDue(now) {
+				due = append(due, check)
+			}
+		}
+	}
+
+	// 商品やストアリンクが削除されたURLの結果は破棄
+	for _, stale := range checks {
+		if err := u.linkCheckRepo.Delete(stale.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NextCheckAt.Before(due[j].NextCheckAt) })
+	if len(due) > LinkCheckBatchSize {
+		due = due[:LinkCheckBatchSize]
+	}
+
+	summary := &LinkCheckSummary{}
+	for _, check := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		result := u.checker.Check(ctx, check.URL)
+		if ctx.Err() != nil {
+			// 停止による中断はリンクの失敗として記録しない
+			break
+		}
+		check.Record(result, u.now())
+		if err := u.linkCheckRepo.Save(check); err != nil {
+			return summary, err
+		}
+		summary.Checked++
+		if check.Status != linkcheck.StatusOK {
+			summary.Failing++
+		}
+	}
+	return summary, nil
+}
+
+// GetBrokenLinks - 正常でないリンク一覧取得
+func (u *AdminLinkCheckUsecase) GetBrokenLinks() ([]linkcheck.LinkCheck, error) {
+	return u.linkCheckRepo.FindFailing()
+}
+
+// productLinks - 商品のチェック対象URL（Target → URL）
+func productLinks(p product.Product) map[string]string {
+	links := map[string]string{linkcheck.TargetImage: p.ImageURL}
+	if p.AffiliateURL != nil && *p.AffiliateURL != "" {
+		links[linkcheck.TargetAffiliate] = *p.AffiliateURL
+	}
+	for _, link := range p.StoreLinks {
+		if link.Store != nil {
+			links[link.Store.Code] = link.URL
+		}
+	}
+	return links
+}
+
+func linkCheckKey(productID int64, target string) string {
+	return fmt.Sprintf("%d:%s", productID, target)
+}
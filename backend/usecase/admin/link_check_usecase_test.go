@@ -0,0 +1,237 @@
+package adminusecase
+
+import (
+	"backend/domain/linkcheck"
+	"backend/domain/product"
+	httpchecker "backend/infrastructure/linkcheck"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockLinkCheckRepository - テスト用モックリポジトリ
+type mockLinkCheckRepository struct {
+	checks  map[int64]linkcheck.LinkCheck
+	nextID  int64
+	deleted []int64
+}
+
+func newMockLinkCheckRepo() *mockLinkCheckRepository {
+	return &mockLinkCheckRepository{checks: map[int64]linkcheck.LinkCheck{}}
+}
+
+func (m *mockLinkCheckRepository) FindAll() ([]linkcheck.LinkCheck, error) {
+	var result []linkcheck.LinkCheck
+	for _, c := range m.checks {
+		result = append(result, c)
+	}
+	return result, nil
+}
+func (m *mockLinkCheckRepository) FindFailing() ([]linkcheck.LinkCheck, error) {
+	var result []linkcheck.LinkCheck
+	for _, c := range m.checks {
+		if c.Status != linkcheck.StatusOK {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+func (m *mockLinkCheckRepository) Save(c *linkcheck.LinkCheck) error {
+	if c.ID == 0 {
+		m.nextID++
+		c.ID = m.nextID
+	}
+	m.checks[c.ID] = *c
+	return nil
+}
+func (m *mockLinkCheckRepository) Delete(id int64) error {
+	delete(m.checks, id)
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *mockLinkCheckRepository) byTarget(target string) linkcheck.LinkCheck {
+	for _, c := range m.checks {
+		if c.Target == target {
+			return c
+		}
+	}
+	return linkcheck.LinkCheck{}
+}
+
+// newLinkTestServer - 画像は正常、ストアページは404、一時エラー用パスは503を返すテストサーバー
+func newLinkTestServer(t *testing.T) (*httptest.Server, *sync.Map) {
+	hits := &sync.Map{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count, _ := hits.LoadOrStore(r.URL.Path, new(int))
+		*count.(*int)++
+		switch r.URL.Path {
+		case "/image.jpg":
+			w.WriteHeader(http.StatusOK)
+		case "/head-not-allowed":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/busy":
+			w.Header().Set("Retry-After", "7200")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, hits
+}
+
+func linkTestProduct(server *httptest.Server, affiliatePath string) product.Product {
+	affiliate := server.URL + affiliatePath
+	return product.Product{
+		ID:           1,
+		ImageURL:     server.URL + "/image.jpg",
+		AffiliateURL: &affiliate,
+		StoreLinks: []product.StoreLink{
+			{ProductID: 1, StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon"}, URL: server.URL + "/dp/gone"},
+		},
+	}
+}
+
+func TestCheckDueLinks_RecordsStatus(t *testing.T) {
+	server, hits := newLinkTestServer(t)
+	p := linkTestProduct(server, "/head-not-allowed")
+	productRepo := &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			return []product.Product{p}, nil
+		},
+	}
+	repo := newMockLinkCheckRepo()
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	uc := NewAdminLinkCheckUsecase(repo, productRepo, httpchecker.NewHTTPChecker(time.Second, 0))
+	uc.now = func() time.Time { return now }
+
+	summary, err := uc.CheckDueLinks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Checked != 3 || summary.Failing != 1 {
+		t.Errorf("expected 3 checked / 1 failing, got %+v", summary)
+	}
+
+	if c := repo.byTarget(linkcheck.TargetImage); c.Status != linkcheck.StatusOK || !c.NextCheckAt.Equal(now.Add(linkcheck.CheckInterval)) {
+		t.Errorf("expected image to be ok and rechecked in 24h, got %+v", c)
+	}
+	// HEAD非対応のサーバーはGETで再確認する
+	if c := repo.byTarget(linkcheck.TargetAffiliate); c.Status != linkcheck.StatusOK {
+		t.Errorf("expected affiliate link to be ok via GET fallback, got %+v", c)
+	}
+	store := repo.byTarget("amazon")
+	if store.Status != linkcheck.StatusBroken || store.HTTPStatus == nil || *store.HTTPStatus != http.StatusNotFound {
+		t.Errorf("expected store link to be broken with 404, got %+v", store)
+	}
+	if !store.NextCheckAt.Equal(now.Add(linkcheck.RetryBaseDelay)) {
+		t.Errorf("expected first retry after %v, got %v", linkcheck.RetryBaseDelay, store.NextCheckAt)
+	}
+
+	// チェック時刻前の再実行ではリクエストしない
+	count, _ := hits.Load("/dp/gone")
+	before := *count.(*int)
+	if summary, _ := uc.CheckDueLinks(context.Background()); summary.Checked != 0 {
+		t.Errorf("expected nothing to be due, got %+v", summary)
+	}
+	if *count.(*int) != before {
+		t.Error("expected no request before next check time")
+	}
+
+	// 失敗が続くと再チェック間隔が倍になる
+	now = now.Add(linkcheck.RetryBaseDelay)
+	if _, err := uc.CheckDueLinks(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store = repo.byTarget("amazon")
+	if store.ConsecutiveFailures != 2 || !store.NextCheckAt.Equal(now.Add(2*linkcheck.RetryBaseDelay)) {
+		t.Errorf("expected backoff to double, got failures=%d next=%v", store.ConsecutiveFailures, store.NextCheckAt)
+	}
+
+	failing, _ := uc.GetBrokenLinks()
+	if len(failing) != 1 || failing[0].Target != "amazon" {
+		t.Errorf("expected only the store link to be listed, got %+v", failing)
+	}
+}
+
+func TestCheckDueLinks_RespectsRetryAfter(t *testing.T) {
+	server, _ := newLinkTestServer(t)
+	p := linkTestProduct(server, "/busy")
+	productRepo := &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			return []product.Product{p}, nil
+		},
+	}
+	repo := newMockLinkCheckRepo()
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	uc := NewAdminLinkCheckUsecase(repo, productRepo, httpchecker.NewHTTPChecker(time.Second, 0))
+	uc.now = func() time.Time { return now }
+
+	if _, err := uc.CheckDueLinks(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 503 はリンク切れではなく一時的な到達不能として扱い、Retry-After まで待つ
+	c := repo.byTarget(linkcheck.TargetAffiliate)
+	if c.Status != linkcheck.StatusUnreachable {
+		t.Errorf("expected unreachable, got %s", c.Status)
+	}
+	if !c.NextCheckAt.Equal(now.Add(2 * time.Hour)) {
+		t.Errorf("expected next check after Retry-After (2h), got %v", c.NextCheckAt)
+	}
+}
+
+func TestCheckDueLinks_ResetsChangedAndRemovesStaleLinks(t *testing.T) {
+	server, _ := newLinkTestServer(t)
+	p := linkTestProduct(server, "/head-not-allowed")
+	productRepo := &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			return []product.Product{p}, nil
+		},
+	}
+	repo := newMockLinkCheckRepo()
+	uc := NewAdminLinkCheckUsecase(repo, productRepo, httpchecker.NewHTTPChecker(time.Second, 0))
+	if _, err := uc.CheckDueLinks(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// ストアリンクを修正し、アフィリエイトURLを削除
+	p.StoreLinks[0].URL = server.URL + "/image.jpg"
+	p.AffiliateURL = nil
+	summary, err := uc.CheckDueLinks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Checked != 1 {
+		t.Errorf("expected only the changed link to be rechecked, got %+v", summary)
+	}
+	if c := repo.byTarget("amazon"); c.Status != linkcheck.StatusOK || c.ConsecutiveFailures != 0 {
+		t.Errorf("expected fixed store link to be ok, got %+v", c)
+	}
+	if len(repo.deleted) != 1 {
+		t.Errorf("expected removed affiliate link check to be deleted, got %v", repo.deleted)
+	}
+}
+
+func TestHTTPChecker_RateLimitsPerHost(t *testing.T) {
+	server, _ := newLinkTestServer(t)
+	checker := httpchecker.NewHTTPChecker(time.Second, 50*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if r := checker.Check(context.Background(), server.URL+"/image.jpg"); r.HTTPStatus != http.StatusOK {
+			t.Fatalf("unexpected result: %+v", r)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected requests to the same host to be spaced out, took %v", elapsed)
+	}
+}
@@ -0,0 +1,216 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVの必須列
+var offerCSVColumns = []string{"product_id", "store", "price", "currency", "availability"}
+
+// AdminOfferUsecase - 管理者向けストア価格ユースケース
+type AdminOfferUsecase struct {
+	offerRepo   offer.OfferRepository
+	productRepo product.ProductRepository
+	uow         event.UnitOfWork
+	now         func() time.Time
+}
+
+// UpsertOfferInput - ストア価格登録の入力
+type UpsertOfferInput struct {
+	ProductID    int64
+	Store        string
+	Price        string
+	Currency     string
+	Availability string
+	CheckedAt    *time.Time
+}
+
+// OfferImportError - CSV取込のエラー行
+type OfferImportError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// OfferImportResult - CSV取込結果
+type OfferImportResult struct {
+	Imported int                `json:"imported"`
+	Errors   []OfferImportError `json:"errors"`
+}
+
+// NewAdminOfferUsecase - 管理者向けストア価格ユースケースの生成
+func NewAdminOfferUsecase(offerRepo offer.OfferRepository, productRepo product.ProductRepository, uow event.UnitOfWork) *AdminOfferUsecase {
+	return &AdminOfferUsecase{
+		offerRepo:   offerRepo,
+		productRepo: productRepo,
+		uow:         uow,
+		now:         time.Now,
+	}
+}
+
+// UpsertOffer - ストア価格の登録・更新（価格または在庫が変わった場合のみ履歴に記録）
+func (u *AdminOfferUsecase) UpsertOffer(input UpsertOfferInput, actor audit.Actor) (*offer.Offer, error) {
+	store, err := product.NewStoreCode(input.Store)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	price, err := offer.ParseMoney(input.Price, input.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("price: %w", err)
+	}
+	availability, err := offer.NewAvailability(input.Availability)
+	if err != nil {
+		return nil, fmt.Errorf("availability: %w", err)
+	}
+
+	p, err := u.productRepo.FindByID(input.ProductID)
+	if errors.Is(err, product.ErrProductNotFound) {
+		return nil, fmt.Errorf("product ID %d not found", input.ProductID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.StoreURL(store) == nil {
+		return nil, fmt.Errorf("product ID %d has no %s link", input.ProductID, store)
+	}
+
+	checkedAt := u.now()
+	if input.CheckedAt != nil {
+		checkedAt = *input.CheckedAt
+	}
+
+	var o *offer.Offer
+	err = u.uow.Do(func(tx event.Tx) error {
+		var err error
+		o, err = tx.Offers().FindByProductIDAndStoreForUpdate(p.ID, store)
+		if errors.Is(err, offer.ErrOfferNotFound) {
+			o = &offer.Offer{ProductID: p.ID, Store: store}
+		} else if err != nil {
+			return err
+		}
+
+		prev := *o
+		var history *offer.PricePoint
+		if o.Apply(price, availability, checkedAt) {
+			history = offer.NewPricePoint(o)
+		}
+		if err := tx.Offers().Save(o, history); err != nil {
+			return err
+		}
+		var before any
+		if prev.ID != 0 {
+			before = prev
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionOfferUpsert, audit.TargetOffer, o.ID, before, o); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(offerChangeEvents(prev, o)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// offerChangeEvents - 値下がり・再入荷のイベント
+func offerChangeEvents(prev offer.Offer, o *offer.Offer) []event.Event {
+	var events []event.Event
+	if o.IsPriceDropFrom(prev) {
+		events = append(events, event.OfferPriceDropped{
+			ProductID: o.ProductID,
+			Store:     o.Store,
+			OldAmount: prev.PriceAmount,
+			NewAmount: o.PriceAmount,
+			Currency:  o.Currency,
+		})
+	}
+	if o.IsBackInStockFrom(prev) {
+		events = append(events, event.OfferBackInStock{ProductID: o.ProductID, Store: o.Store})
+	}
+	return events
+}
+
+// ImportCSV - CSVからストア価格を一括登録（不正な行はスキップしてエラーとして返す、監査ログは行ごと）
+func (u *AdminOfferUsecase) ImportCSV(r io.Reader, actor audit.Actor) (*OfferImportResult, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("csv header is required")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range offerCSVColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv column %q is required", name)
+		}
+	}
+
+	result := &OfferImportResult{Errors: []OfferImportError{}}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, OfferImportError{Line: line, Message: err.Error()})
+			continue
+		}
+
+		input, err := offerInputFromRecord(record, columns)
+		if err == nil {
+			_, err = u.UpsertOffer(input, actor)
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, OfferImportError{Line: line, Message: err.Error()})
+			continue
+		}
+		result.Imported++
+	}
+	return result, nil
+}
+
+// offerInputFromRecord - CSVの1行を入力に変換
+func offerInputFromRecord(record []string, columns map[string]int) (UpsertOfferInput, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	productID, err := strconv.ParseInt(field("product_id"), 10, 64)
+	if err != nil {
+		return UpsertOfferInput{}, errors.New("product_id: invalid product ID")
+	}
+
+	input := UpsertOfferInput{
+		ProductID:    productID,
+		Store:        field("store"),
+		Price:        field("price"),
+		Currency:     field("currency"),
+		Availability: field("availability"),
+	}
+	if checked := field("checked_at"); checked != "" {
+		t, err := time.Parse(time.RFC3339, checked)
+		if err != nil {
+			return UpsertOfferInput{}, errors.New("checked_at: must be RFC3339")
+		}
+		input.CheckedAt = &t
+	}
+	return input, nil
+}
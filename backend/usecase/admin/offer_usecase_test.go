@@ -0,0 +1,266 @@
+package adminusecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mockOfferRepository - テスト用モックリポジトリ
+type mockOfferRepository struct {
+	offers  map[string]*offer.Offer
+	history []offer.PricePoint
+	nextID  int64
+	findErr error
+}
+
+func newMockOfferRepo() *mockOfferRepository {
+	return &mockOfferRepository{offers: map[string]*offer.Offer{}}
+}
+
+func offerKey(productID int64, store string) string {
+	return fmt.Sprintf("%d:%s", productID, store)
+}
+
+func (m *mockOfferRepository) FindByProductID(productID int64) ([]offer.Offer, error) {
+	var result []offer.Offer
+	for _, o := range m.offers {
+		if o.ProductID == productID {
+			result = append(result, *o)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockOfferRepository) FindByProductIDAndStore(productID int64, store string) (*offer.Offer, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	o, ok := m.offers[offerKey(productID, store)]
+	if !ok {
+		return nil, offer.ErrOfferNotFound
+	}
+	copy := *o
+	return &copy, nil
+}
+
+func (m *mockOfferRepository) FindByProductIDAndStoreForUpdate(productID int64, store string) (*offer.Offer, error) {
+	return m.FindByProductIDAndStore(productID, store)
+}
+
+func (m *mockOfferRepository) Save(o *offer.Offer, history *offer.PricePoint) error {
+	if o.ID == 0 {
+		m.nextID++
+		o.ID = m.nextID
+	}
+	copy := *o
+	m.offers[offerKey(o.ProductID, o.Store)] = &copy
+	if history != nil {
+		history.OfferID = o.ID
+		m.history = append(m.history, *history)
+	}
+	return nil
+}
+
+func (m *mockOfferRepository) FindHistory(productID int64, since time.Time) ([]offer.PricePoint, error) {
+	return m.history, nil
+}
+
+func productWithStoreLinks() *mockProductRepository {
+	return &mockProductRepository{
+		findByIDFn: func(id int64) (*product.Product, error) {
+			if id != 1 {
+				return nil, product.ErrProductNotFound
+			}
+			return &product.Product{
+				ID: 1,
+				StoreLinks: []product.StoreLink{
+					{ProductID: 1, StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon"}, URL: "https://www.amazon.co.jp/dp/B000000001"},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestUpsertOffer_RecordsHistoryOnlyOnChange(t *testing.T) {
+	offerRepo := newMockOfferRepo()
+	uc := newTestOfferUsecase(offerRepo)
+
+	input := UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "1280", Currency: "JPY", Availability: "in_stock"}
+
+	o, err := uc.UpsertOffer(input, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if o.PriceAmount != 1280 || o.Currency != "JPY" {
+		t.Errorf("expected JPY 1280, got %s %d", o.Currency, o.PriceAmount)
+	}
+
+	// 同じ価格での再チェックは履歴を増やさない
+	if _, err := uc.UpsertOffer(input, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(offerRepo.history) != 1 {
+		t.Fatalf("expected 1 history entry, got %d", len(offerRepo.history))
+	}
+
+	input.Price = "1180"
+	if _, err := uc.UpsertOffer(input, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(offerRepo.history) != 2 {
+		t.Errorf("expected 2 history entries after price change, got %d", len(offerRepo.history))
+	}
+}
+
+func TestUpsertOffer_LookupErrorIsReturned(t *testing.T) {
+	offerRepo := newMockOfferRepo()
+	offerRepo.findErr = errors.New("connection refused")
+	uc := newTestOfferUsecase(offerRepo)
+
+	_, err := uc.UpsertOffer(UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "1280", Currency: "JPY"}, testActor)
+	if !errors.Is(err, offerRepo.findErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+	if len(offerRepo.offers) != 0 {
+		t.Error("expected no offer to be created")
+	}
+}
+
+func TestUpsertOffer_ProductLookupErrorIsReturned(t *testing.T) {
+	offerRepo := newMockOfferRepo()
+	lookupErr := errors.New("connection refused")
+	products := &mockProductRepository{findByIDFn: func(id int64) (*product.Product, error) { return nil, lookupErr }}
+	uc := NewAdminOfferUsecase(offerRepo, products, &mockUnitOfWork{offers: offerRepo})
+
+	if _, err := uc.UpsertOffer(UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "1280", Currency: "JPY"}, testActor); !errors.Is(err, lookupErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+	if _, err := newTestOfferUsecase(offerRepo).UpsertOffer(UpsertOfferInput{ProductID: 2, Store: "amazon", Price: "1280", Currency: "JPY"}, testActor); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestUpsertOffer_ValidationErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   UpsertOfferInput
+		wantErr string
+	}{
+		{"不正なストア", UpsertOfferInput{ProductID: 1, Store: "e bay!", Price: "100", Currency: "JPY"}, "store"},
+		{"不正な通貨", UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "100", Currency: "GBP"}, "currency"},
+		{"負の価格", UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "-1", Currency: "JPY"}, "negative"},
+		{"不正な在庫状況", UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "100", Currency: "JPY", Availability: "soon"}, "availability"},
+		{"存在しない商品", UpsertOfferInput{ProductID: 2, Store: "amazon", Price: "100", Currency: "JPY"}, "not found"},
+		{"リンク未登録のストア", UpsertOfferInput{ProductID: 1, Store: "rakuten", Price: "100", Currency: "JPY"}, "has no rakuten link"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestOfferUsecase(newMockOfferRepo())
+
+			_, err := uc.UpsertOffer(tc.input, testActor)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	offerRepo := newMockOfferRepo()
+	uc := newTestOfferUsecase(offerRepo)
+
+	csv := strings.Join([]string{
+		"product_id,store,price,currency,availability,checked_at",
+		"1,amazon,1280,JPY,in_stock,2025-06-01T09:00:00Z",
+		"1,amazon,12.99,USD,in_stock,",
+		"abc,amazon,100,JPY,in_stock,",
+		"1,rakuten,100,JPY,in_stock,",
+	}, "\n")
+
+	result, err := uc.ImportCSV(strings.NewReader(csv), testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Imported != 2 {
+		t.Errorf("expected 2 imported rows, got %d", result.Imported)
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("expected 2 row errors, got %v", result.Errors)
+	}
+	if result.Errors[0].Line != 4 || result.Errors[1].Line != 5 {
+		t.Errorf("expected errors on lines 4 and 5, got %v", result.Errors)
+	}
+
+	o, _ := offerRepo.FindByProductIDAndStore(1, "amazon")
+	if o.Currency != "USD" || o.PriceAmount != 1299 {
+		t.Errorf("expected USD 1299 cents, got %s %d", o.Currency, o.PriceAmount)
+	}
+}
+
+func TestImportCSV_MissingColumn(t *testing.T) {
+	uc := newTestOfferUsecase(newMockOfferRepo())
+
+	_, err := uc.ImportCSV(strings.NewReader("product_id,store,price\n1,amazon,100\n"), testActor)
+	if err == nil {
+		t.Fatal("expected error for missing columns")
+	}
+}
+
+func TestUpsertOffer_RecordsPriceDropAndBackInStockEvents(t *testing.T) {
+	offerRepo := newMockOfferRepo()
+	uow := &mockUnitOfWork{offers: offerRepo}
+	uc := NewAdminOfferUsecase(offerRepo, productWithStoreLinks(), uow)
+
+	steps := []struct {
+		price        string
+		currency     string
+		availability string
+		wantEvent    string
+	}{
+		{"1280", "JPY", "out_of_stock", ""}, // 新規登録はイベントなし
+		{"1380", "JPY", "out_of_stock", ""}, // 値上がり
+		{"1380", "JPY", "in_stock", event.NameOfferBackInStock},
+		{"1180", "JPY", "in_stock", event.NameOfferPriceDropped},
+		{"9.99", "USD", "in_stock", ""}, // 通貨変更は比較しない
+	}
+
+	for i, step := range steps {
+		uow.events = nil
+		_, err := uc.UpsertOffer(UpsertOfferInput{ProductID: 1, Store: "amazon", Price: step.price, Currency: step.currency, Availability: step.availability}, testActor)
+		if err != nil {
+			t.Fatalf("step %d: unexpected error: %v", i, err)
+		}
+		if step.wantEvent == "" {
+			if len(uow.events) != 0 {
+				t.Errorf("step %d: expected no events, got %+v", i, uow.events)
+			}
+			continue
+		}
+		if len(uow.events) != 1 || uow.events[0].EventName() != step.wantEvent {
+			t.Errorf("step %d: expected %s event, got %+v", i, step.wantEvent, uow.events)
+		}
+	}
+
+	uow.events = nil
+	if _, err := uc.UpsertOffer(UpsertOfferInput{ProductID: 1, Store: "amazon", Price: "8.50", Currency: "USD", Availability: "in_stock"}, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(uow.events) != 1 {
+		t.Fatalf("expected price drop event, got %+v", uow.events)
+	}
+	if e := uow.events[0].(event.OfferPriceDropped); e.OldAmount != 999 || e.NewAmount != 850 || e.Currency != "USD" {
+		t.Errorf("unexpected price drop event: %+v", e)
+	}
+}
+
+// newTestOfferUsecase - 商品ID 1 に amazon リンクを持つテスト用ユースケース
+func newTestOfferUsecase(offerRepo *mockOfferRepository) *AdminOfferUsecase {
+	return NewAdminOfferUsecase(offerRepo, productWithStoreLinks(), &mockUnitOfWork{offers: offerRepo})
+}
@@ -1,57 +1,120 @@
 package adminusecase
 
 import (
+	"backend/domain/audit"
+	"backend/domain/event"
 	"backend/domain/product"
+	"backend/domain/suggestion"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
+// ErrNutritionConflict - 栄養成分の更新と削除を同時に指定した
+var ErrNutritionConflict = errors.New("nutrition and removeNutrition cannot be used together")
+
 // AdminProductUsecase - 管理者向け商品ユースケース
 type AdminProductUsecase struct {
 	productRepo  product.ProductRepository
 	categoryRepo product.CategoryRepository
+	storeRepo    product.StoreRepository
+	urlRules     product.StoreURLRules
+	uow          event.UnitOfWork
 }
 
 // CreateProductInput - 商品作成の入力
 type CreateProductInput struct {
-	Name             string
-	NameJa           string
-	Description      string
-	DescriptionJa    string
-	ImageURL         string
-	AffiliateURL     *string
-	AmazonURL        *string
-	RakutenURL       *string
-	YahooURL         *string
-	CategoryIDs      []int64
-	CreatedByAdminID *int64
+	Name              string
+	NameJa            string
+	Description       string
+	DescriptionJa     string
+	ImageURL          string
+	AffiliateURL      *string
+	StoreLinks        []StoreLinkInput
+	CategoryIDs       []int64
+	DietaryAttributes []string
+	Certifications    []CertificationInput
+	Nutrition         *NutritionInput
+	CreatedByAdminID  *int64
 }
 
 // UpdateProductInput - 商品更新の入力
 type UpdateProductInput struct {
-	Name             string
-	NameJa           string
-	Description      string
-	DescriptionJa    string
-	ImageURL         string
-	AffiliateURL     *string
-	AmazonURL        *string
-	RakutenURL       *string
-	YahooURL         *string
-	CategoryIDs      []int64
-	UpdatedByAdminID *int64
+	Name              string
+	NameJa            string
+	Description       string
+	DescriptionJa     string
+	ImageURL          string
+	AffiliateURL      *string
+	StoreLinks        []StoreLinkInput
+	CategoryIDs       []int64
+	DietaryAttributes []string             // nil は変更なし
+	Certifications    []CertificationInput // nil は変更なし
+	Nutrition         *NutritionInput      // nil は変更なし
+	RemoveNutrition   bool                 // 栄養成分を削除（Nutrition と同時には指定できない）
+	UpdatedByAdminID  *int64
+	// LegacyStoreURLs - StoreLinks が nil のとき既存のリンクに反映する旧フィールド（amazonUrl 等）の値（nil は変更なし、空文字は削除）
+	LegacyStoreURLs map[string]*string
+}
+
+// CertificationInput - 商品認証の入力
+type CertificationInput struct {
+	Name              string
+	IssuingBody       string
+	CertificateNumber *string
+	IssuedAt          *time.Time
+	ExpiresAt         *time.Time
+}
+
+// StoreLinkInput - ストアリンクの入力
+type StoreLinkInput struct {
+	StoreCode string
+	URL       string
+}
+
+// NutritionInput - 栄養成分の入力
+type NutritionInput struct {
+	Basis             string
+	ServingSizeGrams  *float64
+	CaloriesKcal      float64
+	ProteinGrams      float64
+	FatGrams          float64
+	CarbohydrateGrams float64
+	SaltGrams         float64
 }
 
 // NewAdminProductUsecase - 管理者向け商品ユースケースの生成
-func NewAdminProductUsecase(productRepo product.ProductRepository, categoryRepo product.CategoryRepository) *AdminProductUsecase {
+func NewAdminProductUsecase(productRepo product.ProductRepository, categoryRepo product.CategoryRepository, storeRepo product.StoreRepository, urlRules product.StoreURLRules, uow event.UnitOfWork) *AdminProductUsecase {
 	return &AdminProductUsecase{
 		productRepo:  productRepo,
 		categoryRepo: categoryRepo,
+		storeRepo:    storeRepo,
+		urlRules:     urlRules,
+		uow:          uow,
 	}
 }
 
 // CreateProduct - 商品作成
-func (u *AdminProductUsecase) CreateProduct(input CreateProductInput) (*product.Product, error) {
-	if err := u.validateProductFields(input.Name, input.NameJa, input.Description, input.DescriptionJa, input.ImageURL, input.AffiliateURL, input.AmazonURL, input.RakutenURL, input.YahooURL); err != nil {
+func (u *AdminProductUsecase) CreateProduct(input CreateProductInput, actor audit.Actor) (*product.Product, error) {
+	p, err := u.buildProduct(input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(func(tx event.Tx) error {
+		return createProduct(tx, p, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.ProjectLegacyURLs()
+	return p, nil
+}
+
+// buildProduct - 入力のバリデーションと商品エンティティの生成（保存はしない）
+func (u *AdminProductUsecase) buildProduct(input CreateProductInput) (*product.Product, error) {
+	if err := u.validateProductFields(input.Name, input.NameJa, input.Description, input.DescriptionJa, input.ImageURL, input.AffiliateURL); err != nil {
 		return nil, err
 	}
 
@@ -60,40 +123,111 @@ func (u *AdminProductUsecase) CreateProduct(input CreateProductInput) (*product.
 		return nil, err
 	}
 
-	p := &product.Product{
-		Name:             input.Name,
-		NameJa:           input.NameJa,
-		Description:      input.Description,
-		DescriptionJa:    input.DescriptionJa,
-		ImageURL:         input.ImageURL,
-		AffiliateURL:     input.AffiliateURL,
-		AmazonURL:        input.AmazonURL,
-		RakutenURL:       input.RakutenURL,
-		YahooURL:         input.YahooURL,
-		Categories:       categories,
-		CreatedByAdminID: input.CreatedByAdminID,
+	attributes, certifications, err := u.buildDietaryInfo(input.DietaryAttributes, input.Certifications)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := u.productRepo.Create(p); err != nil {
+	nutrition, err := u.buildNutrition(input.Nutrition)
+	if err != nil {
 		return nil, err
 	}
-	return p, nil
+
+	storeLinks, err := u.resolveStoreLinks(input.StoreLinks, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.Product{
+		Name:              input.Name,
+		NameJa:            input.NameJa,
+		Description:       input.Description,
+		DescriptionJa:     input.DescriptionJa,
+		ImageURL:          input.ImageURL,
+		AffiliateURL:      input.AffiliateURL,
+		StoreLinks:        storeLinks,
+		Categories:        categories,
+		DietaryAttributes: attributes,
+		Certifications:    certifications,
+		Nutrition:         nutrition,
+		CreatedByAdminID:  input.CreatedByAdminID,
+	}, nil
+}
+
+// createProduct - トランザクション内で商品を保存し、監査ログとイベントを記録
+func createProduct(tx event.Tx, p *product.Product, actor audit.Actor) error {
+	if err := tx.Products().Create(p); err != nil {
+		return err
+	}
+	if err := recordAudit(tx.AuditLogs(), actor, audit.ActionProductCreate, audit.TargetProduct, p.ID, nil, p); err != nil {
+		return err
+	}
+	return tx.Outbox().Add(event.ProductCreated{ProductID: p.ID, AdminID: p.CreatedByAdminID})
 }
 
 // UpdateProduct - 商品更新
-func (u *AdminProductUsecase) UpdateProduct(id int64, input UpdateProductInput) (*product.Product, error) {
-	p, err := u.productRepo.FindByID(id)
+func (u *AdminProductUsecase) UpdateProduct(id int64, input UpdateProductInput, actor audit.Actor) (*product.Product, error) {
+	var p *product.Product
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if p, err = tx.Products().FindByIDForUpdate(id); err != nil {
+			return err
+		}
+		return u.updateProduct(tx, p, input, actor, nil)
+	})
 	if err != nil {
 		return nil, err
 	}
+	p.ProjectLegacyURLs()
+	return p, nil
+}
 
-	if err := u.validateProductFields(input.Name, input.NameJa, input.Description, input.DescriptionJa, input.ImageURL, input.AffiliateURL, input.AmazonURL, input.RakutenURL, input.YahooURL); err != nil {
-		return nil, err
+// applyUpdate - 入力を検証して商品に反映（保存はしない）
+func (u *AdminProductUsecase) applyUpdate(p *product.Product, input UpdateProductInput) error {
+	if err := u.validateProductFields(input.Name, input.NameJa, input.Description, input.DescriptionJa, input.ImageURL, input.AffiliateURL); err != nil {
+		return err
 	}
 
 	categories, err := u.resolveCategories(input.CategoryIDs)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	// 食事属性・認証情報は nil なら既存の値を残す（ストアリンクと同じ）
+	attributes, certifications := p.DietaryAttributes, p.Certifications
+	if input.DietaryAttributes != nil || input.Certifications != nil {
+		builtAttributes, builtCertifications, err := u.buildDietaryInfo(input.DietaryAttributes, input.Certifications)
+		if err != nil {
+			return err
+		}
+		if input.DietaryAttributes != nil {
+			attributes = builtAttributes
+		}
+		if input.Certifications != nil {
+			certifications = builtCertifications
+		}
+	}
+
+	// 栄養成分は nil なら既存の値を残し、RemoveNutrition の指定でのみ削除する
+	nutrition := p.Nutrition
+	switch {
+	case input.RemoveNutrition && input.Nutrition != nil:
+		return ErrNutritionConflict
+	case input.RemoveNutrition:
+		nutrition = nil
+	case input.Nutrition != nil:
+		if nutrition, err = u.buildNutrition(input.Nutrition); err != nil {
+			return err
+		}
+	}
+
+	linkInputs := input.StoreLinks
+	if linkInputs == nil {
+		linkInputs = mergeLegacyStoreLinks(storeLinkInputsFrom(p.StoreLinks), input.LegacyStoreURLs)
+	}
+	storeLinks, err := u.resolveStoreLinks(linkInputs, p.StoreLinks)
+	if err != nil {
+		return err
 	}
 
 	p.Name = input.Name
@@ -102,21 +236,52 @@ func (u *AdminProductUsecase) UpdateProduct(id int64, input UpdateProductInput)
 	p.DescriptionJa = input.DescriptionJa
 	p.ImageURL = input.ImageURL
 	p.AffiliateURL = input.AffiliateURL
-	p.AmazonURL = input.AmazonURL
-	p.RakutenURL = input.RakutenURL
-	p.YahooURL = input.YahooURL
+	p.StoreLinks = storeLinks
 	p.Categories = categories
+	p.DietaryAttributes = attributes
+	p.Certifications = certifications
+	p.Nutrition = nutrition
 	p.UpdatedByAdminID = input.UpdatedByAdminID
+	return nil
+}
 
-	if err := u.productRepo.Update(p); err != nil {
-		return nil, err
+// updateProduct - トランザクション内で行ロックした商品に入力を反映して保存し、監査ログと ProductUpdated を記録（suggested は反映元の修正提案、管理者の直接の編集なら nil）
+func (u *AdminProductUsecase) updateProduct(tx event.Tx, p *product.Product, input UpdateProductInput, actor audit.Actor, suggested *suggestion.Suggestion) error {
+	before := *p
+	if err := u.applyUpdate(p, input); err != nil {
+		return err
 	}
-	return p, nil
+
+	updated := event.ProductUpdated{ProductID: p.ID, AdminID: input.UpdatedByAdminID}
+	if suggested != nil {
+		updated.SuggestionID = &suggested.ID
+		updated.SuggestedByCustomerID = &suggested.CustomerID
+	}
+	if err := tx.Products().Update(p); err != nil {
+		return err
+	}
+	if err := recordAudit(tx.AuditLogs(), actor, audit.ActionProductUpdate, audit.TargetProduct, p.ID, before, p); err != nil {
+		return err
+	}
+	return tx.Outbox().Add(updated)
 }
 
 // DeleteProduct - 商品削除
-func (u *AdminProductUsecase) DeleteProduct(id int64) error {
-	return u.productRepo.Delete(id)
+func (u *AdminProductUsecase) DeleteProduct(id int64, actor audit.Actor) error {
+	p, err := u.productRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Products().Delete(id); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionProductDelete, audit.TargetProduct, id, p, nil); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ProductDeleted{ProductID: id})
+	})
 }
 
 // GetProduct - 商品詳細取得
@@ -125,34 +290,13 @@ func (u *AdminProductUsecase) GetProduct(id int64) (*product.Product, error) {
 }
 
 // validateProductFields - 商品フィールドのバリデーション
-func (u *AdminProductUsecase) validateProductFields(name, nameJa, description, descriptionJa, imageURL string, affiliateURL, amazonURL, rakutenURL, yahooURL *string) error {
-	if _, err := product.NewProductNameEn(name); err != nil {
-		return fmt.Errorf("name: %w", err)
-	}
-	if _, err := product.NewProductNameJa(nameJa); err != nil {
-		return fmt.Errorf("nameJa: %w", err)
-	}
-	if _, err := product.NewProductDescriptionEn(description); err != nil {
-		return fmt.Errorf("description: %w", err)
-	}
-	if _, err := product.NewProductDescriptionJa(descriptionJa); err != nil {
-		return fmt.Errorf("descriptionJa: %w", err)
-	}
-	if _, err := product.NewImageURL(imageURL); err != nil {
-		return fmt.Errorf("imageUrl: %w", err)
+func (u *AdminProductUsecase) validateProductFields(name, nameJa, description, descriptionJa, imageURL string, affiliateURL *string) error {
+	if err := product.ValidateDetails(name, nameJa, description, descriptionJa, imageURL); err != nil {
+		return err
 	}
 	if _, err := product.NewOptionalURL(affiliateURL); err != nil {
 		return fmt.Errorf("affiliateUrl: %w", err)
 	}
-	if _, err := product.NewOptionalURL(amazonURL); err != nil {
-		return fmt.Errorf("amazonUrl: %w", err)
-	}
-	if _, err := product.NewOptionalURL(rakutenURL); err != nil {
-		return fmt.Errorf("rakutenUrl: %w", err)
-	}
-	if _, err := product.NewOptionalURL(yahooURL); err != nil {
-		return fmt.Errorf("yahooUrl: %w", err)
-	}
 	return nil
 }
 
@@ -168,3 +312,100 @@ func (u *AdminProductUsecase) resolveCategories(categoryIDs []int64) ([]product.
 	}
 	return categories, nil
 }
+
+// storeLinkInputsFrom - 既存のストアリンクを入力の形に戻す
+func storeLinkInputsFrom(links []product.StoreLink) []StoreLinkInput {
+	inputs := make([]StoreLinkInput, 0, len(links))
+	for _, link := range links {
+		if link.Store != nil {
+			inputs = append(inputs, StoreLinkInput{StoreCode: link.Store.Code, URL: link.URL})
+		}
+	}
+	return inputs
+}
+
+// mergeLegacyStoreLinks - 旧フィールドの値を既存のリンクに反映（他のストアのリンクは残す）
+func mergeLegacyStoreLinks(inputs []StoreLinkInput, legacy map[string]*string) []StoreLinkInput {
+	for _, code := range []string{product.StoreAmazon, product.StoreRakuten, product.StoreYahoo} {
+		url, ok := legacy[code]
+		if !ok || url == nil {
+			continue
+		}
+		merged := make([]StoreLinkInput, 0, len(inputs)+1)
+		for _, in := range inputs {
+			if in.StoreCode != code {
+				merged = append(merged, in)
+			}
+		}
+		if strings.TrimSpace(*url) != "" {
+			merged = append(merged, StoreLinkInput{StoreCode: code, URL: *url})
+		}
+		inputs = merged
+	}
+	return inputs
+}
+
+// resolveStoreLinks - ストアコードの存在チェックとストアリンク生成（URLはストアごとに正規化しアフィリエイトIDを付与）。
+// 無効化されたストアへのリンクは、既存のリンク（existing）を残す場合に限り許可する
+func (u *AdminProductUsecase) resolveStoreLinks(inputs []StoreLinkInput, existing []product.StoreLink) ([]product.StoreLink, error) {
+	linked := make(map[int64]bool, len(existing))
+	for _, link := range existing {
+		linked[link.StoreID] = true
+	}
+	links := make([]product.StoreLink, 0, len(inputs))
+	seen := make(map[int64]bool, len(inputs))
+	for i, in := range inputs {
+		code, err := product.NewStoreCode(in.StoreCode)
+		if err != nil {
+			return nil, fmt.Errorf("storeLinks[%d].storeCode: %w", i, err)
+		}
+		store, err := u.storeRepo.FindByCode(code)
+		if err != nil || (!store.IsActive && !linked[store.ID]) {
+			return nil, fmt.Errorf("storeLinks[%d]: store %s not found", i, code)
+		}
+		if seen[store.ID] {
+			return nil, fmt.Errorf("storeLinks[%d]: %w: %s", i, product.ErrStoreLinkDuplicate, code)
+		}
+		seen[store.ID] = true
+
+		url, err := product.NewStoreLinkURL(in.URL)
+		if err != nil {
+			return nil, fmt.Errorf("storeLinks[%d].url: %w", i, err)
+		}
+		if url, err = u.urlRules.Normalize(store.Code, url); err != nil {
+			return nil, fmt.Errorf("storeLinks[%d].url: %w", i, err)
+		}
+		links = append(links, product.StoreLink{StoreID: store.ID, Store: store, URL: url})
+	}
+	return links, nil
+}
+
+// buildDietaryInfo - 食事属性と認証情報のバリデーションとエンティティ生成
+func (u *AdminProductUsecase) buildDietaryInfo(attributeValues []string, inputs []CertificationInput) (product.DietaryAttributes, []product.Certification, error) {
+	attributes, err := product.NewDietaryAttributes(attributeValues)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dietaryAttributes: %w", err)
+	}
+
+	certifications := make([]product.Certification, 0, len(inputs))
+	for i, in := range inputs {
+		c, err := product.NewCertification(in.Name, in.IssuingBody, in.CertificateNumber, in.IssuedAt, in.ExpiresAt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("certifications[%d]: %w", i, err)
+		}
+		certifications = append(certifications, *c)
+	}
+	return attributes, certifications, nil
+}
+
+// buildNutrition - 栄養成分のバリデーションとValue Object生成（未入力ならnil）
+func (u *AdminProductUsecase) buildNutrition(input *NutritionInput) (*product.Nutrition, error) {
+	if input == nil {
+		return nil, nil
+	}
+	n, err := product.NewNutrition(input.Basis, input.ServingSizeGrams, input.CaloriesKcal, input.ProteinGrams, input.FatGrams, input.CarbohydrateGrams, input.SaltGrams)
+	if err != nil {
+		return nil, fmt.Errorf("nutrition: %w", err)
+	}
+	return n, nil
+}
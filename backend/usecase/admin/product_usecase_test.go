@@ -1,20 +1,31 @@
 package adminusecase
 
 import (
+	"backend/domain/event"
 	"backend/domain/product"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
+// newTestProductUsecase - 書き込みを productRepo に流す UnitOfWork 付きでユースケースを生成
+func newTestProductUsecase(productRepo product.ProductRepository, categoryRepo product.CategoryRepository, storeRepo product.StoreRepository, urlRules product.StoreURLRules) *AdminProductUsecase {
+	return NewAdminProductUsecase(productRepo, categoryRepo, storeRepo, urlRules, &mockUnitOfWork{products: productRepo})
+}
+
 // mockProductRepository - テスト用モックリポジトリ
 type mockProductRepository struct {
+	findAllFn  func(filter product.ProductFilter) ([]product.Product, error)
 	createFn   func(p *product.Product) error
 	updateFn   func(p *product.Product) error
 	findByIDFn func(id int64) (*product.Product, error)
 }
 
-func (m *mockProductRepository) FindAll(categoryID int64, search string) ([]product.Product, error) {
+func (m *mockProductRepository) FindAll(filter product.ProductFilter) ([]product.Product, error) {
+	if m.findAllFn != nil {
+		return m.findAllFn(filter)
+	}
 	return nil, nil
 }
 func (m *mockProductRepository) FindByID(id int64) (*product.Product, error) {
@@ -23,6 +34,9 @@ func (m *mockProductRepository) FindByID(id int64) (*product.Product, error) {
 	}
 	return &product.Product{ID: id}, nil
 }
+func (m *mockProductRepository) FindByIDForUpdate(id int64) (*product.Product, error) {
+	return m.FindByID(id)
+}
 func (m *mockProductRepository) Create(p *product.Product) error {
 	if m.createFn != nil {
 		return m.createFn(p)
@@ -66,6 +80,77 @@ func (m *mockCategoryRepository) Delete(id int64) error {
 	return nil
 }
 
+// mockStoreRepository - テスト用モックリポジトリ
+type mockStoreRepository struct {
+	stores      map[string]*product.Store
+	linkCounts  map[int64]int64
+	offerCounts map[string]int64
+	deleted     []int64
+}
+
+func newMockStoreRepo() *mockStoreRepository {
+	return &mockStoreRepository{
+		stores: map[string]*product.Store{
+			"amazon":  {ID: 1, Code: "amazon", Name: "Amazon", NameJa: "Amazon", IsActive: true},
+			"rakuten": {ID: 2, Code: "rakuten", Name: "Rakuten", NameJa: "楽天市場", IsActive: true},
+			"closed":  {ID: 3, Code: "closed", Name: "Closed", NameJa: "閉店", IsActive: false},
+		},
+		linkCounts:  map[int64]int64{},
+		offerCounts: map[string]int64{},
+	}
+}
+
+func (m *mockStoreRepository) FindAll(activeOnly bool) ([]product.Store, error) {
+	var result []product.Store
+	for _, s := range m.stores {
+		if !activeOnly || s.IsActive {
+			result = append(result, *s)
+		}
+	}
+	return result, nil
+}
+func (m *mockStoreRepository) FindByID(id int64) (*product.Store, error) {
+	for _, s := range m.stores {
+		if s.ID == id {
+			copy := *s
+			return &copy, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+func (m *mockStoreRepository) FindByCode(code string) (*product.Store, error) {
+	s, ok := m.stores[code]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *s
+	return &copy, nil
+}
+func (m *mockStoreRepository) Create(store *product.Store) error {
+	store.ID = int64(len(m.stores) + 1)
+	m.stores[store.Code] = store
+	return nil
+}
+func (m *mockStoreRepository) Update(store *product.Store) error {
+	for code, s := range m.stores {
+		if s.ID == store.ID {
+			delete(m.stores, code)
+		}
+	}
+	m.stores[store.Code] = store
+	return nil
+}
+func (m *mockStoreRepository) Delete(id int64) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+func (m *mockStoreRepository) CountProductLinks(storeID int64) (int64, error) {
+	return m.linkCounts[storeID], nil
+}
+func (m *mockStoreRepository) CountOffers(code string) (int64, error) {
+	return m.offerCounts[code], nil
+}
+
 func validCreateInput() CreateProductInput {
 	return CreateProductInput{
 		Name:          "Test Product",
@@ -78,9 +163,9 @@ func validCreateInput() CreateProductInput {
 }
 
 func TestCreateProduct_Success(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
-	p, err := uc.CreateProduct(validCreateInput())
+	p, err := uc.CreateProduct(validCreateInput(), testActor)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -90,12 +175,12 @@ func TestCreateProduct_Success(t *testing.T) {
 }
 
 func TestCreateProduct_EmptyName(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.Name = ""
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for empty name")
 	}
@@ -105,12 +190,12 @@ func TestCreateProduct_EmptyName(t *testing.T) {
 }
 
 func TestCreateProduct_NameNoEnglish(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.Name = "テスト商品"
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for name without English")
 	}
@@ -120,12 +205,12 @@ func TestCreateProduct_NameNoEnglish(t *testing.T) {
 }
 
 func TestCreateProduct_NameJaNoJapanese(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.NameJa = "Test Product"
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for nameJa without Japanese")
 	}
@@ -135,12 +220,12 @@ func TestCreateProduct_NameJaNoJapanese(t *testing.T) {
 }
 
 func TestCreateProduct_DescriptionNoEnglish(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.Description = "テスト説明文です"
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for description without English")
 	}
@@ -150,12 +235,12 @@ func TestCreateProduct_DescriptionNoEnglish(t *testing.T) {
 }
 
 func TestCreateProduct_DescriptionJaNoJapanese(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.DescriptionJa = "Test description"
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for descriptionJa without Japanese")
 	}
@@ -165,12 +250,12 @@ func TestCreateProduct_DescriptionJaNoJapanese(t *testing.T) {
 }
 
 func TestCreateProduct_NameTooLong(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.Name = strings.Repeat("a", 256)
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for long name")
 	}
@@ -180,12 +265,12 @@ func TestCreateProduct_NameTooLong(t *testing.T) {
 }
 
 func TestCreateProduct_EmptyDescription(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.Description = ""
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for empty description")
 	}
@@ -195,12 +280,12 @@ func TestCreateProduct_EmptyDescription(t *testing.T) {
 }
 
 func TestCreateProduct_DescriptionTooLong(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.Description = strings.Repeat("a", 5001)
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for long description")
 	}
@@ -210,12 +295,12 @@ func TestCreateProduct_DescriptionTooLong(t *testing.T) {
 }
 
 func TestCreateProduct_EmptyImageURL(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.ImageURL = ""
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for empty image URL")
 	}
@@ -225,12 +310,12 @@ func TestCreateProduct_EmptyImageURL(t *testing.T) {
 }
 
 func TestCreateProduct_InvalidImageURL(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.ImageURL = "not-a-url"
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for invalid image URL")
 	}
@@ -239,30 +324,147 @@ func TestCreateProduct_InvalidImageURL(t *testing.T) {
 	}
 }
 
-func TestCreateProduct_InvalidOptionalURL(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+func TestCreateProduct_InvalidStoreLinkURL(t *testing.T) {
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
-	badURL := "not-a-url"
-	input.AmazonURL = &badURL
+	input.StoreLinks = []StoreLinkInput{{StoreCode: "amazon", URL: "not-a-url"}}
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
-		t.Fatal("expected error for invalid optional URL")
+		t.Fatal("expected error for invalid store link URL")
 	}
 	if !errors.Is(err, product.ErrURLInvalid) {
 		t.Errorf("expected ErrURLInvalid, got %v", err)
 	}
 }
 
+func TestCreateProduct_StoreLinks(t *testing.T) {
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	input := validCreateInput()
+	input.StoreLinks = []StoreLinkInput{
+		{StoreCode: "Amazon", URL: " https://www.amazon.co.jp/dp/B000000001 "},
+		{StoreCode: "rakuten", URL: "https://item.rakuten.co.jp/shop/item"},
+	}
+
+	p, err := uc.CreateProduct(input, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(p.StoreLinks) != 2 {
+		t.Fatalf("expected 2 store links, got %d", len(p.StoreLinks))
+	}
+	// 旧フィールドは互換用に StoreLinks から射影される
+	if p.AmazonURL == nil || *p.AmazonURL != "https://www.amazon.co.jp/dp/B000000001" {
+		t.Errorf("expected amazonUrl to be projected, got %v", p.AmazonURL)
+	}
+	if p.YahooURL != nil {
+		t.Errorf("expected yahooUrl to be nil, got %v", *p.YahooURL)
+	}
+}
+
+func TestCreateProduct_StoreLinkErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		links   []StoreLinkInput
+		wantErr string
+	}{
+		{"存在しないストア", []StoreLinkInput{{StoreCode: "iherb", URL: "https://jp.iherb.com/pr/1"}}, "store iherb not found"},
+		{"無効化されたストア", []StoreLinkInput{{StoreCode: "closed", URL: "https://example.com/1"}}, "store closed not found"},
+		{"不正なストアコード", []StoreLinkInput{{StoreCode: "a", URL: "https://example.com/1"}}, "storeCode"},
+		{"URL未入力", []StoreLinkInput{{StoreCode: "amazon", URL: " "}}, "url"},
+		{"ストア重複", []StoreLinkInput{
+			{StoreCode: "amazon", URL: "https://www.amazon.co.jp/dp/1"},
+			{StoreCode: "amazon", URL: "https://www.amazon.co.jp/dp/2"},
+		}, "duplicated"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+			input := validCreateInput()
+			input.StoreLinks = tc.links
+
+			_, err := uc.CreateProduct(input, testActor)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCreateProduct_StoreLinkAffiliateRules(t *testing.T) {
+	rules := product.NewStoreURLRules(map[string]string{
+		product.StoreAmazon:  "veganbite-22",
+		product.StoreRakuten: "1a2b3c4d.5e6f7a8b",
+	})
+
+	testCases := []struct {
+		name    string
+		store   string
+		url     string
+		want    string
+		wantErr error
+	}{
+		{
+			"AmazonはASINに短縮してタグを付与",
+			"amazon", "https://amazon.co.jp/Some-Product/dp/B000000001/ref=sr_1_1?keywords=tofu&tag=typo-22",
+			"https://www.amazon.co.jp/dp/B000000001?tag=veganbite-22", nil,
+		},
+		{
+			"楽天は自社IDの中継URLに変換",
+			"rakuten", "https://item.rakuten.co.jp/shop/item/?scid=abc",
+			"https://hb.afl.rakuten.co.jp/hgc/1a2b3c4d.5e6f7a8b/?pc=https%3A%2F%2Fitem.rakuten.co.jp%2Fshop%2Fitem%2F", nil,
+		},
+		{
+			"他人の楽天中継URLは自社IDで付け替え",
+			"rakuten", "https://hb.afl.rakuten.co.jp/hgc/other.id/?pc=https%3A%2F%2Fitem.rakuten.co.jp%2Fshop%2Fitem%2F",
+			"https://hb.afl.rakuten.co.jp/hgc/1a2b3c4d.5e6f7a8b/?pc=https%3A%2F%2Fitem.rakuten.co.jp%2Fshop%2Fitem%2F", nil,
+		},
+		{
+			"もしもアフィリエイト経由は遷移先のみ検証して維持",
+			"amazon", "https://af.moshimo.com/af/c/click?a_id=1&p_id=170&url=https%3A%2F%2Fwww.amazon.co.jp%2Fdp%2FB000000001",
+			"https://af.moshimo.com/af/c/click?a_id=1&p_id=170&url=https%3A%2F%2Fwww.amazon.co.jp%2Fdp%2FB000000001", nil,
+		},
+		{"ストアとホストの不一致", "amazon", "https://item.rakuten.co.jp/shop/item/", "", product.ErrStoreURLHostMismatch},
+		{"中継URLの遷移先がストアと不一致", "rakuten", "https://af.moshimo.com/af/c/click?a_id=1&url=https%3A%2F%2Fwww.amazon.co.jp%2Fdp%2FB000000001", "", product.ErrStoreURLHostMismatch},
+		{"Amazon短縮URL", "amazon", "https://amzn.to/3abcdef", "", product.ErrStoreURLShortLink},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), rules)
+
+			input := validCreateInput()
+			input.StoreLinks = []StoreLinkInput{{StoreCode: tc.store, URL: tc.url}}
+
+			p, err := uc.CreateProduct(input, testActor)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.StoreLinks[0].URL; got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestCreateProduct_NilOptionalURL(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.AffiliateURL = nil
-	input.AmazonURL = nil
+	input.StoreLinks = nil
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err != nil {
 		t.Fatalf("expected no error for nil optional URLs, got %v", err)
 	}
@@ -274,19 +476,19 @@ func TestCreateProduct_CategoryNotFound(t *testing.T) {
 			return nil, errors.New("not found")
 		},
 	}
-	uc := NewAdminProductUsecase(&mockProductRepository{}, catRepo)
+	uc := newTestProductUsecase(&mockProductRepository{}, catRepo, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := validCreateInput()
 	input.CategoryIDs = []int64{999}
 
-	_, err := uc.CreateProduct(input)
+	_, err := uc.CreateProduct(input, testActor)
 	if err == nil {
 		t.Fatal("expected error for non-existent category")
 	}
 }
 
 func TestUpdateProduct_Success(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := UpdateProductInput{
 		Name:          "Updated",
@@ -297,7 +499,7 @@ func TestUpdateProduct_Success(t *testing.T) {
 		CategoryIDs:   []int64{1},
 	}
 
-	p, err := uc.UpdateProduct(1, input)
+	p, err := uc.UpdateProduct(1, input, testActor)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -306,8 +508,39 @@ func TestUpdateProduct_Success(t *testing.T) {
 	}
 }
 
+func TestUpdateProduct_KeepsDietaryInfoWhenOmitted(t *testing.T) {
+	repo := &mockProductRepository{
+		findByIDFn: func(id int64) (*product.Product, error) {
+			return &product.Product{
+				ID:                id,
+				DietaryAttributes: product.DietaryAttributes{product.DietaryAttributeGlutenFree},
+				Certifications:    []product.Certification{{ProductID: id, Name: "Vegan Society", IssuingBody: "The Vegan Society"}},
+			}, nil
+		},
+	}
+	uc := newTestProductUsecase(repo, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	p, err := uc.UpdateProduct(1, validUpdateInput(), testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(p.DietaryAttributes) != 1 || len(p.Certifications) != 1 || p.Certifications[0].Name != "Vegan Society" {
+		t.Errorf("expected dietary attributes and certifications to be kept, got %+v / %+v", p.DietaryAttributes, p.Certifications)
+	}
+
+	input := validUpdateInput()
+	input.Certifications = []CertificationInput{}
+	p, err = uc.UpdateProduct(1, input, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(p.DietaryAttributes) != 1 || len(p.Certifications) != 0 {
+		t.Errorf("expected only certifications to be cleared, got %+v / %+v", p.DietaryAttributes, p.Certifications)
+	}
+}
+
 func TestUpdateProduct_ValidationError(t *testing.T) {
-	uc := NewAdminProductUsecase(&mockProductRepository{}, &mockCategoryRepository{})
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
 
 	input := UpdateProductInput{
 		Name:          "",
@@ -317,7 +550,7 @@ func TestUpdateProduct_ValidationError(t *testing.T) {
 		ImageURL:      "https://example.com/img.jpg",
 	}
 
-	_, err := uc.UpdateProduct(1, input)
+	_, err := uc.UpdateProduct(1, input, testActor)
 	if err == nil {
 		t.Fatal("expected validation error")
 	}
@@ -325,3 +558,306 @@ func TestUpdateProduct_ValidationError(t *testing.T) {
 		t.Errorf("expected ErrProductNameEmpty, got %v", err)
 	}
 }
+
+// linkedProductRepo - Amazon・閉店ストア・楽天へのリンクを持つ商品を返すリポジトリ
+func linkedProductRepo() *mockProductRepository {
+	return &mockProductRepository{
+		findByIDFn: func(id int64) (*product.Product, error) {
+			return &product.Product{ID: id, StoreLinks: []product.StoreLink{
+				{ProductID: id, StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon"}, URL: "https://www.amazon.co.jp/dp/B000000001"},
+				{ProductID: id, StoreID: 3, Store: &product.Store{ID: 3, Code: "closed"}, URL: "https://closed.example.com/item/1"},
+			}}, nil
+		},
+	}
+}
+
+func validUpdateInput() UpdateProductInput {
+	return UpdateProductInput{
+		Name:          "Updated",
+		NameJa:        "更新済み",
+		Description:   "Updated description",
+		DescriptionJa: "更新された説明",
+		ImageURL:      "https://example.com/new.jpg",
+	}
+}
+
+func TestUpdateProduct_LegacyURLsMergeIntoExistingLinks(t *testing.T) {
+	uc := newTestProductUsecase(linkedProductRepo(), &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	rakuten := "https://item.rakuten.co.jp/shop/item/"
+	empty := ""
+	input := validUpdateInput()
+	input.LegacyStoreURLs = map[string]*string{product.StoreAmazon: &empty, product.StoreRakuten: &rakuten, product.StoreYahoo: nil}
+
+	p, err := uc.UpdateProduct(1, input, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// amazon は削除、楽天は追加、旧フィールドのない閉店ストアのリンクは残る
+	if len(p.StoreLinks) != 2 || p.StoreLinks[0].Store.Code != "closed" || p.StoreLinks[1].Store.Code != "rakuten" {
+		t.Errorf("expected closed and rakuten links, got %+v", p.StoreLinks)
+	}
+}
+
+func TestUpdateProduct_KeepsLinksToInactiveStore(t *testing.T) {
+	uc := newTestProductUsecase(linkedProductRepo(), &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	// storeLinks 省略時は既存のリンク（無効化されたストアを含む）をそのまま保存できる
+	p, err := uc.UpdateProduct(1, validUpdateInput(), testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(p.StoreLinks) != 2 {
+		t.Errorf("expected existing links to be kept, got %+v", p.StoreLinks)
+	}
+
+	// 無効化されたストアへの新しいリンクは追加できない
+	uc = newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+	input := validUpdateInput()
+	input.StoreLinks = []StoreLinkInput{{StoreCode: "closed", URL: "https://closed.example.com/item/1"}}
+	if _, err := uc.UpdateProduct(1, input, testActor); err == nil {
+		t.Error("expected error for a new link to an inactive store")
+	}
+}
+
+func TestCreateProduct_DietaryAttributes(t *testing.T) {
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	input := validCreateInput()
+	input.DietaryAttributes = []string{"organic", "gluten_free", "Organic"}
+
+	p, err := uc.CreateProduct(input, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(p.DietaryAttributes) != 2 {
+		t.Fatalf("expected 2 attributes, got %v", p.DietaryAttributes)
+	}
+	if p.DietaryAttributes[0] != product.DietaryAttributeGlutenFree || p.DietaryAttributes[1] != product.DietaryAttributeOrganic {
+		t.Errorf("expected sorted attributes, got %v", p.DietaryAttributes)
+	}
+}
+
+func TestCreateProduct_InvalidDietaryAttribute(t *testing.T) {
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	input := validCreateInput()
+	input.DietaryAttributes = []string{"meat_free"}
+
+	_, err := uc.CreateProduct(input, testActor)
+	if !errors.Is(err, product.ErrDietaryAttributeInvalid) {
+		t.Errorf("expected ErrDietaryAttributeInvalid, got %v", err)
+	}
+}
+
+func TestCreateProduct_Certification(t *testing.T) {
+	uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	issued := time.Now().AddDate(-2, 0, 0)
+	expired := time.Now().AddDate(0, 0, -1)
+	input := validCreateInput()
+	input.Certifications = []CertificationInput{
+		{Name: "Vegan Trademark", IssuingBody: "The Vegan Society", IssuedAt: &issued, ExpiresAt: &expired},
+	}
+
+	p, err := uc.CreateProduct(input, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(p.Certifications) != 1 {
+		t.Fatalf("expected 1 certification, got %d", len(p.Certifications))
+	}
+	if !p.Certifications[0].IsExpired {
+		t.Error("expected certification past its expiry date to be flagged as expired")
+	}
+}
+
+func TestCreateProduct_CertificationValidation(t *testing.T) {
+	issued := time.Now()
+	before := issued.AddDate(0, 0, -1)
+
+	testCases := []struct {
+		name    string
+		input   CertificationInput
+		wantErr error
+	}{
+		{"認証名が空", CertificationInput{Name: "", IssuingBody: "JAS"}, product.ErrCertificationNameEmpty},
+		{"発行団体が空", CertificationInput{Name: "Organic JAS", IssuingBody: " "}, product.ErrCertificationIssuerEmpty},
+		{"有効期限が発行日より前", CertificationInput{Name: "Organic JAS", IssuingBody: "MAFF", IssuedAt: &issued, ExpiresAt: &before}, product.ErrCertificationExpiryBeforeIssue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+			input := validCreateInput()
+			input.Certifications = []CertificationInput{tc.input}
+
+			_, err := uc.CreateProduct(input, testActor)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func floatPtr(f float64) *float64 { return &f }
+
+func TestCreateProduct_Nutrition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		input           NutritionInput
+		wantErr         error
+		wantProtein100g float64
+		wantHighProtein bool
+	}{
+		{
+			name:            "100gあたりで登録できる",
+			input:           NutritionInput{Basis: "per_100g", CaloriesKcal: 120, ProteinGrams: 12, FatGrams: 5, CarbohydrateGrams: 6, SaltGrams: 0.8},
+			wantProtein100g: 12,
+			wantHighProtein: true,
+		},
+		{
+			name:            "1食あたりは100gあたりに換算される",
+			input:           NutritionInput{Basis: "per_serving", ServingSizeGrams: floatPtr(40), CaloriesKcal: 200, ProteinGrams: 4, FatGrams: 10, CarbohydrateGrams: 24, SaltGrams: 0.2},
+			wantProtein100g: 10,
+			wantHighProtein: false,
+		},
+		{
+			name:    "基準が不正",
+			input:   NutritionInput{Basis: "per_pack"},
+			wantErr: product.ErrNutritionBasisInvalid,
+		},
+		{
+			name:    "1食あたりで量が未指定",
+			input:   NutritionInput{Basis: "per_serving", CaloriesKcal: 100},
+			wantErr: product.ErrServingSizeRequired,
+		},
+		{
+			name:    "負の値",
+			input:   NutritionInput{Basis: "per_100g", ProteinGrams: -1},
+			wantErr: product.ErrNutritionValueNegative,
+		},
+		{
+			name:    "成分の合計が基準量を超える",
+			input:   NutritionInput{Basis: "per_serving", ServingSizeGrams: floatPtr(30), ProteinGrams: 20, FatGrams: 15},
+			wantErr: product.ErrNutritionExceedsReference,
+		},
+		{
+			name:    "エネルギーが上限を超える",
+			input:   NutritionInput{Basis: "per_100g", CaloriesKcal: 950, FatGrams: 100},
+			wantErr: product.ErrNutritionCaloriesTooHigh,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestProductUsecase(&mockProductRepository{}, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+			input := validCreateInput()
+			nutrition := tc.input
+			input.Nutrition = &nutrition
+
+			p, err := uc.CreateProduct(input, testActor)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.Nutrition.ProteinPer100g != tc.wantProtein100g {
+				t.Errorf("expected protein per 100g %v, got %v", tc.wantProtein100g, p.Nutrition.ProteinPer100g)
+			}
+			if p.Nutrition.IsHighProtein() != tc.wantHighProtein {
+				t.Errorf("expected high protein %v, got %v", tc.wantHighProtein, p.Nutrition.IsHighProtein())
+			}
+		})
+	}
+}
+
+func TestUpdateProduct_RemovesNutrition(t *testing.T) {
+	repo := &mockProductRepository{
+		findByIDFn: func(id int64) (*product.Product, error) {
+			return &product.Product{ID: id, Nutrition: &product.Nutrition{ProductID: id}}, nil
+		},
+	}
+	uc := newTestProductUsecase(repo, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{})
+
+	// nutrition を省略した更新では既存の栄養成分を残す
+	p, err := uc.UpdateProduct(1, validUpdateInput(), testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if p.Nutrition == nil {
+		t.Error("expected nutrition to be kept when omitted")
+	}
+
+	input := validUpdateInput()
+	input.RemoveNutrition = true
+	input.Nutrition = &NutritionInput{Basis: "per_100g", ProteinGrams: 10}
+	if _, err := uc.UpdateProduct(1, input, testActor); !errors.Is(err, ErrNutritionConflict) {
+		t.Errorf("expected ErrNutritionConflict, got %v", err)
+	}
+
+	input.Nutrition = nil
+	p, err = uc.UpdateProduct(1, input, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if p.Nutrition != nil {
+		t.Errorf("expected nutrition to be removed, got %+v", p.Nutrition)
+	}
+}
+
+func TestProductMutations_RecordEvents(t *testing.T) {
+	repo := &mockProductRepository{
+		createFn: func(p *product.Product) error {
+			p.ID = 42
+			return nil
+		},
+		findByIDFn: func(id int64) (*product.Product, error) {
+			return &product.Product{ID: id}, nil
+		},
+	}
+	uow := &mockUnitOfWork{products: repo}
+	uc := NewAdminProductUsecase(repo, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{}, uow)
+	adminID := int64(7)
+
+	created, err := uc.CreateProduct(CreateProductInput{
+		Name:             "Oat Milk",
+		NameJa:           "オーツミルク",
+		Description:      "Creamy oat milk",
+		DescriptionJa:    "クリーミーなオーツミルク",
+		ImageURL:         "https://example.com/oat.jpg",
+		CreatedByAdminID: &adminID,
+	}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.UpdateProduct(created.ID, UpdateProductInput{
+		Name:          "Oat Milk",
+		NameJa:        "オーツミルク",
+		Description:   "Barista oat milk",
+		DescriptionJa: "バリスタ用オーツミルク",
+		ImageURL:      "https://example.com/oat.jpg",
+	}, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.DeleteProduct(created.ID, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(uow.events) != 3 {
+		t.Fatalf("expected 3 events, got %+v", uow.events)
+	}
+	createdEvent, ok := uow.events[0].(event.ProductCreated)
+	if !ok || createdEvent.ProductID != 42 || createdEvent.AdminID == nil || *createdEvent.AdminID != adminID {
+		t.Errorf("unexpected create event: %+v", uow.events[0])
+	}
+	if uow.events[1].EventName() != event.NameProductUpdated || uow.events[2].EventName() != event.NameProductDeleted {
+		t.Errorf("unexpected events: %+v", uow.events[1:])
+	}
+}
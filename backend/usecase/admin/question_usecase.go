@@ -0,0 +1,90 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/question"
+)
+
+// AdminQuestionUsecase - 管理者向け商品Q&Aユースケース（公式回答とモデレーション）
+type AdminQuestionUsecase struct {
+	questionRepo question.QuestionRepository
+	answerRepo   question.AnswerRepository
+	uow          event.UnitOfWork
+}
+
+// NewAdminQuestionUsecase - 管理者向け商品Q&Aユースケースの生成
+func NewAdminQuestionUsecase(questionRepo question.QuestionRepository, answerRepo question.AnswerRepository, uow event.UnitOfWork) *AdminQuestionUsecase {
+	return &AdminQuestionUsecase{
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		uow:          uow,
+	}
+}
+
+// CreateOfficialAnswer - ブランド・運営としての公式回答を投稿
+func (u *AdminQuestionUsecase) CreateOfficialAnswer(questionID int64, bodyValue string, actor audit.Actor) (*question.Answer, error) {
+	body, err := question.NewAnswerBody(bodyValue)
+	if err != nil {
+		return nil, err
+	}
+	q, err := u.questionRepo.FindByID(questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	a := question.NewOfficialAnswer(q.ID, actor.AdminID, body)
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Answers().Create(a); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionAnswerCreate, audit.TargetAnswer, a.ID, nil, a); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.AnswerCreated{AnswerID: a.ID, QuestionID: q.ID, ProductID: q.ProductID, IsOfficial: true})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// DeleteQuestion - 質問削除（管理者権限、回答も削除される）
+func (u *AdminQuestionUsecase) DeleteQuestion(id int64, actor audit.Actor) error {
+	q, err := u.questionRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Questions().Delete(id); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionQuestionDelete, audit.TargetQuestion, q.ID, q, nil); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.QuestionDeleted{QuestionID: q.ID, ProductID: q.ProductID, ByAdmin: true})
+	})
+}
+
+// DeleteAnswer - 回答削除（管理者権限）
+func (u *AdminQuestionUsecase) DeleteAnswer(id int64, actor audit.Actor) error {
+	a, err := u.answerRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	q, err := u.questionRepo.FindByID(a.QuestionID)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Answers().Delete(id); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionAnswerDelete, audit.TargetAnswer, a.ID, a, nil); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.AnswerDeleted{AnswerID: a.ID, QuestionID: q.ID, ProductID: q.ProductID, ByAdmin: true})
+	})
+}
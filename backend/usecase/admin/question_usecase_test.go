@@ -0,0 +1,109 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/question"
+	"errors"
+	"testing"
+)
+
+// mockQuestionRepo - 質問リポジトリモック
+type mockQuestionRepo struct {
+	questions map[int64]*question.Question
+}
+
+func (m *mockQuestionRepo) FindByProductID(_ int64) ([]question.Question, error) { return nil, nil }
+func (m *mockQuestionRepo) FindByID(id int64) (*question.Question, error) {
+	if q, ok := m.questions[id]; ok {
+		return q, nil
+	}
+	return nil, question.ErrQuestionNotFound
+}
+func (m *mockQuestionRepo) Create(_ *question.Question) error         { return nil }
+func (m *mockQuestionRepo) SetAcceptedAnswer(_ int64, _ *int64) error { return nil }
+func (m *mockQuestionRepo) Delete(id int64) error {
+	delete(m.questions, id)
+	return nil
+}
+
+// mockAnswerRepo - 回答リポジトリモック
+type mockAnswerRepo struct {
+	answers map[int64]*question.Answer
+	nextID  int64
+}
+
+func (m *mockAnswerRepo) FindByQuestionID(_, _ int64) ([]question.Answer, error) { return nil, nil }
+func (m *mockAnswerRepo) FindByID(id int64) (*question.Answer, error) {
+	if a, ok := m.answers[id]; ok {
+		return a, nil
+	}
+	return nil, question.ErrAnswerNotFound
+}
+func (m *mockAnswerRepo) Create(a *question.Answer) error {
+	m.nextID++
+	a.ID = m.nextID
+	m.answers[a.ID] = a
+	return nil
+}
+func (m *mockAnswerRepo) Delete(id int64) error {
+	delete(m.answers, id)
+	return nil
+}
+func (m *mockAnswerRepo) Upvote(_ *question.Upvote) error { return nil }
+func (m *mockAnswerRepo) RemoveUpvote(_, _ int64) error   { return nil }
+
+func newTestAdminQuestionUsecase() (*AdminQuestionUsecase, *mockQuestionRepo, *mockAnswerRepo, *mockUnitOfWork) {
+	questions := &mockQuestionRepo{questions: map[int64]*question.Question{
+		1: {ID: 1, ProductID: 100, CustomerID: 7, Body: "はちみつは入っていますか？"},
+	}}
+	answers := &mockAnswerRepo{answers: make(map[int64]*question.Answer)}
+	uow := &mockUnitOfWork{questions: questions, answers: answers}
+	return NewAdminQuestionUsecase(questions, answers, uow), questions, answers, uow
+}
+
+func TestCreateOfficialAnswer(t *testing.T) {
+	uc, _, _, uow := newTestAdminQuestionUsecase()
+
+	a, err := uc.CreateOfficialAnswer(1, "はちみつは使用していません", testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !a.IsOfficial || a.AdminID == nil || *a.AdminID != testActor.AdminID || a.CustomerID != nil {
+		t.Errorf("expected official answer by the admin, got %+v", a)
+	}
+	if len(uow.audits) != 1 || uow.audits[0].Action != audit.ActionAnswerCreate {
+		t.Errorf("expected answer.create audit entry, got %+v", uow.audits)
+	}
+	if created, ok := uow.events[0].(event.AnswerCreated); !ok || !created.IsOfficial || created.ProductID != 100 {
+		t.Errorf("expected official AnswerCreated event, got %+v", uow.events)
+	}
+	if _, err := uc.CreateOfficialAnswer(999, "回答", testActor); !errors.Is(err, question.ErrQuestionNotFound) {
+		t.Errorf("expected ErrQuestionNotFound, got %v", err)
+	}
+}
+
+func TestModerateQuestion_Audited(t *testing.T) {
+	uc, questions, answers, uow := newTestAdminQuestionUsecase()
+	customerID := int64(8)
+	answers.answers[5] = &question.Answer{ID: 5, QuestionID: 1, CustomerID: &customerID, Body: "spam"}
+
+	if err := uc.DeleteAnswer(5, testActor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := uc.DeleteQuestion(1, testActor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(answers.answers) != 0 || len(questions.questions) != 0 {
+		t.Error("expected question and answer to be deleted")
+	}
+	if len(uow.audits) != 2 || uow.audits[0].TargetType != audit.TargetAnswer || uow.audits[1].Action != audit.ActionQuestionDelete {
+		t.Errorf("unexpected audit entries: %+v", uow.audits)
+	}
+	if deleted, ok := uow.events[1].(event.QuestionDeleted); !ok || !deleted.ByAdmin {
+		t.Errorf("expected QuestionDeleted by admin, got %+v", uow.events)
+	}
+	if err := uc.DeleteQuestion(1, testActor); !errors.Is(err, question.ErrQuestionNotFound) {
+		t.Errorf("expected ErrQuestionNotFound, got %v", err)
+	}
+}
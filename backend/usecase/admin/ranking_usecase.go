@@ -0,0 +1,51 @@
+package adminusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/ranking"
+	"time"
+)
+
+// AdminRankingUsecase - ランキングの集計ユースケース
+type AdminRankingUsecase struct {
+	rankingRepo ranking.RankingRepository
+	productRepo product.ProductRepository
+	now         func() time.Time
+}
+
+// NewAdminRankingUsecase - ランキングの集計ユースケースの生成
+func NewAdminRankingUsecase(rankingRepo ranking.RankingRepository, productRepo product.ProductRepository) *AdminRankingUsecase {
+	return &AdminRankingUsecase{
+		rankingRepo: rankingRepo,
+		productRepo: productRepo,
+		now:         time.Now,
+	}
+}
+
+// RefreshRankings - トレンド・高評価ランキングを集計し直す（定期実行）
+func (u *AdminRankingUsecase) RefreshRankings() error {
+	products, err := u.productRepo.FindAll(product.ProductFilter{})
+	if err != nil {
+		return err
+	}
+	productCategories := make(map[int64][]int64, len(products))
+	for _, p := range products {
+		categoryIDs := make([]int64, 0, len(p.Categories))
+		for _, c := range p.Categories {
+			categoryIDs = append(categoryIDs, c.ID)
+		}
+		productCategories[p.ID] = categoryIDs
+	}
+
+	now := u.now()
+	for _, t := range []ranking.Type{ranking.TypeTrending, ranking.TypeTop} {
+		activities, err := u.rankingRepo.FindActivity(now.Add(-t.Window()))
+		if err != nil {
+			return err
+		}
+		if err := u.rankingRepo.Replace(t, ranking.Build(t, activities, productCategories, now)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package adminusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/ranking"
+	"testing"
+	"time"
+)
+
+// mockRankingRepository - テスト用モックリポジトリ
+type mockRankingRepository struct {
+	activities map[ranking.Type][]ranking.Activity
+	since      map[ranking.Type]time.Time
+	entries    map[ranking.Type][]ranking.Entry
+}
+
+func newMockRankingRepo() *mockRankingRepository {
+	return &mockRankingRepository{
+		activities: map[ranking.Type][]ranking.Activity{},
+		since:      map[ranking.Type]time.Time{},
+		entries:    map[ranking.Type][]ranking.Entry{},
+	}
+}
+
+// FindActivity - 集計期間の長さから種類を判定して返す
+func (m *mockRankingRepository) FindActivity(since time.Time) ([]ranking.Activity, error) {
+	t := ranking.TypeTrending
+	if time.Since(since) > ranking.TrendingWindow*2 {
+		t = ranking.TypeTop
+	}
+	m.since[t] = since
+	return m.activities[t], nil
+}
+func (m *mockRankingRepository) Replace(t ranking.Type, entries []ranking.Entry) error {
+	m.entries[t] = entries
+	return nil
+}
+func (m *mockRankingRepository) Find(t ranking.Type, categoryID int64) ([]ranking.Entry, error) {
+	return nil, nil
+}
+
+func (m *mockRankingRepository) ranked(t ranking.Type, categoryID int64) []int64 {
+	var ids []int64
+	for _, e := range m.entries[t] {
+		if e.CategoryID == categoryID {
+			ids = append(ids, e.ProductID)
+		}
+	}
+	return ids
+}
+
+func TestRefreshRankings(t *testing.T) {
+	repo := newMockRankingRepo()
+	// 商品1: お気に入りが多い / 商品2: 高評価レビューが多い / 商品3: 低評価レビューのみ / 商品99: 削除済み
+	repo.activities[ranking.TypeTrending] = []ranking.Activity{
+		{ProductID: 1, Favorites: 5},
+		{ProductID: 2, Reviews: 2, RatingSum: 10, Favorites: 2},
+		{ProductID: 3, Reviews: 3, RatingSum: 3},
+		{ProductID: 99, Favorites: 50},
+	}
+	// 商品2は満点1件、商品3は4.5平均で多数。ベイズ平均で件数の多い商品3が上位
+	repo.activities[ranking.TypeTop] = []ranking.Activity{
+		{ProductID: 2, Reviews: 1, RatingSum: 5},
+		{ProductID: 3, Reviews: 20, RatingSum: 90},
+		{ProductID: 1, Reviews: 4, RatingSum: 8},
+	}
+	productRepo := &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			return []product.Product{
+				{ID: 1, Categories: []product.Category{{ID: 1}}},
+				{ID: 2, Categories: []product.Category{{ID: 1}}},
+				{ID: 3, Categories: []product.Category{{ID: 2}}},
+			}, nil
+		},
+	}
+	uc := NewAdminRankingUsecase(repo, productRepo)
+
+	if err := uc.RefreshRankings(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// トレンド: 商品2 = 2 + 2*2*5/5 = 6, 商品1 = 5, 商品3 = 2*3*1/5 = 1.2
+	if got := repo.ranked(ranking.TypeTrending, ranking.AllCategories); !equalIDs(got, []int64{2, 1, 3}) {
+		t.Errorf("unexpected trending ranking: %v", got)
+	}
+	if got := repo.ranked(ranking.TypeTrending, 1); !equalIDs(got, []int64{2, 1}) {
+		t.Errorf("unexpected trending ranking for category 1: %v", got)
+	}
+	if got := repo.ranked(ranking.TypeTop, ranking.AllCategories); !equalIDs(got, []int64{3, 2, 1}) {
+		t.Errorf("unexpected top ranking: %v", got)
+	}
+	if got := repo.ranked(ranking.TypeTop, 2); !equalIDs(got, []int64{3}) {
+		t.Errorf("unexpected top ranking for category 2: %v", got)
+	}
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package adminusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/recommendation"
+	"time"
+)
+
+// AdminRecommendationUsecase - 推薦の再計算ユースケース
+type AdminRecommendationUsecase struct {
+	recommendationRepo recommendation.RecommendationRepository
+	productRepo        product.ProductRepository
+	now                func() time.Time
+}
+
+// RecomputeSummary - 再計算の結果件数
+type RecomputeSummary struct {
+	Similarities    int
+	Recommendations int
+}
+
+// NewAdminRecommendationUsecase - 推薦の再計算ユースケースの生成
+func NewAdminRecommendationUsecase(recommendationRepo recommendation.RecommendationRepository, productRepo product.ProductRepository) *AdminRecommendationUsecase {
+	return &AdminRecommendationUsecase{
+		recommendationRepo: recommendationRepo,
+		productRepo:        productRepo,
+		now:                time.Now,
+	}
+}
+
+// RecomputeRecommendations - お気に入り・高評価レビューから類似商品と顧客別推薦を再計算（定期実行）
+func (u *AdminRecommendationUsecase) RecomputeRecommendations() (*RecomputeSummary, error) {
+	products, err := u.productRepo.FindAll(product.ProductFilter{})
+	if err != nil {
+		return nil, err
+	}
+	interactions, err := u.recommendationRepo.FindInteractions()
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make([]recommendation.CatalogItem, 0, len(products))
+	for _, p := range products {
+		categoryIDs := make([]int64, 0, len(p.Categories))
+		for _, c := range p.Categories {
+			categoryIDs = append(categoryIDs, c.ID)
+		}
+		catalog = append(catalog, recommendation.CatalogItem{ProductID: p.ID, CategoryIDs: categoryIDs, Rating: p.Rating})
+	}
+
+	model := recommendation.NewModel(interactions, catalog, u.now())
+	similar := model.SimilarProducts()
+	recommendations := model.Recommendations(similar)
+
+	if err := u.recommendationRepo.ReplaceAll(similar, recommendations); err != nil {
+		return nil, err
+	}
+	return &RecomputeSummary{Similarities: len(similar), Recommendations: len(recommendations)}, nil
+}
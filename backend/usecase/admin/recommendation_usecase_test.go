@@ -0,0 +1,128 @@
+package adminusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/recommendation"
+	"testing"
+)
+
+// mockRecommendationRepository - テスト用モックリポジトリ
+type mockRecommendationRepository struct {
+	interactions    []recommendation.Interaction
+	similar         []recommendation.SimilarProduct
+	recommendations []recommendation.Recommendation
+}
+
+func (m *mockRecommendationRepository) FindInteractions() ([]recommendation.Interaction, error) {
+	return m.interactions, nil
+}
+func (m *mockRecommendationRepository) ReplaceAll(similar []recommendation.SimilarProduct, recommendations []recommendation.Recommendation) error {
+	m.similar = similar
+	m.recommendations = recommendations
+	return nil
+}
+func (m *mockRecommendationRepository) FindSimilar(productID int64, limit int) ([]recommendation.SimilarProduct, error) {
+	return nil, nil
+}
+func (m *mockRecommendationRepository) FindByCustomerID(customerID int64, limit int) ([]recommendation.Recommendation, error) {
+	return nil, nil
+}
+
+func (m *mockRecommendationRepository) similarTo(productID int64) []recommendation.SimilarProduct {
+	var result []recommendation.SimilarProduct
+	for _, s := range m.similar {
+		if s.ProductID == productID {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func (m *mockRecommendationRepository) recommendedFor(customerID int64) []recommendation.Recommendation {
+	var result []recommendation.Recommendation
+	for _, r := range m.recommendations {
+		if r.CustomerID == customerID {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// recommendationCatalog - 商品1〜3は代替肉、商品4・5は飲料
+func recommendationCatalog() *mockProductRepository {
+	meat := product.Category{ID: 1}
+	drink := product.Category{ID: 2}
+	return &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			return []product.Product{
+				{ID: 1, Categories: []product.Category{meat}, Rating: 4.0},
+				{ID: 2, Categories: []product.Category{meat}, Rating: 3.5},
+				{ID: 3, Categories: []product.Category{meat}, Rating: 4.8},
+				{ID: 4, Categories: []product.Category{drink}, Rating: 4.2},
+				{ID: 5, Categories: []product.Category{drink}, Rating: 3.0},
+			}, nil
+		},
+	}
+}
+
+func TestRecomputeRecommendations_CoOccurrence(t *testing.T) {
+	repo := &mockRecommendationRepository{interactions: []recommendation.Interaction{
+		{CustomerID: 10, ProductID: 1}, {CustomerID: 10, ProductID: 4},
+		{CustomerID: 11, ProductID: 1}, {CustomerID: 11, ProductID: 4},
+		{CustomerID: 12, ProductID: 1}, {CustomerID: 12, ProductID: 2},
+		{CustomerID: 13, ProductID: 1},
+		{CustomerID: 13, ProductID: 999}, // 削除済み商品へのシグナルは無視
+	}}
+	uc := NewAdminRecommendationUsecase(repo, recommendationCatalog())
+
+	summary, err := uc.RecomputeRecommendations()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Similarities != len(repo.similar) || summary.Recommendations != len(repo.recommendations) {
+		t.Errorf("summary does not match stored rows: %+v", summary)
+	}
+
+	// 商品1と最も共起するのは商品4（2人）、次に商品2（1人）。残りは同カテゴリの人気商品で補完
+	similar := repo.similarTo(1)
+	if len(similar) != 3 {
+		t.Fatalf("expected 3 similar products, got %+v", similar)
+	}
+	if similar[0].SimilarProductID != 4 || similar[0].Reason != recommendation.ReasonSimilar || similar[0].Rank != 1 {
+		t.Errorf("expected product 4 first, got %+v", similar[0])
+	}
+	if similar[1].SimilarProductID != 2 || similar[1].Reason != recommendation.ReasonSimilar {
+		t.Errorf("expected product 2 second, got %+v", similar[1])
+	}
+	if similar[2].SimilarProductID != 3 || similar[2].Reason != recommendation.ReasonPopular || similar[2].Rank != 3 {
+		t.Errorf("expected product 3 as category fallback, got %+v", similar[2])
+	}
+
+	// 顧客12（商品1・2）には商品4を推薦し、既に好意を示した商品は含めない
+	recs := repo.recommendedFor(12)
+	if len(recs) == 0 || recs[0].ProductID != 4 {
+		t.Fatalf("expected product 4 to be recommended first, got %+v", recs)
+	}
+	for _, r := range recs {
+		if r.ProductID == 1 || r.ProductID == 2 {
+			t.Errorf("expected owned product %d to be excluded", r.ProductID)
+		}
+	}
+}
+
+func TestRecomputeRecommendations_CategoryFallback(t *testing.T) {
+	// 共起がない顧客は、関心のあるカテゴリの人気商品（評価順）を推薦する
+	repo := &mockRecommendationRepository{interactions: []recommendation.Interaction{
+		{CustomerID: 20, ProductID: 5},
+	}}
+	uc := NewAdminRecommendationUsecase(repo, recommendationCatalog())
+
+	if _, err := uc.RecomputeRecommendations(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recs := repo.recommendedFor(20)
+	if len(recs) != 1 || recs[0].ProductID != 4 || recs[0].Reason != recommendation.ReasonPopular {
+		t.Errorf("expected popular drink (product 4) only, got %+v", recs)
+	}
+}
@@ -1,22 +1,25 @@
 package adminusecase
 
 import (
-	"backend/domain/product"
+	"backend/domain/audit"
+	"backend/domain/event"
 	"backend/domain/review"
 	"errors"
 )
 
-// AdminReviewUsecase - 管理者向けレビューユースケース
+// AdminReviewUsecase - 管理者向けレビューユースケース（レビュー・返信のモデレーションと公式返信）
 type AdminReviewUsecase struct {
-	reviewRepo  review.ReviewRepository
-	productRepo product.ProductRepository
+	reviewRepo review.ReviewRepository
+	replyRepo  review.ReplyRepository
+	uow        event.UnitOfWork
 }
 
 // NewAdminReviewUsecase - 管理者向けレビューユースケースの生成
-func NewAdminReviewUsecase(reviewRepo review.ReviewRepository, productRepo product.ProductRepository) *AdminReviewUsecase {
+func NewAdminReviewUsecase(reviewRepo review.ReviewRepository, replyRepo review.ReplyRepository, uow event.UnitOfWork) *AdminReviewUsecase {
 	return &AdminReviewUsecase{
-		reviewRepo:  reviewRepo,
-		productRepo: productRepo,
+		reviewRepo: reviewRepo,
+		replyRepo:  replyRepo,
+		uow:        uow,
 	}
 }
 
@@ -26,21 +29,86 @@ func (u *AdminReviewUsecase) GetAllReviews() ([]review.Review, error) {
 }
 
 // DeleteReview - レビュー削除（管理者権限）
-func (u *AdminReviewUsecase) DeleteReview(id int64) error {
+func (u *AdminReviewUsecase) DeleteReview(id int64, actor audit.Actor) error {
 	r, err := u.reviewRepo.FindByID(id)
 	if err != nil {
 		return errors.New("review not found")
 	}
 
-	productID := r.ProductID
-	if err := u.reviewRepo.Delete(id); err != nil {
-		return err
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Reviews().Delete(id); err != nil {
+			return err
+		}
+
+		// 商品の評価を再計算
+		avg, count, err := tx.Reviews().GetProductRatingStats(r.ProductID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Products().UpdateRating(r.ProductID, avg, int(count)); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionReviewDelete, audit.TargetReview, r.ID, r, nil); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReviewDeleted{ReviewID: r.ID, ProductID: r.ProductID, CustomerID: r.CustomerID, ByAdmin: true})
+	})
+}
+
+// CreateOfficialReply - ブランド・運営としての公式返信を投稿（parentID が nil ならレビューへの直接の返信）
+func (u *AdminReviewUsecase) CreateOfficialReply(reviewID int64, parentID *int64, bodyValue string, actor audit.Actor) (*review.Reply, error) {
+	body, err := review.NewReplyBody(bodyValue)
+	if err != nil {
+		return nil, err
+	}
+	r, err := u.reviewRepo.FindByID(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	var parent *review.Reply
+	if parentID != nil {
+		if parent, err = u.replyRepo.FindByID(*parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	reply, err := review.NewOfficialReply(r, parent, actor.AdminID, body)
+	if err != nil {
+		return nil, err
 	}
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Replies().Create(reply); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionReplyCreate, audit.TargetReply, reply.ID, nil, reply); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReplyCreated{ReplyID: reply.ID, ReviewID: r.ID, ProductID: r.ProductID, IsOfficial: true})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
 
-	// 商品の評価を再計算
-	avg, count, err := u.reviewRepo.GetProductRatingStats(productID)
+// DeleteReply - 返信削除（管理者権限、その返信への返信も削除される）
+func (u *AdminReviewUsecase) DeleteReply(id int64, actor audit.Actor) error {
+	reply, err := u.replyRepo.FindByID(id)
 	if err != nil {
 		return err
 	}
-	return u.productRepo.UpdateRating(productID, avg, int(count))
+	r, err := u.reviewRepo.FindByID(reply.ReviewID)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Replies().Delete(id); err != nil {
+			return err
+		}
+		if err := recordAudit(tx.AuditLogs(), actor, audit.ActionReplyDelete, audit.TargetReply, reply.ID, reply, nil); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReplyDeleted{ReplyID: reply.ID, ReviewID: r.ID, ProductID: r.ProductID, ByAdmin: true})
+	})
 }
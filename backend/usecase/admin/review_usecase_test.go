@@ -1,6 +1,8 @@
 package adminusecase
 
 import (
+	"backend/domain/audit"
+	"backend/domain/event"
 	"backend/domain/product"
 	"backend/domain/review"
 	"errors"
@@ -21,8 +23,9 @@ func (m *mockReviewRepo) FindAll() ([]review.Review, error) {
 	}
 	return []review.Review{}, nil
 }
-func (m *mockReviewRepo) FindByProductID(_ int64) ([]review.Review, error) { return nil, nil }
+func (m *mockReviewRepo) FindByProductID(_ int64) ([]review.Review, error)  { return nil, nil }
 func (m *mockReviewRepo) FindByCustomerID(_ int64) ([]review.Review, error) { return nil, nil }
+func (m *mockReviewRepo) CountByCustomerID(_ int64) (int64, error)          { return 0, nil }
 func (m *mockReviewRepo) FindByID(id int64) (*review.Review, error) {
 	if m.findByIDFn != nil {
 		return m.findByIDFn(id)
@@ -54,19 +57,22 @@ type mockProductRepoForReview struct {
 	updateRatingFn func(productID int64, rating float64, count int) error
 }
 
-func (m *mockProductRepoForReview) FindAll(_ int64, _ string) ([]product.Product, error) {
+func (m *mockProductRepoForReview) FindAll(_ product.ProductFilter) ([]product.Product, error) {
 	return nil, nil
 }
 func (m *mockProductRepoForReview) FindByID(_ int64) (*product.Product, error) { return nil, nil }
-func (m *mockProductRepoForReview) Create(_ *product.Product) error             { return nil }
-func (m *mockProductRepoForReview) Update(_ *product.Product) error             { return nil }
-func (m *mockProductRepoForReview) Delete(_ int64) error                        { return nil }
+func (m *mockProductRepoForReview) Create(_ *product.Product) error            { return nil }
+func (m *mockProductRepoForReview) Update(_ *product.Product) error            { return nil }
+func (m *mockProductRepoForReview) Delete(_ int64) error                       { return nil }
 func (m *mockProductRepoForReview) UpdateRating(productID int64, rating float64, count int) error {
 	if m.updateRatingFn != nil {
 		return m.updateRatingFn(productID, rating, count)
 	}
 	return nil
 }
+func (m *mockProductRepoForReview) FindByIDForUpdate(_ int64) (*product.Product, error) {
+	return nil, nil
+}
 
 func TestGetAllReviews_Success(t *testing.T) {
 	rating, _ := review.NewRating(5)
@@ -79,7 +85,7 @@ func TestGetAllReviews_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	uc := NewAdminReviewUsecase(reviewRepo, &mockProductRepoForReview{})
+	uc := NewAdminReviewUsecase(reviewRepo, newMockReplyRepo(), &mockUnitOfWork{reviews: reviewRepo, products: &mockProductRepoForReview{}})
 
 	reviews, err := uc.GetAllReviews()
 	if err != nil {
@@ -96,7 +102,7 @@ func TestGetAllReviews_RepoError(t *testing.T) {
 			return nil, errors.New("db error")
 		},
 	}
-	uc := NewAdminReviewUsecase(reviewRepo, &mockProductRepoForReview{})
+	uc := NewAdminReviewUsecase(reviewRepo, newMockReplyRepo(), &mockUnitOfWork{reviews: reviewRepo, products: &mockProductRepoForReview{}})
 
 	_, err := uc.GetAllReviews()
 	if err == nil {
@@ -119,9 +125,9 @@ func TestDeleteReview_Success(t *testing.T) {
 			return nil
 		},
 	}
-	uc := NewAdminReviewUsecase(reviewRepo, productRepo)
+	uc := NewAdminReviewUsecase(reviewRepo, newMockReplyRepo(), &mockUnitOfWork{reviews: reviewRepo, products: productRepo})
 
-	err := uc.DeleteReview(1)
+	err := uc.DeleteReview(1, testActor)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -139,9 +145,9 @@ func TestDeleteReview_NotFound(t *testing.T) {
 			return nil, errors.New("not found")
 		},
 	}
-	uc := NewAdminReviewUsecase(reviewRepo, &mockProductRepoForReview{})
+	uc := NewAdminReviewUsecase(reviewRepo, newMockReplyRepo(), &mockUnitOfWork{reviews: reviewRepo, products: &mockProductRepoForReview{}})
 
-	err := uc.DeleteReview(999)
+	err := uc.DeleteReview(999, testActor)
 	if err == nil {
 		t.Fatal("expected error for not found review")
 	}
@@ -149,3 +155,82 @@ func TestDeleteReview_NotFound(t *testing.T) {
 		t.Errorf("expected 'review not found', got '%s'", err.Error())
 	}
 }
+
+// mockReplyRepo - 返信リポジトリモック
+type mockReplyRepo struct {
+	replies map[int64]*review.Reply
+	nextID  int64
+}
+
+func newMockReplyRepo() *mockReplyRepo {
+	return &mockReplyRepo{replies: make(map[int64]*review.Reply)}
+}
+
+func (m *mockReplyRepo) FindByReviewID(_ int64) ([]review.Reply, error) { return nil, nil }
+func (m *mockReplyRepo) FindByID(id int64) (*review.Reply, error) {
+	if r, ok := m.replies[id]; ok {
+		return r, nil
+	}
+	return nil, review.ErrReplyNotFound
+}
+func (m *mockReplyRepo) Create(reply *review.Reply) error {
+	m.nextID++
+	reply.ID = m.nextID
+	m.replies[reply.ID] = reply
+	return nil
+}
+func (m *mockReplyRepo) Delete(id int64) error {
+	delete(m.replies, id)
+	return nil
+}
+
+func TestCreateOfficialReply(t *testing.T) {
+	reviewRepo := &mockReviewRepo{}
+	replyRepo := newMockReplyRepo()
+	uow := &mockUnitOfWork{reviews: reviewRepo, replies: replyRepo}
+	uc := NewAdminReviewUsecase(reviewRepo, replyRepo, uow)
+
+	reply, err := uc.CreateOfficialReply(1, nil, "ご購入ありがとうございます", testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reply.IsOfficial || reply.AdminID == nil || *reply.AdminID != testActor.AdminID || reply.CustomerID != nil {
+		t.Errorf("expected official reply by the admin, got %+v", reply)
+	}
+	if len(uow.audits) != 1 || uow.audits[0].Action != audit.ActionReplyCreate {
+		t.Errorf("expected reply.create audit entry, got %+v", uow.audits)
+	}
+	if created, ok := uow.events[0].(event.ReplyCreated); !ok || !created.IsOfficial {
+		t.Errorf("expected official ReplyCreated event, got %+v", uow.events)
+	}
+
+	missing := int64(999)
+	if _, err := uc.CreateOfficialReply(1, &missing, "ありがとうございます", testActor); !errors.Is(err, review.ErrReplyNotFound) {
+		t.Errorf("expected review.ErrReplyNotFound, got %v", err)
+	}
+}
+
+func TestDeleteReply_Audited(t *testing.T) {
+	reviewRepo := &mockReviewRepo{}
+	replyRepo := newMockReplyRepo()
+	customerID := int64(7)
+	replyRepo.replies[5] = &review.Reply{ID: 5, ReviewID: 1, Depth: 1, CustomerID: &customerID, Body: "spam"}
+	uow := &mockUnitOfWork{reviews: reviewRepo, replies: replyRepo}
+	uc := NewAdminReviewUsecase(reviewRepo, replyRepo, uow)
+
+	if err := uc.DeleteReply(5, testActor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := replyRepo.replies[5]; ok {
+		t.Error("expected reply to be deleted")
+	}
+	if len(uow.audits) != 1 || uow.audits[0].Action != audit.ActionReplyDelete || uow.audits[0].TargetType != audit.TargetReply {
+		t.Errorf("expected reply.delete audit entry, got %+v", uow.audits)
+	}
+	if deleted, ok := uow.events[0].(event.ReplyDeleted); !ok || !deleted.ByAdmin {
+		t.Errorf("expected ReplyDeleted by admin, got %+v", uow.events)
+	}
+	if err := uc.DeleteReply(5, testActor); !errors.Is(err, review.ErrReplyNotFound) {
+		t.Errorf("expected review.ErrReplyNotFound, got %v", err)
+	}
+}
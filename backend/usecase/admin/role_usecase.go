@@ -0,0 +1,156 @@
+package adminusecase
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"backend/domain/event"
+	"errors"
+)
+
+var (
+	ErrRoleNameTaken = errors.New("a role with this name already exists")
+	ErrRoleInUse     = errors.New("role is assigned to admins and cannot be deleted")
+)
+
+// RoleInput - ロール作成・更新の入力
+type RoleInput struct {
+	Name        string
+	NameJa      string
+	Description string
+	Permissions []string
+}
+
+// AdminRoleUsecase - 管理者ロール・権限管理ユースケース（admin:manage 権限が必要）
+type AdminRoleUsecase struct {
+	roleRepo   admin.RoleRepository
+	uow        event.UnitOfWork
+	authorizer *admin.Authorizer
+}
+
+// NewAdminRoleUsecase - 管理者ロール・権限管理ユースケースの生成
+func NewAdminRoleUsecase(roleRepo admin.RoleRepository, uow event.UnitOfWork, authorizer *admin.Authorizer) *AdminRoleUsecase {
+	return &AdminRoleUsecase{
+		roleRepo:   roleRepo,
+		uow:        uow,
+		authorizer: authorizer,
+	}
+}
+
+// GetRoles - ロール一覧取得（権限付き）
+func (u *AdminRoleUsecase) GetRoles(actor audit.Actor) ([]admin.Role, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+	return u.roleRepo.FindAll()
+}
+
+// GetPermissions - ロールに付与できる権限の一覧
+func (u *AdminRoleUsecase) GetPermissions(actor audit.Actor) ([]string, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+	return admin.Permissions(), nil
+}
+
+// CreateRole - カスタムロール作成
+func (u *AdminRoleUsecase) CreateRole(input RoleInput, actor audit.Actor) (*admin.Role, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+	r, err := admin.NewRole(input.Name, input.NameJa, input.Description, input.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := ensureRoleNameFree(tx.Roles(), r.Name); err != nil {
+			return err
+		}
+		if err := tx.Roles().Create(r); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionRoleCreate, audit.TargetRole, r.ID, nil, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UpdateRole - ロールの名前・権限を更新（組み込みロールは名前を変えられず、スーパー管理者の権限は変更不可）
+func (u *AdminRoleUsecase) UpdateRole(id int64, input RoleInput, actor audit.Actor) (*admin.Role, error) {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return nil, err
+	}
+
+	var r *admin.Role
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if r, err = findRole(tx.Roles(), id); err != nil {
+			return err
+		}
+		before := *r
+
+		if err := r.Rename(input.Name, input.NameJa, input.Description); err != nil {
+			return err
+		}
+		if err := r.Grant(input.Permissions); err != nil {
+			return err
+		}
+		if r.Name != before.Name {
+			if err := ensureRoleNameFree(tx.Roles(), r.Name); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Roles().Update(r); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionRoleUpdate, audit.TargetRole, r.ID, before, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DeleteRole - カスタムロール削除（管理者に割り当て中のロールは不可）
+func (u *AdminRoleUsecase) DeleteRole(id int64, actor audit.Actor) error {
+	if err := authorizeManage(u.authorizer, actor); err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		r, err := findRole(tx.Roles(), id)
+		if err != nil {
+			return err
+		}
+		if r.IsBuiltIn {
+			return admin.ErrRoleBuiltIn
+		}
+		count, err := tx.Admins().CountByRole(id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrRoleInUse
+		}
+
+		if err := tx.Roles().Delete(id); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionRoleDelete, audit.TargetRole, r.ID, r, nil)
+	})
+}
+
+// ensureRoleNameFree - 同じ名前のロールがあれば ErrRoleNameTaken（検索のエラーはそのまま返す）
+func ensureRoleNameFree(repo admin.RoleRepository, name string) error {
+	_, err := repo.FindByName(name)
+	if err == nil {
+		return ErrRoleNameTaken
+	}
+	if errors.Is(err, admin.ErrRoleNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+package adminusecase
+
+import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"errors"
+	"testing"
+)
+
+// newTestRoleUsecase - 管理者リポジトリを Authorizer と共有するユースケースを生成
+func newTestRoleUsecase(adminRepo *mockAdminRepository, roleRepo *mockRoleRepository) (*AdminRoleUsecase, *mockUnitOfWork) {
+	uow := &mockUnitOfWork{admins: adminRepo, roles: roleRepo}
+	return NewAdminRoleUsecase(roleRepo, uow, admin.NewAuthorizer(adminRepo)), uow
+}
+
+func TestCreateRole_GrantsPermissionsAsData(t *testing.T) {
+	adminRepo := newMockAdminRepo()
+	authorizer := admin.NewAuthorizer(adminRepo)
+	uc, uow := newTestRoleUsecase(adminRepo, newMockRoleRepo())
+
+	r, err := uc.CreateRole(RoleInput{
+		Name:        "auditor",
+		NameJa:      "監査担当",
+		Permissions: []string{admin.PermAuditRead, admin.PermCustomerRead, admin.PermAuditRead},
+	}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.ID != 4 || r.IsBuiltIn || len(r.Permissions) != 2 {
+		t.Errorf("unexpected role: %+v", r)
+	}
+	if len(uow.audits) != 1 || uow.audits[0].Action != audit.ActionRoleCreate {
+		t.Errorf("expected role.create audit entry, got %+v", uow.audits)
+	}
+
+	// カスタムロールの管理者は付与された権限だけを持つ
+	adminRepo.admins[5] = &admin.Admin{ID: 5, RoleID: r.ID, Role: r, IsActive: true}
+	if err := authorizer.Authorize(5, admin.PermAuditRead); err != nil {
+		t.Errorf("expected audit:read to be granted, got %v", err)
+	}
+	if err := authorizer.Authorize(5, admin.PermProductWrite); !errors.Is(err, admin.ErrPermissionDenied) {
+		t.Errorf("expected product:write to be denied, got %v", err)
+	}
+	auditUC := NewAdminAuditUsecase(newMockAuditLogRepo(), authorizer)
+	if _, err := auditUC.GetAuditLogs(audit.Actor{AdminID: 5, Role: "auditor"}, audit.Filter{}); err != nil {
+		t.Errorf("expected auditor to read audit logs, got %v", err)
+	}
+
+	if _, err := uc.CreateRole(RoleInput{Name: "auditor", NameJa: "重複"}, testActor); !errors.Is(err, ErrRoleNameTaken) {
+		t.Errorf("expected ErrRoleNameTaken, got %v", err)
+	}
+}
+
+func TestCreateRole_Validation(t *testing.T) {
+	uc, _ := newTestRoleUsecase(newMockAdminRepo(), newMockRoleRepo())
+
+	tests := []struct {
+		name  string
+		input RoleInput
+		want  error
+	}{
+		{"invalid name", RoleInput{Name: "Content Editor", NameJa: "編集者"}, admin.ErrRoleNameInvalid},
+		{"missing nameJa", RoleInput{Name: "editor"}, admin.ErrRoleNameJaRequired},
+		{"unknown permission", RoleInput{Name: "editor", NameJa: "編集者", Permissions: []string{"product:delete"}}, admin.ErrUnknownPermission},
+		{"reserved permission", RoleInput{Name: "editor", NameJa: "編集者", Permissions: []string{admin.PermAdminManage}}, admin.ErrUnknownPermission},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.CreateRole(tt.input, testActor); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if _, err := uc.CreateRole(RoleInput{Name: "editor", NameJa: "編集者"}, audit.Actor{AdminID: 2, Role: admin.RoleAdmin}); !errors.Is(err, ErrAdminForbidden) {
+		t.Errorf("expected ErrAdminForbidden, got %v", err)
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	adminRepo := newMockAdminRepo()
+	roleRepo := newMockRoleRepo()
+	uc, _ := newTestRoleUsecase(adminRepo, roleRepo)
+
+	r, err := uc.UpdateRole(3, RoleInput{
+		Name:        admin.RoleModerator,
+		NameJa:      "モデレーター",
+		Permissions: []string{admin.PermReviewModerate, admin.PermCustomerBan},
+	}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roleRepo.roles[3].Permissions) != 2 || !r.Allows(admin.PermCustomerBan) {
+		t.Errorf("expected moderator to gain customer:ban, got %+v", r.Permissions)
+	}
+
+	if _, err := uc.UpdateRole(3, RoleInput{Name: "mod", NameJa: "モデレーター"}, testActor); !errors.Is(err, admin.ErrRoleBuiltIn) {
+		t.Errorf("expected ErrRoleBuiltIn for rename, got %v", err)
+	}
+	if _, err := uc.UpdateRole(1, RoleInput{Name: admin.RoleSuperAdmin, NameJa: "スーパー管理者"}, testActor); !errors.Is(err, admin.ErrRoleBuiltIn) {
+		t.Errorf("expected ErrRoleBuiltIn for super admin, got %v", err)
+	}
+	if _, err := uc.UpdateRole(99, RoleInput{Name: "x", NameJa: "x"}, testActor); !errors.Is(err, ErrAdminRoleNotFound) {
+		t.Errorf("expected ErrAdminRoleNotFound, got %v", err)
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	adminRepo := newMockAdminRepo()
+	roleRepo := newMockRoleRepo()
+	uc, _ := newTestRoleUsecase(adminRepo, roleRepo)
+
+	if err := uc.DeleteRole(3, testActor); !errors.Is(err, admin.ErrRoleBuiltIn) {
+		t.Errorf("expected ErrRoleBuiltIn, got %v", err)
+	}
+
+	r, err := uc.CreateRole(RoleInput{Name: "editor", NameJa: "編集者", Permissions: []string{admin.PermProductWrite}}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	adminRepo.admins[5] = &admin.Admin{ID: 5, RoleID: r.ID, IsActive: false}
+	if err := uc.DeleteRole(r.ID, testActor); !errors.Is(err, ErrRoleInUse) {
+		t.Errorf("expected ErrRoleInUse, got %v", err)
+	}
+
+	delete(adminRepo.admins, 5)
+	if err := uc.DeleteRole(r.ID, testActor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := roleRepo.roles[r.ID]; ok {
+		t.Error("expected role to be deleted")
+	}
+}
+
+func TestRole_AuditFailureFailsTheChange(t *testing.T) {
+	roleRepo := newMockRoleRepo()
+	uc, uow := newTestRoleUsecase(newMockAdminRepo(), roleRepo)
+	uow.auditErr = errors.New("audit insert failed")
+
+	if _, err := uc.UpdateRole(3, RoleInput{Name: admin.RoleModerator, NameJa: "モデレーター", Permissions: []string{admin.PermReviewModerate}}, testActor); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(uow.audits) != 0 {
+		t.Errorf("expected no committed audit entries, got %+v", uow.audits)
+	}
+}
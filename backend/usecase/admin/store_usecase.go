@@ -0,0 +1,164 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/product"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrStoreInUse     = errors.New("store is linked to products and cannot be deleted")
+	ErrStoreHasOffers = errors.New("store has price records and cannot be deleted; deactivate it instead")
+	// ErrStoreCodeImmutable - ストアコードは商品リンクのURL規則・クリック計測・価格情報のキーのため作成後は変更できない
+	ErrStoreCodeImmutable = errors.New("store code cannot be changed")
+)
+
+// AdminStoreUsecase - 管理者向けストアマスタユースケース
+type AdminStoreUsecase struct {
+	storeRepo product.StoreRepository
+	uow       event.UnitOfWork
+}
+
+// CreateStoreInput - ストア作成の入力
+type CreateStoreInput struct {
+	Code             string
+	Name             string
+	NameJa           string
+	DisplayOrder     int
+	CreatedByAdminID *int64
+}
+
+// UpdateStoreInput - ストア更新の入力（Code は空か現在のコードのみ。コードは変更できない）
+type UpdateStoreInput struct {
+	Code             string
+	Name             string
+	NameJa           string
+	DisplayOrder     int
+	IsActive         bool
+	UpdatedByAdminID *int64
+}
+
+// NewAdminStoreUsecase - 管理者向けストアマスタユースケースの生成
+func NewAdminStoreUsecase(storeRepo product.StoreRepository, uow event.UnitOfWork) *AdminStoreUsecase {
+	return &AdminStoreUsecase{storeRepo: storeRepo, uow: uow}
+}
+
+// GetAllStores - ストア一覧取得（無効化済みを含む）
+func (u *AdminStoreUsecase) GetAllStores() ([]product.Store, error) {
+	return u.storeRepo.FindAll(false)
+}
+
+// CreateStore - ストア作成
+func (u *AdminStoreUsecase) CreateStore(input CreateStoreInput, actor audit.Actor) (*product.Store, error) {
+	code, name, nameJa, err := u.validateStoreFields(input.Code, input.Name, input.NameJa)
+	if err != nil {
+		return nil, err
+	}
+	if existing, err := u.storeRepo.FindByCode(code); err == nil && existing != nil {
+		return nil, fmt.Errorf("code: store %s already exists", code)
+	}
+
+	s := &product.Store{
+		Code:             code,
+		Name:             name,
+		NameJa:           nameJa,
+		DisplayOrder:     input.DisplayOrder,
+		IsActive:         true,
+		CreatedByAdminID: input.CreatedByAdminID,
+	}
+
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Stores().Create(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionStoreCreate, audit.TargetStore, s.ID, nil, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateStore - ストア更新
+func (u *AdminStoreUsecase) UpdateStore(id int64, input UpdateStoreInput, actor audit.Actor) (*product.Store, error) {
+	s, err := u.storeRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	before := *s
+
+	if input.Code != "" {
+		if code, err := product.NewStoreCode(input.Code); err != nil || code != s.Code {
+			return nil, ErrStoreCodeImmutable
+		}
+	}
+	_, name, nameJa, err := u.validateStoreFields(s.Code, input.Name, input.NameJa)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Name = name
+	s.NameJa = nameJa
+	s.DisplayOrder = input.DisplayOrder
+	s.IsActive = input.IsActive
+	s.UpdatedByAdminID = input.UpdatedByAdminID
+
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Stores().Update(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionStoreUpdate, audit.TargetStore, s.ID, before, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteStore - ストア削除（商品リンクが残っている場合は削除せず無効化を促す）
+func (u *AdminStoreUsecase) DeleteStore(id int64, actor audit.Actor) error {
+	s, err := u.storeRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	return u.uow.Do(func(tx event.Tx) error {
+		count, err := tx.Stores().CountProductLinks(id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrStoreInUse
+		}
+		// retailer_offers.store は ON DELETE 指定のない外部キーのため、価格情報が残るストアは削除できない
+		offers, err := tx.Stores().CountOffers(s.Code)
+		if err != nil {
+			return err
+		}
+		if offers > 0 {
+			return ErrStoreHasOffers
+		}
+		if err := tx.Stores().Delete(id); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionStoreDelete, audit.TargetStore, id, s, nil)
+	})
+}
+
+// validateStoreFields - ストアフィールドのバリデーション
+func (u *AdminStoreUsecase) validateStoreFields(code, name, nameJa string) (string, string, string, error) {
+	validCode, err := product.NewStoreCode(code)
+	if err != nil {
+		return "", "", "", fmt.Errorf("code: %w", err)
+	}
+	validName, err := product.NewStoreName(name)
+	if err != nil {
+		return "", "", "", fmt.Errorf("name: %w", err)
+	}
+	validNameJa, err := product.NewStoreName(nameJa)
+	if err != nil {
+		return "", "", "", fmt.Errorf("nameJa: %w", err)
+	}
+	return validCode, validName, validNameJa, nil
+}
@@ -0,0 +1,97 @@
+package adminusecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// newTestStoreUsecase - 書き込みを repo に流す UnitOfWork 付きでユースケースを生成
+func newTestStoreUsecase(repo *mockStoreRepository) *AdminStoreUsecase {
+	return NewAdminStoreUsecase(repo, &mockUnitOfWork{stores: repo})
+}
+
+func TestCreateStore_Success(t *testing.T) {
+	repo := newMockStoreRepo()
+	uc := newTestStoreUsecase(repo)
+
+	s, err := uc.CreateStore(CreateStoreInput{Code: " iHerb ", Name: "iHerb", NameJa: "アイハーブ", DisplayOrder: 4}, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Code != "iherb" {
+		t.Errorf("expected normalized code 'iherb', got %q", s.Code)
+	}
+	if !s.IsActive {
+		t.Error("expected new store to be active")
+	}
+}
+
+func TestCreateStore_ValidationErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   CreateStoreInput
+		wantErr string
+	}{
+		{"不正なコード", CreateStoreInput{Code: "i-herb", Name: "iHerb", NameJa: "アイハーブ"}, "code"},
+		{"重複したコード", CreateStoreInput{Code: "amazon", Name: "Amazon", NameJa: "Amazon"}, "already exists"},
+		{"名前未入力", CreateStoreInput{Code: "oisix", Name: " ", NameJa: "オイシックス"}, "name"},
+		{"日本語名未入力", CreateStoreInput{Code: "oisix", Name: "Oisix", NameJa: ""}, "nameJa"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestStoreUsecase(newMockStoreRepo())
+
+			_, err := uc.CreateStore(tc.input, testActor)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUpdateStore_CodeImmutable(t *testing.T) {
+	uc := newTestStoreUsecase(newMockStoreRepo())
+
+	if _, err := uc.UpdateStore(2, UpdateStoreInput{Code: "amazon", Name: "Rakuten", NameJa: "楽天市場", IsActive: true}, testActor); !errors.Is(err, ErrStoreCodeImmutable) {
+		t.Errorf("expected ErrStoreCodeImmutable, got %v", err)
+	}
+	s, err := uc.UpdateStore(2, UpdateStoreInput{Name: "Rakuten Ichiba", NameJa: "楽天市場", IsActive: true}, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Code != "rakuten" || s.Name != "Rakuten Ichiba" {
+		t.Errorf("expected code to be kept, got %+v", s)
+	}
+}
+
+func TestDeleteStore_InUse(t *testing.T) {
+	repo := newMockStoreRepo()
+	repo.linkCounts[1] = 3
+	uc := newTestStoreUsecase(repo)
+
+	if err := uc.DeleteStore(1, testActor); !errors.Is(err, ErrStoreInUse) {
+		t.Errorf("expected ErrStoreInUse, got %v", err)
+	}
+	if len(repo.deleted) != 0 {
+		t.Error("expected store not to be deleted")
+	}
+
+	// 価格情報が残るストアも削除できない
+	repo.offerCounts["rakuten"] = 1
+	if err := uc.DeleteStore(2, testActor); !errors.Is(err, ErrStoreHasOffers) {
+		t.Errorf("expected ErrStoreHasOffers, got %v", err)
+	}
+	if len(repo.deleted) != 0 {
+		t.Error("expected store not to be deleted")
+	}
+
+	delete(repo.offerCounts, "rakuten")
+	if err := uc.DeleteStore(2, testActor); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.deleted) != 1 || repo.deleted[0] != 2 {
+		t.Errorf("expected store 2 to be deleted, got %v", repo.deleted)
+	}
+}
@@ -0,0 +1,158 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/submission"
+	"time"
+)
+
+// normalizeBatchSize - 正規化した名前を1回のクエリで設定する商品数
+const normalizeBatchSize = 500
+
+// AdminSubmissionUsecase - 管理者向け新商品の提案の審査ユースケース
+type AdminSubmissionUsecase struct {
+	submissionRepo submission.SubmissionRepository
+	products       *AdminProductUsecase
+	uow            event.UnitOfWork
+	now            func() time.Time
+}
+
+// NewAdminSubmissionUsecase - 管理者向け新商品の提案の審査ユースケースの生成（承認時の商品登録は products と同じバリデーションで行う）
+func NewAdminSubmissionUsecase(submissionRepo submission.SubmissionRepository, products *AdminProductUsecase, uow event.UnitOfWork) *AdminSubmissionUsecase {
+	return &AdminSubmissionUsecase{
+		submissionRepo: submissionRepo,
+		products:       products,
+		uow:            uow,
+		now:            time.Now,
+	}
+}
+
+// GetSubmissions - ステータスごとの提案一覧（古い順、空なら審査待ち）。審査待ちには重複候補を含める
+func (u *AdminSubmissionUsecase) GetSubmissions(status string) ([]submission.Submission, error) {
+	if status == "" {
+		status = submission.StatusPending
+	}
+	status, err := submission.NewStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions, err := u.submissionRepo.FindByStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	if status != submission.StatusPending {
+		return submissions, nil
+	}
+	for i := range submissions {
+		matches, err := u.submissionRepo.FindProductsByName(submissions[i].Name, submissions[i].NameJa)
+		if err != nil {
+			return nil, err
+		}
+		submissions[i].PossibleDuplicates = matches
+	}
+	return submissions, nil
+}
+
+// ApproveSubmission - 提案を承認して商品を登録（input の空の項目は提案の内容を使い、管理者が編集した項目は input を優先）
+func (u *AdminSubmissionUsecase) ApproveSubmission(id int64, input CreateProductInput, actor audit.Actor) (*submission.Submission, error) {
+	var s *submission.Submission
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if s, err = tx.Submissions().FindByIDForUpdate(id); err != nil {
+			return err
+		}
+		if !s.IsPending() {
+			return submission.ErrNotPending
+		}
+		before := *s
+
+		p, err := u.products.buildProduct(u.approvalInput(s, input, actor))
+		if err != nil {
+			return err
+		}
+		matches, err := tx.Submissions().FindProductsByName(p.Name, p.NameJa)
+		if err != nil {
+			return err
+		}
+		if len(matches) > 0 {
+			return submission.ErrDuplicateProduct
+		}
+
+		if err := createProduct(tx, p, actor); err != nil {
+			return err
+		}
+		if err := s.Approve(p.ID, actor.AdminID, u.now()); err != nil {
+			return err
+		}
+		if err := tx.Submissions().Update(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionSubmissionApprove, audit.TargetSubmission, s.ID, before, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// approvalInput - 省略された項目を提案の内容で補う（購入リンクを指定しなければ提案の店舗URLを、既知のストアならストアリンク、それ以外はアフィリエイトURLとして使う）
+func (u *AdminSubmissionUsecase) approvalInput(s *submission.Submission, input CreateProductInput, actor audit.Actor) CreateProductInput {
+	input.Name = firstNonEmpty(input.Name, s.Name)
+	input.NameJa = firstNonEmpty(input.NameJa, s.NameJa)
+	input.Description = firstNonEmpty(input.Description, s.Description)
+	input.DescriptionJa = firstNonEmpty(input.DescriptionJa, s.DescriptionJa)
+	input.ImageURL = firstNonEmpty(input.ImageURL, s.ImageURL)
+	if s.StoreURL != nil && len(input.StoreLinks) == 0 && input.AffiliateURL == nil {
+		if code, ok := u.products.urlRules.StoreCodeFor(*s.StoreURL); ok {
+			input.StoreLinks = []StoreLinkInput{{StoreCode: code, URL: *s.StoreURL}}
+		} else {
+			input.AffiliateURL = s.StoreURL
+		}
+	}
+	input.CreatedByAdminID = &actor.AdminID
+	return input
+}
+
+// RejectSubmission - 理由を付けて提案を却下
+func (u *AdminSubmissionUsecase) RejectSubmission(id int64, reason string, actor audit.Actor) (*submission.Submission, error) {
+	var s *submission.Submission
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if s, err = tx.Submissions().FindByIDForUpdate(id); err != nil {
+			return err
+		}
+		before := *s
+		if err := s.Reject(reason, actor.AdminID, u.now()); err != nil {
+			return err
+		}
+		if err := tx.Submissions().Update(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionSubmissionReject, audit.TargetSubmission, s.ID, before, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// NormalizeProductNames - 正規化した名前が未設定の既存商品に設定する（起動時に1回実行し、設定した件数を返す）
+func (u *AdminSubmissionUsecase) NormalizeProductNames() (int, error) {
+	total := 0
+	for {
+		n, err := u.submissionRepo.BackfillNormalizedNames(normalizeBatchSize)
+		total += n
+		if err != nil || n < normalizeBatchSize {
+			return total, err
+		}
+	}
+}
+
+func firstNonEmpty(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,219 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/product"
+	"backend/domain/submission"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockSubmissionRepo - 提案リポジトリモック（FindProductsByName は products から正規化した名前で探す）
+type mockSubmissionRepo struct {
+	submissions  map[int64]*submission.Submission
+	products     []submission.ProductMatch
+	findErr      error
+	unnormalized int
+	backfilled   []int
+}
+
+func (m *mockSubmissionRepo) FindByStatus(status string) ([]submission.Submission, error) {
+	var result []submission.Submission
+	for id := int64(1); id <= int64(len(m.submissions)); id++ {
+		if s, ok := m.submissions[id]; ok && s.Status == status {
+			result = append(result, *s)
+		}
+	}
+	return result, nil
+}
+func (m *mockSubmissionRepo) FindByCustomerID(_ int64) ([]submission.Submission, error) {
+	return nil, nil
+}
+func (m *mockSubmissionRepo) FindByID(id int64) (*submission.Submission, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	if s, ok := m.submissions[id]; ok {
+		copied := *s
+		return &copied, nil
+	}
+	return nil, submission.ErrSubmissionNotFound
+}
+func (m *mockSubmissionRepo) FindByIDForUpdate(id int64) (*submission.Submission, error) {
+	return m.FindByID(id)
+}
+func (m *mockSubmissionRepo) CountPending(_ int64) (int64, error)   { return 0, nil }
+func (m *mockSubmissionRepo) Create(_ *submission.Submission) error { return nil }
+func (m *mockSubmissionRepo) Update(s *submission.Submission) error {
+	copied := *s
+	m.submissions[s.ID] = &copied
+	return nil
+}
+func (m *mockSubmissionRepo) FindProductsByName(name, nameJa string) ([]submission.ProductMatch, error) {
+	var result []submission.ProductMatch
+	for _, p := range m.products {
+		if product.NormalizeName(p.Name) == product.NormalizeName(name) || product.NormalizeName(p.NameJa) == product.NormalizeName(nameJa) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+func (m *mockSubmissionRepo) BackfillNormalizedNames(limit int) (int, error) {
+	n := min(limit, m.unnormalized)
+	m.unnormalized -= n
+	m.backfilled = append(m.backfilled, n)
+	return n, nil
+}
+
+func newTestSubmissionUsecase() (*AdminSubmissionUsecase, *mockSubmissionRepo, *mockUnitOfWork) {
+	submissions := &mockSubmissionRepo{
+		submissions: map[int64]*submission.Submission{
+			1: {ID: 1, CustomerID: 7, Name: "Soy Meat", NameJa: "大豆ミート", Description: "Dried soy meat", DescriptionJa: "乾燥大豆ミート", ImageURL: "https://example.com/soy.jpg", Status: submission.StatusPending},
+			2: {ID: 2, CustomerID: 8, Name: "Oat Milk", NameJa: "オーツミルク", Description: "Barista oat milk", DescriptionJa: "バリスタ向けオーツミルク", ImageURL: "https://example.com/oat.jpg", Status: submission.StatusPending},
+		},
+		products: []submission.ProductMatch{{ProductID: 3, Name: "OAT-MILK", NameJa: "オーツ ミルク"}},
+	}
+	products := &mockProductRepository{createFn: func(p *product.Product) error {
+		p.ID = 50
+		return nil
+	}}
+	uow := &mockUnitOfWork{products: products, submissions: submissions}
+	productUsecase := NewAdminProductUsecase(products, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{}, uow)
+	uc := NewAdminSubmissionUsecase(submissions, productUsecase, uow)
+	uc.now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+	return uc, submissions, uow
+}
+
+func TestGetSubmissions_PossibleDuplicates(t *testing.T) {
+	uc, _, _ := newTestSubmissionUsecase()
+
+	pending, err := uc.GetSubmissions("")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pending) != 2 || len(pending[0].PossibleDuplicates) != 0 || len(pending[1].PossibleDuplicates) != 1 || pending[1].PossibleDuplicates[0].ProductID != 3 {
+		t.Errorf("expected the oat milk submission to be flagged as a duplicate, got %+v", pending)
+	}
+	if _, err := uc.GetSubmissions("unknown"); !errors.Is(err, submission.ErrStatusInvalid) {
+		t.Errorf("expected ErrStatusInvalid, got %v", err)
+	}
+}
+
+func TestApproveSubmission_WithEdits(t *testing.T) {
+	uc, submissions, uow := newTestSubmissionUsecase()
+
+	s, err := uc.ApproveSubmission(1, CreateProductInput{Name: "Soy Meat Mince", CategoryIDs: []int64{1}}, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Status != submission.StatusApproved || s.ProductID == nil || *s.ProductID != 50 || s.ReviewedByAdminID == nil {
+		t.Errorf("expected approved submission linked to the product, got %+v", s)
+	}
+	if len(uow.audits) != 2 || uow.audits[0].Action != audit.ActionProductCreate || uow.audits[1].Action != audit.ActionSubmissionApprove {
+		t.Errorf("expected product.create and submission.approve audit entries, got %+v", uow.audits)
+	}
+	if submissions.submissions[1].Status != submission.StatusApproved {
+		t.Error("expected approval to be saved")
+	}
+	if _, err := uc.ApproveSubmission(1, CreateProductInput{}, testActor); !errors.Is(err, submission.ErrNotPending) {
+		t.Errorf("expected ErrNotPending, got %v", err)
+	}
+
+	// 既存商品と重複する名前のままでは承認できない
+	if _, err := uc.ApproveSubmission(2, CreateProductInput{}, testActor); !errors.Is(err, submission.ErrDuplicateProduct) {
+		t.Errorf("expected ErrDuplicateProduct, got %v", err)
+	}
+	if _, err := uc.ApproveSubmission(2, CreateProductInput{NameJa: "大豆"}, testActor); !errors.Is(err, submission.ErrDuplicateProduct) {
+		t.Errorf("expected ErrDuplicateProduct, got %v", err)
+	}
+}
+
+func TestRejectSubmission(t *testing.T) {
+	uc, _, uow := newTestSubmissionUsecase()
+
+	if _, err := uc.RejectSubmission(2, "  ", testActor); !errors.Is(err, submission.ErrRejectionReasonRequired) {
+		t.Errorf("expected ErrRejectionReasonRequired, got %v", err)
+	}
+	s, err := uc.RejectSubmission(2, "既に登録されている商品です", testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Status != submission.StatusRejected || s.RejectionReason != "既に登録されている商品です" {
+		t.Errorf("expected rejected submission, got %+v", s)
+	}
+	if len(uow.audits) != 1 || uow.audits[0].Action != audit.ActionSubmissionReject {
+		t.Errorf("expected submission.reject audit entry, got %+v", uow.audits)
+	}
+	if _, err := uc.RejectSubmission(2, "重複", testActor); !errors.Is(err, submission.ErrNotPending) {
+		t.Errorf("expected ErrNotPending, got %v", err)
+	}
+	if _, err := uc.RejectSubmission(999, "重複", testActor); !errors.Is(err, submission.ErrSubmissionNotFound) {
+		t.Errorf("expected ErrSubmissionNotFound, got %v", err)
+	}
+}
+
+func TestApproveSubmission_CarriesStoreURL(t *testing.T) {
+	testCases := []struct {
+		name          string
+		storeURL      string
+		wantStore     string
+		wantAffiliate bool
+	}{
+		{"既知のストア", "https://www.amazon.co.jp/dp/B0TESTASIN?ref=x", "amazon", false},
+		{"その他の店舗", "https://shop.example.com/soy-meat", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, submissions, uow := newTestSubmissionUsecase()
+			submissions.submissions[1].StoreURL = &tc.storeURL
+			var created *product.Product
+			uow.products.(*mockProductRepository).createFn = func(p *product.Product) error {
+				p.ID = 50
+				created = p
+				return nil
+			}
+
+			if _, err := uc.ApproveSubmission(1, CreateProductInput{}, testActor); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tc.wantStore != "" {
+				if len(created.StoreLinks) != 1 || created.StoreLinks[0].Store.Code != tc.wantStore {
+					t.Errorf("expected a %s store link, got %+v", tc.wantStore, created.StoreLinks)
+				}
+			} else if len(created.StoreLinks) != 0 {
+				t.Errorf("expected no store links, got %+v", created.StoreLinks)
+			}
+			if got := created.AffiliateURL != nil && *created.AffiliateURL == tc.storeURL; got != tc.wantAffiliate {
+				t.Errorf("expected affiliate URL %v, got %v", tc.wantAffiliate, created.AffiliateURL)
+			}
+		})
+	}
+}
+
+func TestSubmission_LookupErrorsAreReturned(t *testing.T) {
+	uc, submissions, _ := newTestSubmissionUsecase()
+	dbErr := errors.New("connection refused")
+	submissions.findErr = dbErr
+
+	if _, err := uc.ApproveSubmission(1, CreateProductInput{}, testActor); !errors.Is(err, dbErr) {
+		t.Errorf("expected the lookup error, got %v", err)
+	}
+	if _, err := uc.RejectSubmission(1, "重複", testActor); !errors.Is(err, dbErr) {
+		t.Errorf("expected the lookup error, got %v", err)
+	}
+}
+
+func TestNormalizeProductNames_Batches(t *testing.T) {
+	uc, submissions, _ := newTestSubmissionUsecase()
+	submissions.unnormalized = normalizeBatchSize + 20
+
+	n, err := uc.NormalizeProductNames()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if n != normalizeBatchSize+20 || len(submissions.backfilled) != 2 {
+		t.Errorf("expected %d products in 2 batches, got %d in %v", normalizeBatchSize+20, n, submissions.backfilled)
+	}
+}
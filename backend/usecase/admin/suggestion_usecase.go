@@ -0,0 +1,191 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/product"
+	"backend/domain/suggestion"
+	"time"
+)
+
+// AdminSuggestionUsecase - 管理者向け商品情報の修正提案の審査ユースケース
+type AdminSuggestionUsecase struct {
+	suggestionRepo suggestion.SuggestionRepository
+	products       *AdminProductUsecase
+	uow            event.UnitOfWork
+	now            func() time.Time
+}
+
+// NewAdminSuggestionUsecase - 管理者向け商品情報の修正提案の審査ユースケースの生成（承認時の商品更新は products の UpdateProduct と同じ処理で行う）
+func NewAdminSuggestionUsecase(suggestionRepo suggestion.SuggestionRepository, products *AdminProductUsecase, uow event.UnitOfWork) *AdminSuggestionUsecase {
+	return &AdminSuggestionUsecase{
+		suggestionRepo: suggestionRepo,
+		products:       products,
+		uow:            uow,
+		now:            time.Now,
+	}
+}
+
+// GetSuggestions - ステータスごとの修正提案一覧（古い順、空なら審査待ち）。審査待ちには現在の商品との比較を含める
+func (u *AdminSuggestionUsecase) GetSuggestions(status string) ([]suggestion.Suggestion, error) {
+	if status == "" {
+		status = suggestion.StatusPending
+	}
+	status, err := suggestion.NewStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := u.suggestionRepo.FindByStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	if status != suggestion.StatusPending {
+		return suggestions, nil
+	}
+	for i := range suggestions {
+		if err := u.fillComparison(&suggestions[i]); err != nil {
+			return nil, err
+		}
+	}
+	return suggestions, nil
+}
+
+// GetSuggestion - 修正提案の詳細（現在の商品との項目ごとの比較を含む）
+func (u *AdminSuggestionUsecase) GetSuggestion(id int64) (*suggestion.Suggestion, error) {
+	s, err := u.suggestionRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.fillComparison(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ApproveSuggestion - 修正提案を承認して商品を更新（fields の項目だけを反映、空なら全項目）。商品の更新は提案者を記録して UpdateProduct と同じ処理で行う
+func (u *AdminSuggestionUsecase) ApproveSuggestion(id int64, fields []string, actor audit.Actor) (*suggestion.Suggestion, error) {
+	var s *suggestion.Suggestion
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if s, err = tx.Suggestions().FindByIDForUpdate(id); err != nil {
+			return err
+		}
+		if !s.IsPending() {
+			return suggestion.ErrNotPending
+		}
+		p, err := tx.Products().FindByIDForUpdate(s.ProductID)
+		if err != nil {
+			return err
+		}
+		before := *s
+		before.Changes = append([]suggestion.Change(nil), s.Changes...)
+
+		if err := s.Approve(p, fields, actor.AdminID, u.now()); err != nil {
+			return err
+		}
+		input := approvalInput(p, s.AppliedChanges())
+		input.UpdatedByAdminID = &actor.AdminID
+		if err := u.products.updateProduct(tx, p, input, actor, s); err != nil {
+			return err
+		}
+		if err := tx.Suggestions().Update(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionSuggestionApprove, audit.TargetSuggestion, s.ID, before, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RejectSuggestion - 理由を付けて修正提案を却下
+func (u *AdminSuggestionUsecase) RejectSuggestion(id int64, reason string, actor audit.Actor) (*suggestion.Suggestion, error) {
+	var s *suggestion.Suggestion
+	err := u.uow.Do(func(tx event.Tx) error {
+		var err error
+		if s, err = tx.Suggestions().FindByIDForUpdate(id); err != nil {
+			return err
+		}
+		before := *s
+		if err := s.Reject(reason, actor.AdminID, u.now()); err != nil {
+			return err
+		}
+		if err := tx.Suggestions().Update(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionSuggestionReject, audit.TargetSuggestion, s.ID, before, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (u *AdminSuggestionUsecase) fillComparison(s *suggestion.Suggestion) error {
+	p, err := u.products.GetProduct(s.ProductID)
+	if err != nil {
+		return err
+	}
+	s.Comparison = s.Compare(p)
+	return nil
+}
+
+// approvalInput - 現在の商品に承認した変更案を重ねた更新入力（食事属性・認証情報・栄養成分は省略して既存の値を残す）
+func approvalInput(p *product.Product, changes []suggestion.Change) UpdateProductInput {
+	input := UpdateProductInput{
+		Name:          p.Name,
+		NameJa:        p.NameJa,
+		Description:   p.Description,
+		DescriptionJa: p.DescriptionJa,
+		ImageURL:      p.ImageURL,
+		AffiliateURL:  p.AffiliateURL,
+		StoreLinks:    storeLinkInputsFrom(p.StoreLinks),
+	}
+	for _, c := range p.Categories {
+		input.CategoryIDs = append(input.CategoryIDs, c.ID)
+	}
+	for _, c := range changes {
+		applySuggestedChange(&input, c)
+	}
+	return input
+}
+
+// applySuggestedChange - 変更案を更新入力に反映（ストアリンクは置き換え・追加・削除）
+func applySuggestedChange(input *UpdateProductInput, c suggestion.Change) {
+	switch c.Field {
+	case suggestion.FieldName:
+		input.Name = c.Proposed
+	case suggestion.FieldNameJa:
+		input.NameJa = c.Proposed
+	case suggestion.FieldDescription:
+		input.Description = c.Proposed
+	case suggestion.FieldDescriptionJa:
+		input.DescriptionJa = c.Proposed
+	case suggestion.FieldImageURL:
+		input.ImageURL = c.Proposed
+	case suggestion.FieldAffiliateURL:
+		if c.Proposed == "" {
+			input.AffiliateURL = nil
+		} else {
+			url := c.Proposed
+			input.AffiliateURL = &url
+		}
+	default:
+		code, ok := suggestion.StoreCodeOf(c.Field)
+		if !ok {
+			return
+		}
+		links := make([]StoreLinkInput, 0, len(input.StoreLinks)+1)
+		for _, link := range input.StoreLinks {
+			if link.StoreCode != code {
+				links = append(links, link)
+			}
+		}
+		if c.Proposed != "" {
+			links = append(links, StoreLinkInput{StoreCode: code, URL: c.Proposed})
+		}
+		input.StoreLinks = links
+	}
+}
@@ -0,0 +1,226 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/product"
+	"backend/domain/suggestion"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockSuggestionRepo - 修正提案リポジトリモック
+type mockSuggestionRepo struct {
+	suggestions map[int64]*suggestion.Suggestion
+}
+
+func (m *mockSuggestionRepo) FindByStatus(status string) ([]suggestion.Suggestion, error) {
+	var result []suggestion.Suggestion
+	for id := int64(1); id <= int64(len(m.suggestions)); id++ {
+		if s, ok := m.suggestions[id]; ok && s.Status == status {
+			result = append(result, *s)
+		}
+	}
+	return result, nil
+}
+func (m *mockSuggestionRepo) FindByCustomerID(_ int64) ([]suggestion.Suggestion, error) {
+	return nil, nil
+}
+func (m *mockSuggestionRepo) FindByID(id int64) (*suggestion.Suggestion, error) {
+	if s, ok := m.suggestions[id]; ok {
+		copied := *s
+		copied.Changes = append([]suggestion.Change(nil), s.Changes...)
+		return &copied, nil
+	}
+	return nil, suggestion.ErrSuggestionNotFound
+}
+func (m *mockSuggestionRepo) FindByIDForUpdate(id int64) (*suggestion.Suggestion, error) {
+	return m.FindByID(id)
+}
+func (m *mockSuggestionRepo) CountPending(_ int64) (int64, error)   { return 0, nil }
+func (m *mockSuggestionRepo) Create(_ *suggestion.Suggestion) error { return nil }
+func (m *mockSuggestionRepo) Update(s *suggestion.Suggestion) error {
+	copied := *s
+	m.suggestions[s.ID] = &copied
+	return nil
+}
+
+// testSuggestedProduct - 修正提案の対象商品（Amazon のリンクあり）
+func testSuggestedProduct() *product.Product {
+	return &product.Product{
+		ID:            10,
+		Name:          "Soy Meat",
+		NameJa:        "大豆ミート",
+		Description:   "Contains wheat",
+		DescriptionJa: "小麦を含む",
+		ImageURL:      "https://example.com/soy.jpg",
+		StoreLinks: []product.StoreLink{
+			{StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon"}, URL: "https://www.amazon.co.jp/dp/B000000001"},
+		},
+		Categories: []product.Category{{ID: 2}},
+		Nutrition:  &product.Nutrition{ProductID: 10, ProteinGrams: 20},
+	}
+}
+
+func newTestSuggestionUsecase() (*AdminSuggestionUsecase, *mockSuggestionRepo, *mockProductRepository, *mockUnitOfWork) {
+	suggestions := &mockSuggestionRepo{suggestions: map[int64]*suggestion.Suggestion{
+		1: {ID: 1, ProductID: 10, CustomerID: 7, Status: suggestion.StatusPending, Changes: []suggestion.Change{
+			{ID: 1, Field: suggestion.FieldDescription, Original: "Contains wheat", Proposed: "Gluten free"},
+			{ID: 2, Field: suggestion.FieldNameJa, Original: "大豆ミート", Proposed: "ソイミート"},
+			{ID: 3, Field: suggestion.StoreLinkField("rakuten"), Original: "", Proposed: "https://item.rakuten.co.jp/shop/soy/"},
+		}},
+	}}
+	products := &mockProductRepository{findByIDFn: func(id int64) (*product.Product, error) {
+		if id != 10 {
+			return nil, product.ErrProductNotFound
+		}
+		return testSuggestedProduct(), nil
+	}}
+	uow := &mockUnitOfWork{products: products, suggestions: suggestions}
+	productUsecase := NewAdminProductUsecase(products, &mockCategoryRepository{}, newMockStoreRepo(), product.StoreURLRules{}, uow)
+	uc := NewAdminSuggestionUsecase(suggestions, productUsecase, uow)
+	uc.now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+	return uc, suggestions, products, uow
+}
+
+func TestGetSuggestion_Comparison(t *testing.T) {
+	uc, suggestions, _, _ := newTestSuggestionUsecase()
+	// 提案後に管理者が日本語名を変更した
+	suggestions.suggestions[1].Changes[1].Original = "大豆ミート（旧）"
+
+	s, err := uc.GetSuggestion(1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(s.Comparison) != 3 {
+		t.Fatalf("expected 3 compared fields, got %+v", s.Comparison)
+	}
+	if c := s.Comparison[0]; c.Current != "Contains wheat" || c.Proposed != "Gluten free" || c.Outdated {
+		t.Errorf("unexpected description comparison: %+v", c)
+	}
+	if c := s.Comparison[1]; c.Current != "大豆ミート" || !c.Outdated {
+		t.Errorf("expected nameJa to be outdated, got %+v", c)
+	}
+	if _, err := uc.GetSuggestion(99); !errors.Is(err, suggestion.ErrSuggestionNotFound) {
+		t.Errorf("expected ErrSuggestionNotFound, got %v", err)
+	}
+}
+
+func TestApproveSuggestion_SelectedFields(t *testing.T) {
+	uc, suggestions, products, uow := newTestSuggestionUsecase()
+	var saved *product.Product
+	products.updateFn = func(p *product.Product) error {
+		saved = p
+		return nil
+	}
+
+	if _, err := uc.ApproveSuggestion(1, []string{"imageUrl"}, testActor); !errors.Is(err, suggestion.ErrFieldNotSuggested) {
+		t.Errorf("expected ErrFieldNotSuggested, got %v", err)
+	}
+
+	s, err := uc.ApproveSuggestion(1, []string{suggestion.FieldDescription, suggestion.StoreLinkField("rakuten")}, testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Status != suggestion.StatusApproved || !s.Changes[0].Applied || s.Changes[1].Applied || !s.Changes[2].Applied {
+		t.Errorf("expected only the selected changes to be applied, got %+v", s)
+	}
+	if saved == nil || saved.Description != "Gluten free" || saved.NameJa != "大豆ミート" || len(saved.StoreLinks) != 2 || len(saved.Categories) != 1 || saved.Nutrition == nil {
+		t.Fatalf("expected product to keep unselected fields and gain the rakuten link, got %+v", saved)
+	}
+	if saved.UpdatedByAdminID == nil || *saved.UpdatedByAdminID != testActor.AdminID {
+		t.Errorf("expected product to be updated by the admin, got %v", saved.UpdatedByAdminID)
+	}
+	updated, ok := uow.events[0].(event.ProductUpdated)
+	if !ok || updated.SuggestionID == nil || *updated.SuggestionID != 1 || updated.SuggestedByCustomerID == nil || *updated.SuggestedByCustomerID != 7 {
+		t.Errorf("expected ProductUpdated attributed to the suggestion, got %+v", uow.events)
+	}
+	if len(uow.audits) != 2 || uow.audits[0].Action != audit.ActionProductUpdate || uow.audits[1].Action != audit.ActionSuggestionApprove {
+		t.Errorf("expected product.update and suggestion.approve audit entries, got %+v", uow.audits)
+	}
+	if suggestions.suggestions[1].Status != suggestion.StatusApproved {
+		t.Error("expected approval to be saved")
+	}
+	if _, err := uc.ApproveSuggestion(1, nil, testActor); !errors.Is(err, suggestion.ErrNotPending) {
+		t.Errorf("expected ErrNotPending, got %v", err)
+	}
+}
+
+func TestApproveSuggestion_OutdatedField(t *testing.T) {
+	uc, suggestions, products, uow := newTestSuggestionUsecase()
+	var saved *product.Product
+	products.updateFn = func(p *product.Product) error {
+		saved = p
+		return nil
+	}
+	// 提案後に管理者が日本語名を変更した
+	suggestions.suggestions[1].Changes[1].Original = "大豆ミート（旧）"
+
+	if _, err := uc.ApproveSuggestion(1, nil, testActor); !errors.Is(err, suggestion.ErrFieldOutdated) {
+		t.Fatalf("expected ErrFieldOutdated, got %v", err)
+	}
+	if saved != nil || len(uow.audits) != 0 || suggestions.suggestions[1].Status != suggestion.StatusPending {
+		t.Fatalf("expected nothing to be saved, got product %+v and audits %+v", saved, uow.audits)
+	}
+
+	if _, err := uc.ApproveSuggestion(1, []string{suggestion.FieldNameJa}, testActor); err != nil {
+		t.Fatalf("expected explicitly selected outdated field to be applied, got %v", err)
+	}
+	if saved == nil || saved.NameJa != "ソイミート" || saved.Description != "Contains wheat" {
+		t.Errorf("expected only nameJa to be applied, got %+v", saved)
+	}
+}
+
+func TestApproveSuggestion_ProductNotFound(t *testing.T) {
+	uc, suggestions, _, _ := newTestSuggestionUsecase()
+	suggestions.suggestions[1].ProductID = 99
+
+	if _, err := uc.ApproveSuggestion(1, nil, testActor); !errors.Is(err, product.ErrProductNotFound) {
+		t.Errorf("expected ErrProductNotFound, got %v", err)
+	}
+	if _, err := uc.ApproveSuggestion(99, nil, testActor); !errors.Is(err, suggestion.ErrSuggestionNotFound) {
+		t.Errorf("expected ErrSuggestionNotFound, got %v", err)
+	}
+}
+
+func TestApprovalInput_StoreLinks(t *testing.T) {
+	p := testSuggestedProduct()
+	p.AffiliateURL = &p.ImageURL
+
+	input := approvalInput(p, []suggestion.Change{{Field: suggestion.StoreLinkField("amazon"), Proposed: "https://www.amazon.co.jp/dp/B000000002"}})
+	if len(input.StoreLinks) != 1 || input.StoreLinks[0].URL != "https://www.amazon.co.jp/dp/B000000002" {
+		t.Errorf("expected amazon link to be replaced, got %+v", input.StoreLinks)
+	}
+	if len(input.CategoryIDs) != 1 || input.DietaryAttributes != nil || input.Certifications != nil || input.Nutrition != nil {
+		t.Errorf("expected categories to be kept and dietary info and nutrition to be omitted, got %+v", input)
+	}
+	input = approvalInput(p, []suggestion.Change{
+		{Field: suggestion.StoreLinkField("amazon"), Proposed: ""},
+		{Field: suggestion.FieldAffiliateURL, Proposed: ""},
+	})
+	if len(input.StoreLinks) != 0 || input.AffiliateURL != nil {
+		t.Errorf("expected amazon link and affiliate URL to be removed, got %+v", input)
+	}
+}
+
+func TestRejectSuggestion(t *testing.T) {
+	uc, suggestions, _, uow := newTestSuggestionUsecase()
+
+	if _, err := uc.RejectSuggestion(1, "", testActor); !errors.Is(err, suggestion.ErrRejectionReasonRequired) {
+		t.Errorf("expected ErrRejectionReasonRequired, got %v", err)
+	}
+	s, err := uc.RejectSuggestion(1, "パッケージの表示と一致しません", testActor)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Status != suggestion.StatusRejected || suggestions.suggestions[1].RejectionReason == "" {
+		t.Errorf("expected rejected suggestion with reason, got %+v", s)
+	}
+	if len(uow.audits) != 1 || uow.audits[0].TargetType != audit.TargetSuggestion {
+		t.Errorf("expected suggestion.reject audit entry, got %+v", uow.audits)
+	}
+	if len(uow.events) != 0 {
+		t.Errorf("expected no product events, got %+v", uow.events)
+	}
+}
@@ -0,0 +1,296 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/webhook"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// webhookBatchSize - 1回の送信ジョブで処理する配信数の上限
+const webhookBatchSize = 50
+
+var (
+	ErrDeliveryInProgress = errors.New("webhook delivery is still pending")
+	ErrWebhookInactive    = errors.New("webhook is inactive")
+	ErrDeliveryStatus     = errors.New("status must be one of pending, succeeded, dead")
+)
+
+// AdminWebhookUsecase - 管理者向け Webhook ユースケース（購読管理・イベントの振り分け・送信）
+type AdminWebhookUsecase struct {
+	webhookRepo webhook.WebhookRepository
+	uow         webhook.UnitOfWork
+	sender      webhook.Sender
+	now         func() time.Time
+}
+
+// CreateWebhookInput - Webhook 作成の入力（Secret が空なら生成）
+type CreateWebhookInput struct {
+	Name             string
+	URL              string
+	Secret           string
+	EventTypes       []string
+	ProductIDs       []int64
+	CreatedByAdminID *int64
+}
+
+// UpdateWebhookInput - Webhook 更新の入力（Secret が空なら変更しない）
+type UpdateWebhookInput struct {
+	Name             string
+	URL              string
+	Secret           string
+	EventTypes       []string
+	ProductIDs       []int64
+	IsActive         bool
+	UpdatedByAdminID *int64
+}
+
+// CreatedWebhook - 作成した Webhook（シークレットはこの応答でのみ返す）
+type CreatedWebhook struct {
+	*webhook.Subscription
+	Secret string `json:"secret"`
+}
+
+// DeliverySummary - 送信結果
+type DeliverySummary struct {
+	Delivered int `json:"delivered"`
+	Retrying  int `json:"retrying"`
+	Dead      int `json:"dead"`
+}
+
+// NewAdminWebhookUsecase - 管理者向け Webhook ユースケースの生成
+func NewAdminWebhookUsecase(webhookRepo webhook.WebhookRepository, uow webhook.UnitOfWork, sender webhook.Sender) *AdminWebhookUsecase {
+	return &AdminWebhookUsecase{
+		webhookRepo: webhookRepo,
+		uow:         uow,
+		sender:      sender,
+		now:         time.Now,
+	}
+}
+
+// GetAllWebhooks - Webhook 一覧取得
+func (u *AdminWebhookUsecase) GetAllWebhooks() ([]webhook.Subscription, error) {
+	return u.webhookRepo.FindAll()
+}
+
+// CreateWebhook - Webhook 作成
+func (u *AdminWebhookUsecase) CreateWebhook(input CreateWebhookInput, actor audit.Actor) (*CreatedWebhook, error) {
+	name, url, eventTypes, productIDs, err := u.validateWebhookFields(input.Name, input.URL, input.EventTypes, input.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := webhook.NewSecret(input.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("secret: %w", err)
+	}
+
+	s := &webhook.Subscription{
+		Name:             name,
+		URL:              url,
+		Secret:           secret,
+		EventTypes:       eventTypes,
+		ProductIDs:       productIDs,
+		IsActive:         true,
+		CreatedByAdminID: input.CreatedByAdminID,
+	}
+	err = u.uow.Do(func(tx webhook.Tx) error {
+		if err := tx.Webhooks().Create(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionWebhookCreate, audit.TargetWebhook, s.ID, nil, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreatedWebhook{Subscription: s, Secret: secret}, nil
+}
+
+// UpdateWebhook - Webhook 更新
+func (u *AdminWebhookUsecase) UpdateWebhook(id int64, input UpdateWebhookInput, actor audit.Actor) (*webhook.Subscription, error) {
+	s, err := u.webhookRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	before := *s
+
+	name, url, eventTypes, productIDs, err := u.validateWebhookFields(input.Name, input.URL, input.EventTypes, input.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+	if input.Secret != "" {
+		secret, err := webhook.NewSecret(input.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("secret: %w", err)
+		}
+		s.Secret = secret
+	}
+
+	s.Name = name
+	s.URL = url
+	s.EventTypes = eventTypes
+	s.ProductIDs = productIDs
+	s.IsActive = input.IsActive
+	s.UpdatedByAdminID = input.UpdatedByAdminID
+
+	err = u.uow.Do(func(tx webhook.Tx) error {
+		if err := tx.Webhooks().Update(s); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionWebhookUpdate, audit.TargetWebhook, s.ID, before, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteWebhook - Webhook 削除（配信ログも削除される）
+func (u *AdminWebhookUsecase) DeleteWebhook(id int64, actor audit.Actor) error {
+	s, err := u.webhookRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	return u.uow.Do(func(tx webhook.Tx) error {
+		if err := tx.Webhooks().Delete(id); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionWebhookDelete, audit.TargetWebhook, id, s, nil)
+	})
+}
+
+// HandleEvent - イベントを購読している Webhook ごとに配信を作成（イベントバスの購読者、再配信されても重複しない）
+func (u *AdminWebhookUsecase) HandleEvent(ctx context.Context, e event.Event) error {
+	eventID := event.IDFromContext(ctx)
+	if eventID == 0 {
+		return fmt.Errorf("%s: missing event id", e.EventName())
+	}
+
+	subscriptions, err := u.webhookRepo.FindActiveByEvent(e.EventName())
+	if err != nil {
+		return err
+	}
+	now := u.now()
+	var deliveries []*webhook.Delivery
+	for i := range subscriptions {
+		if !subscriptions[i].Matches(e) {
+			continue
+		}
+		d, err := webhook.NewDelivery(subscriptions[i].ID, eventID, e.EventName(), e, now)
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return u.webhookRepo.CreateDeliveries(deliveries)
+}
+
+// DeliverDue - 送信時刻を過ぎた配信を送信（失敗は指数バックオフで再試行し、上限回数で dead）
+func (u *AdminWebhookUsecase) DeliverDue(ctx context.Context) (*DeliverySummary, error) {
+	deliveries, err := u.webhookRepo.FindDueDeliveries(u.now(), webhookBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &DeliverySummary{}
+	subscriptions := map[int64]*webhook.Subscription{}
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			return summary, ctx.Err()
+		}
+		d := &deliveries[i]
+		s, ok := subscriptions[d.SubscriptionID]
+		if !ok {
+			// 削除された Webhook の配信だけを打ち切り、その他の取得エラーは次回のジョブで再試行する
+			if s, err = u.webhookRepo.FindByID(d.SubscriptionID); errors.Is(err, webhook.ErrWebhookNotFound) {
+				s = nil
+			} else if err != nil {
+				return summary, err
+			}
+			subscriptions[d.SubscriptionID] = s
+		}
+
+		switch {
+		case s == nil || !s.IsActive:
+			d.Abandon(ErrWebhookInactive.Error())
+		default:
+			d.Record(u.sender.Send(ctx, s, d), u.now())
+		}
+
+		switch d.Status {
+		case webhook.DeliverySucceeded:
+			summary.Delivered++
+		case webhook.DeliveryDead:
+			summary.Dead++
+		default:
+			summary.Retrying++
+		}
+		if err := u.webhookRepo.SaveDelivery(d); err != nil {
+			return summary, err
+		}
+	}
+	return summary, nil
+}
+
+// GetDeliveries - 配信ログ取得（新しい順）
+func (u *AdminWebhookUsecase) GetDeliveries(filter webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	switch filter.Status {
+	case "", webhook.DeliveryPending, webhook.DeliverySucceeded, webhook.DeliveryDead:
+	default:
+		return nil, ErrDeliveryStatus
+	}
+	return u.webhookRepo.FindDeliveries(filter)
+}
+
+// ReplayDelivery - 完了または dead の配信を同じ本文で再送（新しい配信として次回のジョブで送信）
+func (u *AdminWebhookUsecase) ReplayDelivery(id int64, actor audit.Actor) (*webhook.Delivery, error) {
+	d, err := u.webhookRepo.FindDeliveryByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status == webhook.DeliveryPending {
+		return nil, ErrDeliveryInProgress
+	}
+	s, err := u.webhookRepo.FindByID(d.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.IsActive {
+		return nil, ErrWebhookInactive
+	}
+
+	replay := d.Replay(u.now())
+	err = u.uow.Do(func(tx webhook.Tx) error {
+		if err := tx.Webhooks().CreateDelivery(replay); err != nil {
+			return err
+		}
+		return recordAudit(tx.AuditLogs(), actor, audit.ActionWebhookReplay, audit.TargetWebhookDelivery, d.ID, nil, replay)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
+
+// validateWebhookFields - Webhook フィールドのバリデーション
+func (u *AdminWebhookUsecase) validateWebhookFields(name, url string, eventTypes []string, productIDs []int64) (string, string, webhook.StringList, webhook.Int64List, error) {
+	validName, err := webhook.NewName(name)
+	if err != nil {
+		return "", "", nil, nil, fmt.Errorf("name: %w", err)
+	}
+	validURL, err := webhook.NewURL(url)
+	if err != nil {
+		return "", "", nil, nil, fmt.Errorf("url: %w", err)
+	}
+	validEventTypes, err := webhook.NewEventTypes(eventTypes)
+	if err != nil {
+		return "", "", nil, nil, fmt.Errorf("eventTypes: %w", err)
+	}
+	validProductIDs, err := webhook.NewProductIDs(productIDs)
+	if err != nil {
+		return "", "", nil, nil, fmt.Errorf("productIds: %w", err)
+	}
+	return validName, validURL, validEventTypes, validProductIDs, nil
+}
@@ -0,0 +1,493 @@
+package adminusecase
+
+import (
+	"backend/domain/audit"
+	"backend/domain/event"
+	"backend/domain/webhook"
+	httpsender "backend/infrastructure/webhook"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockWebhookRepository - テスト用モックリポジトリ
+type mockWebhookRepository struct {
+	subscriptions map[int64]*webhook.Subscription
+	deliveries    map[int64]*webhook.Delivery
+	nextID        int64
+	nextDelivery  int64
+	findErr       error
+}
+
+// mockWebhookUnitOfWork - テスト用 UnitOfWork（コミットされた監査ログを audits に記録）
+type mockWebhookUnitOfWork struct {
+	webhooks     webhook.WebhookRepository
+	pendingAudit *mockAuditLogRepository
+	audits       []audit.Entry
+}
+
+func (m *mockWebhookUnitOfWork) Do(fn func(tx webhook.Tx) error) error {
+	m.pendingAudit = newMockAuditLogRepo()
+	if err := fn(m); err != nil {
+		return err
+	}
+	m.audits = append(m.audits, m.pendingAudit.entries...)
+	return nil
+}
+
+func (m *mockWebhookUnitOfWork) Webhooks() webhook.WebhookRepository { return m.webhooks }
+func (m *mockWebhookUnitOfWork) AuditLogs() audit.AuditLogRepository { return m.pendingAudit }
+
+func newMockWebhookRepo() *mockWebhookRepository {
+	return &mockWebhookRepository{
+		subscriptions: map[int64]*webhook.Subscription{},
+		deliveries:    map[int64]*webhook.Delivery{},
+	}
+}
+
+func (m *mockWebhookRepository) FindAll() ([]webhook.Subscription, error) {
+	var result []webhook.Subscription
+	for _, s := range m.subscriptions {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (m *mockWebhookRepository) FindByID(id int64) (*webhook.Subscription, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	s, ok := m.subscriptions[id]
+	if !ok {
+		return nil, webhook.ErrWebhookNotFound
+	}
+	copy := *s
+	return &copy, nil
+}
+
+func (m *mockWebhookRepository) FindActiveByEvent(eventName string) ([]webhook.Subscription, error) {
+	var result []webhook.Subscription
+	for _, s := range m.subscriptions {
+		if s.IsActive && s.EventTypes.Contains(eventName) {
+			result = append(result, *s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (m *mockWebhookRepository) Create(s *webhook.Subscription) error {
+	m.nextID++
+	s.ID = m.nextID
+	copy := *s
+	m.subscriptions[s.ID] = &copy
+	return nil
+}
+
+func (m *mockWebhookRepository) Update(s *webhook.Subscription) error {
+	copy := *s
+	m.subscriptions[s.ID] = &copy
+	return nil
+}
+
+func (m *mockWebhookRepository) Delete(id int64) error {
+	delete(m.subscriptions, id)
+	for dID, d := range m.deliveries {
+		if d.SubscriptionID == id {
+			delete(m.deliveries, dID)
+		}
+	}
+	return nil
+}
+
+func (m *mockWebhookRepository) CreateDeliveries(deliveries []*webhook.Delivery) error {
+	for _, d := range deliveries {
+		duplicate := false
+		for _, existing := range m.deliveries {
+			if existing.SubscriptionID == d.SubscriptionID && existing.EventID == d.EventID && existing.ReplayOfID == nil {
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			_ = m.CreateDelivery(d)
+		}
+	}
+	return nil
+}
+
+func (m *mockWebhookRepository) CreateDelivery(d *webhook.Delivery) error {
+	m.nextDelivery++
+	d.ID = m.nextDelivery
+	copy := *d
+	m.deliveries[d.ID] = &copy
+	return nil
+}
+
+func (m *mockWebhookRepository) FindDueDeliveries(now time.Time, limit int) ([]webhook.Delivery, error) {
+	var result []webhook.Delivery
+	for _, d := range m.deliveries {
+		if d.Status == webhook.DeliveryPending && !d.NextAttemptAt.After(now) {
+			result = append(result, *d)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *mockWebhookRepository) FindDeliveries(filter webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	var result []webhook.Delivery
+	for _, d := range m.deliveries {
+		if (filter.SubscriptionID == 0 || d.SubscriptionID == filter.SubscriptionID) && (filter.Status == "" || d.Status == filter.Status) {
+			result = append(result, *d)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID > result[j].ID })
+	return result, nil
+}
+
+func (m *mockWebhookRepository) FindDeliveryByID(id int64) (*webhook.Delivery, error) {
+	d, ok := m.deliveries[id]
+	if !ok {
+		return nil, webhook.ErrDeliveryNotFound
+	}
+	copy := *d
+	return &copy, nil
+}
+
+func (m *mockWebhookRepository) SaveDelivery(d *webhook.Delivery) error {
+	copy := *d
+	m.deliveries[d.ID] = &copy
+	return nil
+}
+
+// webhookReceiver - httptest による受信側（署名を検証し、status を返す）
+type webhookReceiver struct {
+	server *httptest.Server
+	secret string
+
+	mu       sync.Mutex
+	status   int
+	received []webhook.Envelope
+	invalid  int
+}
+
+func newWebhookReceiver(t *testing.T, secret string) *webhookReceiver {
+	r := &webhookReceiver{secret: secret, status: http.StatusOK}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if !webhook.Verify(r.secret, req.Header.Get(webhook.HeaderSignature), body, time.Now(), 5*time.Minute) {
+			r.invalid++
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var envelope webhook.Envelope
+		if err := json.Unmarshal(body, &envelope); err == nil {
+			r.received = append(r.received, envelope)
+		}
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *webhookReceiver) respondWith(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func newTestWebhookUsecase(repo *mockWebhookRepository, now *time.Time) *AdminWebhookUsecase {
+	uc := NewAdminWebhookUsecase(repo, &mockWebhookUnitOfWork{webhooks: repo}, httpsender.NewHTTPSender(5*time.Second))
+	uc.now = func() time.Time { return *now }
+	return uc
+}
+
+func dispatchContext(eventID int64) context.Context {
+	return event.WithID(context.Background(), eventID)
+}
+
+func TestCreateWebhook_GeneratesSecret(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+
+	created, err := uc.CreateWebhook(CreateWebhookInput{
+		Name:       "Partner",
+		URL:        "https://partner.example.com/hooks",
+		EventTypes: []string{event.NameReviewCreated, event.NameReviewCreated},
+	}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created.Secret) < 16 || created.Secret != repo.subscriptions[created.ID].Secret {
+		t.Errorf("expected generated secret to be stored and returned, got %q", created.Secret)
+	}
+	if len(created.EventTypes) != 1 || !created.IsActive {
+		t.Errorf("unexpected subscription: %+v", created.Subscription)
+	}
+
+	// シークレットは一覧の応答に含めない
+	list, _ := uc.GetAllWebhooks()
+	raw, _ := json.Marshal(list)
+	var decoded []map[string]any
+	_ = json.Unmarshal(raw, &decoded)
+	if _, ok := decoded[0]["secret"]; ok {
+		t.Error("expected secret to be omitted from list response")
+	}
+}
+
+func TestCreateWebhook_Validation(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+
+	cases := []CreateWebhookInput{
+		{Name: "", URL: "https://example.com", EventTypes: []string{event.NameReviewCreated}},
+		{Name: "x", URL: "ftp://example.com", EventTypes: []string{event.NameReviewCreated}},
+		{Name: "x", URL: "https://example.com", EventTypes: nil},
+		{Name: "x", URL: "https://example.com", EventTypes: []string{event.NameCustomerBanned}},
+		{Name: "x", URL: "https://example.com", EventTypes: []string{event.NameReviewCreated}, Secret: "short"},
+		{Name: "x", URL: "https://example.com", EventTypes: []string{event.NameReviewCreated}, ProductIDs: []int64{0}},
+	}
+	for i, input := range cases {
+		if _, err := uc.CreateWebhook(input, testActor); err == nil {
+			t.Errorf("case %d: expected error, got nil", i)
+		}
+	}
+	if len(repo.subscriptions) != 0 {
+		t.Errorf("expected nothing created, got %d", len(repo.subscriptions))
+	}
+}
+
+func TestUpdateWebhook_KeepsSecretWhenOmitted(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	created, _ := uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: "https://example.com", EventTypes: []string{event.NameReviewCreated}}, testActor)
+
+	updated, err := uc.UpdateWebhook(created.ID, UpdateWebhookInput{
+		Name:       "Renamed",
+		URL:        "https://example.com/v2",
+		EventTypes: []string{event.NameProductUpdated},
+		IsActive:   false,
+	}, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Secret != created.Secret || updated.Name != "Renamed" || updated.IsActive {
+		t.Errorf("unexpected subscription: %+v", updated)
+	}
+
+	if _, err := uc.UpdateWebhook(999, UpdateWebhookInput{Name: "x", URL: "https://example.com", EventTypes: []string{event.NameReviewCreated}}, testActor); !errors.Is(err, webhook.ErrWebhookNotFound) {
+		t.Errorf("expected ErrWebhookNotFound, got %v", err)
+	}
+}
+
+func TestHandleEvent_FansOutToMatchingSubscriptions(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	all, _ := uc.CreateWebhook(CreateWebhookInput{Name: "All", URL: "https://a.example.com", EventTypes: []string{event.NameReviewCreated}}, testActor)
+	filtered, _ := uc.CreateWebhook(CreateWebhookInput{Name: "Shop", URL: "https://b.example.com", EventTypes: []string{event.NameReviewCreated}, ProductIDs: []int64{42}}, testActor)
+	_, _ = uc.CreateWebhook(CreateWebhookInput{Name: "Other", URL: "https://c.example.com", EventTypes: []string{event.NameProductUpdated}}, testActor)
+
+	e := event.ReviewCreated{ReviewID: 1, ProductID: 7, CustomerID: 3, Rating: 5}
+	if err := uc.HandleEvent(dispatchContext(100), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 再配信されても同じ購読には作成しない
+	if err := uc.HandleEvent(dispatchContext(100), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.HandleEvent(dispatchContext(101), event.ReviewCreated{ReviewID: 2, ProductID: 42}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byAll, _ := repo.FindDeliveries(webhook.DeliveryFilter{SubscriptionID: all.ID})
+	byFiltered, _ := repo.FindDeliveries(webhook.DeliveryFilter{SubscriptionID: filtered.ID})
+	if len(byAll) != 2 || len(byFiltered) != 1 || byFiltered[0].EventID != 101 {
+		t.Fatalf("unexpected deliveries: all=%+v filtered=%+v", byAll, byFiltered)
+	}
+	if len(repo.deliveries) != 3 {
+		t.Errorf("expected 3 deliveries, got %d", len(repo.deliveries))
+	}
+
+	if err := uc.HandleEvent(context.Background(), e); err == nil {
+		t.Error("expected error without event id, got nil")
+	}
+}
+
+func TestDeliverDue_SignsAndDelivers(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	receiver := newWebhookReceiver(t, "partner-secret-0123456789")
+	_, _ = uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: receiver.server.URL, Secret: receiver.secret, EventTypes: []string{event.NameReviewCreated}}, testActor)
+
+	_ = uc.HandleEvent(dispatchContext(100), event.ReviewCreated{ReviewID: 1, ProductID: 7, CustomerID: 3, Rating: 4})
+	summary, err := uc.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Delivered != 1 {
+		t.Fatalf("expected 1 delivered, got %+v", summary)
+	}
+	if receiver.invalid != 0 || len(receiver.received) != 1 {
+		t.Fatalf("expected 1 valid request, got %d valid / %d invalid", len(receiver.received), receiver.invalid)
+	}
+	got := receiver.received[0]
+	if got.ID != 100 || got.Event != event.NameReviewCreated {
+		t.Errorf("unexpected envelope: %+v", got)
+	}
+	var data event.ReviewCreated
+	if err := json.Unmarshal(got.Data, &data); err != nil || data.ProductID != 7 || data.Rating != 4 {
+		t.Errorf("unexpected data: %s", got.Data)
+	}
+
+	deliveries, _ := repo.FindDeliveries(webhook.DeliveryFilter{Status: webhook.DeliverySucceeded})
+	if len(deliveries) != 1 || deliveries[0].DeliveredAt == nil || *deliveries[0].LastStatusCode != http.StatusOK {
+		t.Errorf("unexpected delivery log: %+v", deliveries)
+	}
+}
+
+func TestDeliverDue_WrongSecretIsRejected(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	receiver := newWebhookReceiver(t, "partner-secret-0123456789")
+	_, _ = uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: receiver.server.URL, Secret: "another-secret-0123456789", EventTypes: []string{event.NameReviewCreated}}, testActor)
+
+	_ = uc.HandleEvent(dispatchContext(100), event.ReviewCreated{ReviewID: 1, ProductID: 7})
+	summary, _ := uc.DeliverDue(context.Background())
+	if summary.Retrying != 1 || receiver.invalid != 1 {
+		t.Errorf("expected rejected delivery to be retried, got %+v (invalid=%d)", summary, receiver.invalid)
+	}
+}
+
+func TestDeliverDue_RetriesWithBackoffUntilDead(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	receiver := newWebhookReceiver(t, "partner-secret-0123456789")
+	receiver.respondWith(http.StatusInternalServerError)
+	_, _ = uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: receiver.server.URL, Secret: receiver.secret, EventTypes: []string{event.NameReviewCreated}}, testActor)
+	_ = uc.HandleEvent(dispatchContext(100), event.ReviewCreated{ReviewID: 1, ProductID: 7})
+
+	summary, _ := uc.DeliverDue(context.Background())
+	if summary.Retrying != 1 {
+		t.Fatalf("expected retrying, got %+v", summary)
+	}
+	d := repo.deliveries[1]
+	if d.NextAttemptAt.Sub(now) != time.Minute || d.LastStatusCode == nil || *d.LastStatusCode != http.StatusInternalServerError {
+		t.Errorf("unexpected first failure: %+v", d)
+	}
+
+	// 再試行時刻前は送信しない
+	if summary, _ := uc.DeliverDue(context.Background()); summary.Retrying+summary.Delivered+summary.Dead != 0 {
+		t.Errorf("expected nothing due, got %+v", summary)
+	}
+
+	var dead int
+	for i := 1; i < webhook.MaxAttempts; i++ {
+		now = repo.deliveries[1].NextAttemptAt
+		summary, _ := uc.DeliverDue(context.Background())
+		dead += summary.Dead
+	}
+	d = repo.deliveries[1]
+	if dead != 1 || d.Status != webhook.DeliveryDead || d.Attempts != webhook.MaxAttempts {
+		t.Errorf("expected dead after %d attempts, got %+v", webhook.MaxAttempts, d)
+	}
+	if len(receiver.received) != webhook.MaxAttempts {
+		t.Errorf("expected %d requests, got %d", webhook.MaxAttempts, len(receiver.received))
+	}
+}
+
+func TestDeliverDue_InactiveSubscriptionIsDeadLettered(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	receiver := newWebhookReceiver(t, "partner-secret-0123456789")
+	created, _ := uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: receiver.server.URL, Secret: receiver.secret, EventTypes: []string{event.NameReviewCreated}}, testActor)
+	_ = uc.HandleEvent(dispatchContext(100), event.ReviewCreated{ReviewID: 1, ProductID: 7})
+	repo.subscriptions[created.ID].IsActive = false
+
+	summary, _ := uc.DeliverDue(context.Background())
+	if summary.Dead != 1 || len(receiver.received) != 0 {
+		t.Errorf("expected dead-lettered delivery without request, got %+v", summary)
+	}
+}
+
+func TestDeliverDue_LookupErrorKeepsDeliveryPending(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	receiver := newWebhookReceiver(t, "partner-secret-0123456789")
+	_, _ = uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: receiver.server.URL, Secret: receiver.secret, EventTypes: []string{event.NameReviewCreated}}, testActor)
+	_ = uc.HandleEvent(dispatchContext(100), event.ReviewCreated{ReviewID: 1, ProductID: 7})
+	repo.findErr = errors.New("connection reset")
+
+	if _, err := uc.DeliverDue(context.Background()); err == nil {
+		t.Fatal("expected the lookup error to be returned")
+	}
+	if d := repo.deliveries[1]; d.Status != webhook.DeliveryPending || d.Attempts != 0 {
+		t.Errorf("expected delivery to stay pending, got %+v", d)
+	}
+}
+
+func TestReplayDelivery(t *testing.T) {
+	repo := newMockWebhookRepo()
+	now := time.Now()
+	uc := newTestWebhookUsecase(repo, &now)
+	receiver := newWebhookReceiver(t, "partner-secret-0123456789")
+	receiver.respondWith(http.StatusServiceUnavailable)
+	_, _ = uc.CreateWebhook(CreateWebhookInput{Name: "Partner", URL: receiver.server.URL, Secret: receiver.secret, EventTypes: []string{event.NameReviewCreated}}, testActor)
+	_ = uc.HandleEvent(dispatchContext(100), event.ReviewCreated{ReviewID: 1, ProductID: 7})
+	original := repo.deliveries[1]
+
+	// pending の間は再送できない
+	if _, err := uc.ReplayDelivery(original.ID, testActor); !errors.Is(err, ErrDeliveryInProgress) {
+		t.Fatalf("expected ErrDeliveryInProgress, got %v", err)
+	}
+
+	original.Status = webhook.DeliveryDead
+	receiver.respondWith(http.StatusOK)
+	replay, err := uc.ReplayDelivery(original.ID, testActor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replay.ReplayOfID == nil || *replay.ReplayOfID != original.ID || replay.Payload != original.Payload {
+		t.Errorf("unexpected replay: %+v", replay)
+	}
+
+	summary, _ := uc.DeliverDue(context.Background())
+	if summary.Delivered != 1 || len(receiver.received) != 1 || receiver.received[0].ID != 100 {
+		t.Errorf("expected replay to be delivered, got %+v", summary)
+	}
+	if repo.deliveries[original.ID].Status != webhook.DeliveryDead {
+		t.Error("expected original delivery log to be kept")
+	}
+
+	if _, err := uc.ReplayDelivery(999, testActor); !errors.Is(err, webhook.ErrDeliveryNotFound) {
+		t.Errorf("expected ErrDeliveryNotFound, got %v", err)
+	}
+}
+
+func TestGetDeliveries_InvalidStatus(t *testing.T) {
+	uc := newTestWebhookUsecase(newMockWebhookRepo(), new(time.Time))
+	if _, err := uc.GetDeliveries(webhook.DeliveryFilter{Status: "failed"}); !errors.Is(err, ErrDeliveryStatus) {
+		t.Errorf("expected ErrDeliveryStatus, got %v", err)
+	}
+}
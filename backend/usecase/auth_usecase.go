@@ -28,20 +28,20 @@ func (u *AuthUsecase) FindOrCreateCustomer(googleUserInfo *auth.GoogleUserInfo)
 	if err != nil {
 		// 新規作成
 		newCustomer := &customer.Customer{
-			GoogleID:    googleUserInfo.ID,
-			Email:       googleUserInfo.Email,
-			Name:        googleUserInfo.Name,
-			Avatar:      googleUserInfo.Picture,
-			MemberSince: time.Now(),
+			GoogleID:          googleUserInfo.ID,
+			Email:             googleUserInfo.Email,
+			Name:              googleUserInfo.Name,
+			Avatar:            googleUserInfo.Picture,
+			MemberSince:       time.Now(),
+			PreferredLanguage: customer.LanguageJa,
 		}
 		if err := u.customerRepo.Create(newCustomer); err != nil {
 			return nil, err
 		}
 		return newCustomer, nil
 	}
-	// 既存カスタマー更新
-	existing.Name = googleUserInfo.Name
-	existing.Avatar = googleUserInfo.Picture
+	// 既存カスタマー更新（本人が編集した名前・アバターは維持）
+	existing.SyncGoogleProfile(googleUserInfo.Name, googleUserInfo.Picture)
 	if err := u.customerRepo.Update(existing); err != nil {
 		return nil, err
 	}
@@ -54,8 +54,13 @@ func (u *AuthUsecase) FindAndUpdateAdmin(googleUserInfo *auth.GoogleUserInfo) (*
 	if err != nil {
 		return nil, errors.New("admin not found")
 	}
+	if !a.IsActive {
+		return nil, errors.New("admin is deactivated")
+	}
 
+	now := time.Now()
 	a.GoogleID = googleUserInfo.ID
+	a.LastLoginAt = &now
 	a.Name = googleUserInfo.Name
 	a.Avatar = googleUserInfo.Picture
 	if err := u.adminRepo.Update(a); err != nil {
@@ -67,7 +72,21 @@ func (u *AuthUsecase) FindAndUpdateAdmin(googleUserInfo *auth.GoogleUserInfo) (*
 // GetCurrentCustomerOrAdmin - 現在のカスタマーまたは管理者を取得
 func (u *AuthUsecase) GetCurrentCustomerOrAdmin(userID int64, isAdmin bool) (interface{}, error) {
 	if isAdmin {
-		return u.adminRepo.FindByID(userID)
+		a, err := u.adminRepo.FindByID(userID)
+		if err != nil {
+			return nil, err
+		}
+		if !a.IsActive {
+			return nil, errors.New("admin is deactivated")
+		}
+		return a, nil
+	}
+	c, err := u.customerRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if c.IsAnonymized() {
+		return nil, errors.New("customer account has been deleted")
 	}
-	return u.customerRepo.FindByID(userID)
+	return c, nil
 }
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/favorite"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrCollectionNotFound      = errors.New("collection not found")
+	ErrCollectionItemNotFound  = errors.New("item not found in collection")
+	ErrCollectionItemExists    = errors.New("already in collection")
+	ErrDefaultCollectionDelete = errors.New("default collection cannot be deleted")
+	ErrCollectionOrderInvalid  = errors.New("order must list every item in the collection exactly once")
+)
+
+// CollectionUsecase - お気に入りリストユースケース
+type CollectionUsecase struct {
+	collectionRepo favorite.CollectionRepository
+	favoriteRepo   favorite.FavoriteRepository
+	uow            event.UnitOfWork
+}
+
+// NewCollectionUsecase - お気に入りリストユースケースの生成
+func NewCollectionUsecase(collectionRepo favorite.CollectionRepository, favoriteRepo favorite.FavoriteRepository, uow event.UnitOfWork) *CollectionUsecase {
+	return &CollectionUsecase{collectionRepo: collectionRepo, favoriteRepo: favoriteRepo, uow: uow}
+}
+
+// CollectionInput - リスト作成・更新の入力
+type CollectionInput struct {
+	Name        string
+	Description *string
+	IsPublic    bool
+}
+
+// CollectionItemInput - リストへの商品追加の入力
+type CollectionItemInput struct {
+	ProductID int64
+	Note      *string
+}
+
+// GetCustomerCollections - カスタマーのリスト一覧取得
+func (u *CollectionUsecase) GetCustomerCollections(customerID, requestCustomerID int64) ([]favorite.Collection, error) {
+	if customerID != requestCustomerID {
+		return nil, errors.New("permission denied")
+	}
+	return u.collectionRepo.FindByCustomerID(customerID)
+}
+
+// GetCollection - リスト取得（本人のみ）
+func (u *CollectionUsecase) GetCollection(collectionID, requestCustomerID int64) (*favorite.Collection, error) {
+	return u.findOwned(collectionID, requestCustomerID)
+}
+
+// GetSharedCollection - 共有リンクから公開リストを取得
+func (u *CollectionUsecase) GetSharedCollection(token string) (*favorite.Collection, error) {
+	c, err := u.collectionRepo.FindByShareToken(token)
+	if errors.Is(err, favorite.ErrCollectionNotFound) {
+		return nil, ErrCollectionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !c.IsPublic {
+		return nil, ErrCollectionNotFound
+	}
+	return c, nil
+}
+
+// CreateCollection - リスト作成
+func (u *CollectionUsecase) CreateCollection(customerID int64, input CollectionInput, requestCustomerID int64) (*favorite.Collection, error) {
+	if customerID != requestCustomerID {
+		return nil, errors.New("permission denied")
+	}
+	c := &favorite.Collection{CustomerID: customerID}
+	if err := applyCollectionInput(c, input); err != nil {
+		return nil, err
+	}
+	if err := u.collectionRepo.Create(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UpdateCollection - リストの名前・説明・公開設定を更新
+func (u *CollectionUsecase) UpdateCollection(collectionID int64, input CollectionInput, requestCustomerID int64) (*favorite.Collection, error) {
+	c, err := u.findOwned(collectionID, requestCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyCollectionInput(c, input); err != nil {
+		return nil, err
+	}
+	if err := u.collectionRepo.Update(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DeleteCollection - リスト削除（既定のリストは削除不可、お気に入り自体は残る）
+func (u *CollectionUsecase) DeleteCollection(collectionID, requestCustomerID int64) error {
+	c, err := u.findOwned(collectionID, requestCustomerID)
+	if err != nil {
+		return err
+	}
+	if c.IsDefault {
+		return ErrDefaultCollectionDelete
+	}
+	return u.collectionRepo.Delete(c.ID)
+}
+
+// AddItem - リストに商品を追加（未登録ならお気に入りにも追加）
+func (u *CollectionUsecase) AddItem(collectionID int64, input CollectionItemInput, requestCustomerID int64) (*favorite.CollectionItem, error) {
+	c, err := u.findOwned(collectionID, requestCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if findItem(c, input.ProductID) != nil {
+		return nil, ErrCollectionItemExists
+	}
+	note, err := favorite.NewCollectionItemNote(input.Note)
+	if err != nil {
+		return nil, fmt.Errorf("note: %w", err)
+	}
+
+	item := &favorite.CollectionItem{CollectionID: c.ID, ProductID: input.ProductID, Note: note}
+	err = u.uow.Do(func(tx event.Tx) error {
+		// どのリストに入れた商品も「お気に入り」として扱う
+		_, err := tx.Favorites().FindByCustomerIDAndProductID(c.CustomerID, input.ProductID)
+		if errors.Is(err, favorite.ErrFavoriteNotFound) {
+			if err := tx.Favorites().Create(&favorite.Favorite{CustomerID: c.CustomerID, ProductID: input.ProductID}); err != nil {
+				return err
+			}
+			if !c.IsDefault {
+				if err := addToDefaultCollection(tx.Collections(), c.CustomerID, input.ProductID); err != nil {
+					return err
+				}
+			}
+		} else if err != nil {
+			return err
+		}
+		return tx.Collections().AddItem(item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateItemNote - リスト内の商品メモを更新
+func (u *CollectionUsecase) UpdateItemNote(collectionID, productID int64, note *string, requestCustomerID int64) (*favorite.CollectionItem, error) {
+	c, err := u.findOwned(collectionID, requestCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	item := findItem(c, productID)
+	if item == nil {
+		return nil, ErrCollectionItemNotFound
+	}
+	validated, err := favorite.NewCollectionItemNote(note)
+	if err != nil {
+		return nil, fmt.Errorf("note: %w", err)
+	}
+	item.Note = validated
+	if err := u.collectionRepo.UpdateItem(item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// RemoveItem - リストから商品を外す（既定のリストから外した場合はお気に入り解除として扱う）
+func (u *CollectionUsecase) RemoveItem(collectionID, productID, requestCustomerID int64) error {
+	c, err := u.findOwned(collectionID, requestCustomerID)
+	if err != nil {
+		return err
+	}
+	if findItem(c, productID) == nil {
+		return ErrCollectionItemNotFound
+	}
+	if c.IsDefault {
+		return u.uow.Do(func(tx event.Tx) error {
+			return removeFavorite(tx, c.CustomerID, productID)
+		})
+	}
+	return u.collectionRepo.RemoveItem(c.ID, productID)
+}
+
+// ReorderItems - リスト内の並び順を変更（全商品を過不足なく指定）
+func (u *CollectionUsecase) ReorderItems(collectionID int64, productIDs []int64, requestCustomerID int64) (*favorite.Collection, error) {
+	c, err := u.findOwned(collectionID, requestCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(productIDs) != len(c.Items) {
+		return nil, ErrCollectionOrderInvalid
+	}
+	seen := make(map[int64]bool, len(productIDs))
+	for _, id := range productIDs {
+		if seen[id] || findItem(c, id) == nil {
+			return nil, ErrCollectionOrderInvalid
+		}
+		seen[id] = true
+	}
+	if err := u.collectionRepo.ReorderItems(c.ID, productIDs); err != nil {
+		return nil, err
+	}
+	return u.collectionRepo.FindByID(c.ID)
+}
+
+func (u *CollectionUsecase) findOwned(collectionID, requestCustomerID int64) (*favorite.Collection, error) {
+	c, err := u.collectionRepo.FindByID(collectionID)
+	if errors.Is(err, favorite.ErrCollectionNotFound) {
+		return nil, ErrCollectionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CustomerID != requestCustomerID {
+		return nil, errors.New("permission denied")
+	}
+	return c, nil
+}
+
+func applyCollectionInput(c *favorite.Collection, input CollectionInput) error {
+	name, err := favorite.NewCollectionName(input.Name)
+	if err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+	description, err := favorite.NewCollectionDescription(input.Description)
+	if err != nil {
+		return fmt.Errorf("description: %w", err)
+	}
+	c.Name = name
+	c.Description = description
+	return c.SetPublic(input.IsPublic)
+}
+
+func findItem(c *favorite.Collection, productID int64) *favorite.CollectionItem {
+	for i := range c.Items {
+		if c.Items[i].ProductID == productID {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+// addToDefaultCollection - 既定のリストに商品を追加（リストが無ければ作成）
+func addToDefaultCollection(repo favorite.CollectionRepository, customerID, productID int64) error {
+	c, err := repo.FindDefault(customerID)
+	if errors.Is(err, favorite.ErrCollectionNotFound) {
+		c = &favorite.Collection{CustomerID: customerID, Name: favorite.DefaultCollectionName, IsDefault: true}
+		if err := repo.Create(c); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	return repo.AddItem(&favorite.CollectionItem{CollectionID: c.ID, ProductID: productID})
+}
+
+// removeFavorite - お気に入りを解除し、全リストから商品を取り除く
+func removeFavorite(tx event.Tx, customerID, productID int64) error {
+	if err := tx.Favorites().Delete(customerID, productID); err != nil {
+		return err
+	}
+	return tx.Collections().RemoveProduct(customerID, productID)
+}
@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"backend/domain/favorite"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// ===== Mock Repository =====
+
+type mockCollectionRepository struct {
+	collections map[int64]*favorite.Collection
+	nextID      int64
+	findErr     error
+}
+
+func newMockCollectionRepo() *mockCollectionRepository {
+	return &mockCollectionRepository{collections: map[int64]*favorite.Collection{}}
+}
+
+func (m *mockCollectionRepository) snapshot(c *favorite.Collection) *favorite.Collection {
+	copy := *c
+	copy.Items = append([]favorite.CollectionItem(nil), c.Items...)
+	sort.SliceStable(copy.Items, func(i, j int) bool { return copy.Items[i].Position < copy.Items[j].Position })
+	return &copy
+}
+
+func (m *mockCollectionRepository) FindByCustomerID(customerID int64) ([]favorite.Collection, error) {
+	var result []favorite.Collection
+	for id := int64(1); id <= m.nextID; id++ {
+		if c, ok := m.collections[id]; ok && c.CustomerID == customerID {
+			result = append(result, *m.snapshot(c))
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCollectionRepository) FindByID(id int64) (*favorite.Collection, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	c, ok := m.collections[id]
+	if !ok {
+		return nil, favorite.ErrCollectionNotFound
+	}
+	return m.snapshot(c), nil
+}
+
+func (m *mockCollectionRepository) FindByShareToken(token string) (*favorite.Collection, error) {
+	for _, c := range m.collections {
+		if c.IsPublic && c.ShareToken != nil && *c.ShareToken == token {
+			return m.snapshot(c), nil
+		}
+	}
+	return nil, favorite.ErrCollectionNotFound
+}
+
+func (m *mockCollectionRepository) FindDefault(customerID int64) (*favorite.Collection, error) {
+	for _, c := range m.collections {
+		if c.CustomerID == customerID && c.IsDefault {
+			return m.snapshot(c), nil
+		}
+	}
+	return nil, favorite.ErrCollectionNotFound
+}
+
+func (m *mockCollectionRepository) Create(c *favorite.Collection) error {
+	m.nextID++
+	c.ID = m.nextID
+	m.collections[c.ID] = m.snapshot(c)
+	return nil
+}
+
+func (m *mockCollectionRepository) Update(c *favorite.Collection) error {
+	stored := m.collections[c.ID]
+	items := stored.Items
+	*stored = *c
+	stored.Items = items
+	return nil
+}
+
+func (m *mockCollectionRepository) Delete(id int64) error {
+	delete(m.collections, id)
+	return nil
+}
+
+func (m *mockCollectionRepository) AddItem(item *favorite.CollectionItem) error {
+	c := m.collections[item.CollectionID]
+	item.Position = len(c.Items)
+	c.Items = append(c.Items, *item)
+	return nil
+}
+
+func (m *mockCollectionRepository) UpdateItem(item *favorite.CollectionItem) error {
+	c := m.collections[item.CollectionID]
+	for i := range c.Items {
+		if c.Items[i].ProductID == item.ProductID {
+			c.Items[i].Note = item.Note
+		}
+	}
+	return nil
+}
+
+func (m *mockCollectionRepository) RemoveItem(collectionID, productID int64) error {
+	c := m.collections[collectionID]
+	var kept []favorite.CollectionItem
+	for _, item := range c.Items {
+		if item.ProductID != productID {
+			kept = append(kept, item)
+		}
+	}
+	c.Items = kept
+	return nil
+}
+
+func (m *mockCollectionRepository) RemoveProduct(customerID, productID int64) error {
+	for id, c := range m.collections {
+		if c.CustomerID == customerID {
+			m.RemoveItem(id, productID)
+		}
+	}
+	return nil
+}
+
+func (m *mockCollectionRepository) ReorderItems(collectionID int64, productIDs []int64) error {
+	c := m.collections[collectionID]
+	for position, productID := range productIDs {
+		for i := range c.Items {
+			if c.Items[i].ProductID == productID {
+				c.Items[i].Position = position
+			}
+		}
+	}
+	return nil
+}
+
+// inMemoryFavoriteRepo - 登録状態を保持するお気に入りモック
+func inMemoryFavoriteRepo() *mockFavoriteRepository {
+	m := &mockFavoriteRepository{}
+	m.findByCustomerIDAndProdFunc = func(customerID, productID int64) (*favorite.Favorite, error) {
+		for i := range m.favorites {
+			if m.favorites[i].CustomerID == customerID && m.favorites[i].ProductID == productID {
+				return &m.favorites[i], nil
+			}
+		}
+		return nil, favorite.ErrFavoriteNotFound
+	}
+	m.createFunc = func(fav *favorite.Favorite) error {
+		m.favorites = append(m.favorites, *fav)
+		return nil
+	}
+	m.deleteFunc = func(customerID, productID int64) error {
+		var kept []favorite.Favorite
+		for _, f := range m.favorites {
+			if f.CustomerID != customerID || f.ProductID != productID {
+				kept = append(kept, f)
+			}
+		}
+		m.favorites = kept
+		return nil
+	}
+	return m
+}
+
+// newTestCollectionUsecase - 書き込みを各リポジトリに流す UnitOfWork 付きでユースケースを生成
+func newTestCollectionUsecase(collectionRepo *mockCollectionRepository, favoriteRepo *mockFavoriteRepository) *CollectionUsecase {
+	return NewCollectionUsecase(collectionRepo, favoriteRepo, &mockUnitOfWork{favorites: favoriteRepo, collections: collectionRepo})
+}
+
+func productIDs(c *favorite.Collection) []int64 {
+	var ids []int64
+	for _, item := range c.Items {
+		ids = append(ids, item.ProductID)
+	}
+	return ids
+}
+
+// ===== Tests =====
+
+func TestFavoriteUsecase_SyncsDefaultCollection(t *testing.T) {
+	favoriteRepo := inMemoryFavoriteRepo()
+	collectionRepo := newMockCollectionRepo()
+	uc := NewFavoriteUsecase(favoriteRepo, &mockUnitOfWork{favorites: favoriteRepo, collections: collectionRepo})
+
+	for _, productID := range []int64{10, 20} {
+		if err := uc.AddFavorite(&favorite.Favorite{CustomerID: 1, ProductID: productID}, 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	def, err := collectionRepo.FindDefault(1)
+	if err != nil {
+		t.Fatalf("expected default collection to be created: %v", err)
+	}
+	if def.Name != favorite.DefaultCollectionName || len(def.Items) != 2 {
+		t.Fatalf("expected default collection with 2 items, got %q with %v", def.Name, productIDs(def))
+	}
+
+	if err := uc.RemoveFavorite(1, 10, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	def, _ = collectionRepo.FindDefault(1)
+	if got := productIDs(def); len(got) != 1 || got[0] != 20 {
+		t.Errorf("expected only product 20 to remain, got %v", got)
+	}
+}
+
+func TestCollectionUsecase_CreateAndShare(t *testing.T) {
+	uc := newTestCollectionUsecase(newMockCollectionRepo(), inMemoryFavoriteRepo())
+
+	c, err := uc.CreateCollection(1, CollectionInput{Name: "  朝食  ", IsPublic: true}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Name != "朝食" {
+		t.Errorf("expected trimmed name, got %q", c.Name)
+	}
+	if c.ShareToken == nil || *c.ShareToken == "" {
+		t.Fatal("expected share token for public collection")
+	}
+	token := *c.ShareToken
+
+	shared, err := uc.GetSharedCollection(token)
+	if err != nil || shared.ID != c.ID {
+		t.Fatalf("expected shared collection %d, got %v (err %v)", c.ID, shared, err)
+	}
+
+	// 非公開にすると共有リンクは無効になる
+	if _, err := uc.UpdateCollection(c.ID, CollectionInput{Name: "朝食", IsPublic: false}, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.GetSharedCollection(token); !errors.Is(err, ErrCollectionNotFound) {
+		t.Errorf("expected ErrCollectionNotFound after making private, got %v", err)
+	}
+
+	// 再公開時は新しいトークンが発行される
+	updated, _ := uc.UpdateCollection(c.ID, CollectionInput{Name: "朝食", IsPublic: true}, 1)
+	if updated.ShareToken == nil || *updated.ShareToken == token {
+		t.Error("expected a new share token after re-publishing")
+	}
+}
+
+func TestCollectionUsecase_ValidationAndPermissions(t *testing.T) {
+	collectionRepo := newMockCollectionRepo()
+	uc := newTestCollectionUsecase(collectionRepo, inMemoryFavoriteRepo())
+	owned, _ := uc.CreateCollection(1, CollectionInput{Name: "ギフト"}, 1)
+	tooLong := strings.Repeat("あ", favorite.CollectionItemNoteMaxLength+1)
+
+	testCases := []struct {
+		name    string
+		run     func() error
+		wantErr error
+		wantMsg string
+	}{
+		{"空のリスト名", func() error {
+			_, err := uc.CreateCollection(1, CollectionInput{Name: " "}, 1)
+			return err
+		}, favorite.ErrCollectionNameEmpty, ""},
+		{"長すぎるリスト名", func() error {
+			_, err := uc.CreateCollection(1, CollectionInput{Name: strings.Repeat("a", 51)}, 1)
+			return err
+		}, favorite.ErrCollectionNameTooLong, ""},
+		{"他カスタマーのリスト作成", func() error {
+			_, err := uc.CreateCollection(2, CollectionInput{Name: "x"}, 1)
+			return err
+		}, nil, "permission denied"},
+		{"他カスタマーのリスト更新", func() error {
+			_, err := uc.UpdateCollection(owned.ID, CollectionInput{Name: "x"}, 2)
+			return err
+		}, nil, "permission denied"},
+		{"存在しないリスト", func() error {
+			_, err := uc.GetCollection(999, 1)
+			return err
+		}, ErrCollectionNotFound, ""},
+		{"長すぎるメモ", func() error {
+			_, err := uc.AddItem(owned.ID, CollectionItemInput{ProductID: 1, Note: &tooLong}, 1)
+			return err
+		}, favorite.ErrCollectionItemNoteTooLong, ""},
+		{"リストにない商品の削除", func() error {
+			return uc.RemoveItem(owned.ID, 42, 1)
+		}, ErrCollectionItemNotFound, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.run()
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantMsg != "" && (err == nil || err.Error() != tc.wantMsg) {
+				t.Errorf("expected error %q, got %v", tc.wantMsg, err)
+			}
+		})
+	}
+}
+
+func TestCollectionUsecase_ItemsFollowFavorites(t *testing.T) {
+	favoriteRepo := inMemoryFavoriteRepo()
+	collectionRepo := newMockCollectionRepo()
+	uc := newTestCollectionUsecase(collectionRepo, favoriteRepo)
+
+	gifts, _ := uc.CreateCollection(1, CollectionInput{Name: "ギフト"}, 1)
+	note := " 母の日に "
+	item, err := uc.AddItem(gifts.ID, CollectionItemInput{ProductID: 5, Note: &note}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Note == nil || *item.Note != "母の日に" {
+		t.Errorf("expected trimmed note, got %v", item.Note)
+	}
+
+	// 任意のリストへの追加はお気に入り登録と既定のリスト追加を兼ねる
+	if fav, _ := favoriteRepo.FindByCustomerIDAndProductID(1, 5); fav == nil {
+		t.Error("expected product to be added to favorites")
+	}
+	def, err := collectionRepo.FindDefault(1)
+	if err != nil || len(def.Items) != 1 {
+		t.Fatalf("expected product in default collection, got %v (err %v)", def, err)
+	}
+
+	if _, err := uc.AddItem(gifts.ID, CollectionItemInput{ProductID: 5}, 1); !errors.Is(err, ErrCollectionItemExists) {
+		t.Errorf("expected ErrCollectionItemExists, got %v", err)
+	}
+
+	// 任意のリストから外してもお気に入りは残る
+	if err := uc.RemoveItem(gifts.ID, 5, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fav, _ := favoriteRepo.FindByCustomerIDAndProductID(1, 5); fav == nil {
+		t.Error("expected favorite to remain after removing from a custom list")
+	}
+
+	// 既定のリストから外すとお気に入り解除になる
+	if err := uc.RemoveItem(def.ID, 5, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fav, _ := favoriteRepo.FindByCustomerIDAndProductID(1, 5); fav != nil {
+		t.Error("expected favorite to be removed with the default list item")
+	}
+
+	if err := uc.DeleteCollection(def.ID, 1); !errors.Is(err, ErrDefaultCollectionDelete) {
+		t.Errorf("expected ErrDefaultCollectionDelete, got %v", err)
+	}
+	if err := uc.DeleteCollection(gifts.ID, 1); err != nil {
+		t.Errorf("unexpected error deleting custom list: %v", err)
+	}
+}
+
+func TestCollectionUsecase_ReorderItems(t *testing.T) {
+	uc := newTestCollectionUsecase(newMockCollectionRepo(), inMemoryFavoriteRepo())
+	c, _ := uc.CreateCollection(1, CollectionInput{Name: "朝食"}, 1)
+	for _, productID := range []int64{1, 2, 3} {
+		if _, err := uc.AddItem(c.ID, CollectionItemInput{ProductID: productID}, 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	reordered, err := uc.ReorderItems(c.ID, []int64{3, 1, 2}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := productIDs(reordered); got[0] != 3 || got[1] != 1 || got[2] != 2 {
+		t.Errorf("expected order [3 1 2], got %v", got)
+	}
+
+	for _, invalid := range [][]int64{{3, 1}, {3, 1, 1}, {3, 1, 9}} {
+		if _, err := uc.ReorderItems(c.ID, invalid, 1); !errors.Is(err, ErrCollectionOrderInvalid) {
+			t.Errorf("expected ErrCollectionOrderInvalid for %v, got %v", invalid, err)
+		}
+	}
+}
+
+func TestCollectionUsecase_LookupErrorsAreReturned(t *testing.T) {
+	dbErr := errors.New("connection refused")
+
+	collectionRepo := newMockCollectionRepo()
+	favoriteRepo := inMemoryFavoriteRepo()
+	uc := newTestCollectionUsecase(collectionRepo, favoriteRepo)
+	gifts, _ := uc.CreateCollection(1, CollectionInput{Name: "ギフト"}, 1)
+
+	// お気に入りの確認に失敗した場合は未登録とみなさずエラーを返す
+	favoriteRepo.findByCustomerIDAndProdFunc = func(customerID, productID int64) (*favorite.Favorite, error) {
+		return nil, dbErr
+	}
+	if _, err := uc.AddItem(gifts.ID, CollectionItemInput{ProductID: 5}, 1); !errors.Is(err, dbErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+	if c, _ := collectionRepo.FindByID(gifts.ID); len(c.Items) != 0 || len(favoriteRepo.favorites) != 0 {
+		t.Errorf("expected nothing to be written, got items %v and favorites %v", productIDs(c), favoriteRepo.favorites)
+	}
+
+	// リスト取得の失敗は ErrCollectionNotFound に変換しない
+	collectionRepo.findErr = dbErr
+	if _, err := uc.GetCollection(gifts.ID, 1); !errors.Is(err, dbErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+}
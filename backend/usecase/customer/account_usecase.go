@@ -0,0 +1,158 @@
+package customerusecase
+
+import (
+	"archive/zip"
+	"backend/domain/customer"
+	"backend/domain/favorite"
+	"backend/domain/review"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// DataExport - 本人の個人データのエクスポート
+type DataExport struct {
+	ExportedAt  time.Time             `json:"exportedAt"`
+	Profile     *customer.Customer    `json:"profile"`
+	Reviews     []review.Review       `json:"reviews"`
+	Favorites   []favorite.Favorite   `json:"favorites"`
+	Collections []favorite.Collection `json:"collections"`
+}
+
+// WriteZip - 項目ごとの JSON ファイルを ZIP アーカイブとして書き出す
+func (e *DataExport) WriteZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", e.Profile},
+		{"reviews.json", e.Reviews},
+		{"favorites.json", e.Favorites},
+		{"collections.json", e.Collections},
+	}
+	for _, f := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: e.ExportedAt})
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// AccountUsecase - 個人データのエクスポートと退会ユースケース
+type AccountUsecase struct {
+	customerRepo   customer.CustomerRepository
+	erasureRepo    customer.ErasureRepository
+	reviewRepo     review.ReviewRepository
+	favoriteRepo   favorite.FavoriteRepository
+	collectionRepo favorite.CollectionRepository
+	now            func() time.Time
+}
+
+// NewAccountUsecase - 個人データのエクスポートと退会ユースケースの生成
+func NewAccountUsecase(
+	customerRepo customer.CustomerRepository,
+	erasureRepo customer.ErasureRepository,
+	reviewRepo review.ReviewRepository,
+	favoriteRepo favorite.FavoriteRepository,
+	collectionRepo favorite.CollectionRepository,
+) *AccountUsecase {
+	return &AccountUsecase{
+		customerRepo:   customerRepo,
+		erasureRepo:    erasureRepo,
+		reviewRepo:     reviewRepo,
+		favoriteRepo:   favoriteRepo,
+		collectionRepo: collectionRepo,
+		now:            time.Now,
+	}
+}
+
+// ExportData - プロフィール・レビュー・お気に入り・お気に入りリストをまとめて取得
+func (u *AccountUsecase) ExportData(customerID int64) (*DataExport, error) {
+	c, err := findCustomer(u.customerRepo, customerID)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := u.reviewRepo.FindByCustomerID(customerID)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := u.favoriteRepo.FindByCustomerID(customerID)
+	if err != nil {
+		return nil, err
+	}
+	collections, err := u.collectionRepo.FindByCustomerID(customerID)
+	if err != nil {
+		return nil, err
+	}
+	return &DataExport{
+		ExportedAt:  u.now(),
+		Profile:     c,
+		Reviews:     reviews,
+		Favorites:   favorites,
+		Collections: collections,
+	}, nil
+}
+
+// RequestDeletion - 退会を申請（猶予期間が過ぎると匿名化される）
+func (u *AccountUsecase) RequestDeletion(customerID int64) (*customer.Customer, error) {
+	c, err := findCustomer(u.customerRepo, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.RequestDeletion(u.now()); err != nil {
+		return nil, err
+	}
+	if err := u.customerRepo.Update(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// CancelDeletion - 猶予期間中の退会申請を取り消す
+func (u *AccountUsecase) CancelDeletion(customerID int64) (*customer.Customer, error) {
+	c, err := findCustomer(u.customerRepo, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.CancelDeletion(); err != nil {
+		return nil, err
+	}
+	if err := u.customerRepo.Update(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// EraseDueAccounts - 猶予期間が過ぎたアカウントを匿名化（定期ジョブ、1件失敗しても残りは続ける）
+func (u *AccountUsecase) EraseDueAccounts() (int, error) {
+	now := u.now()
+	customers, err := u.erasureRepo.FindDueForErasure(now)
+	if err != nil {
+		return 0, err
+	}
+
+	erased := 0
+	var firstErr error
+	for i := range customers {
+		c := &customers[i]
+		if !c.IsDeletionDue(now) {
+			continue
+		}
+		c.Anonymize(now)
+		if err := u.erasureRepo.Erase(c); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		erased++
+	}
+	return erased, firstErr
+}
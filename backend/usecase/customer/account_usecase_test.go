@@ -0,0 +1,229 @@
+package customerusecase
+
+import (
+	"archive/zip"
+	"backend/domain/customer"
+	"backend/domain/favorite"
+	"backend/domain/review"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockErasureRepository - テスト用モックリポジトリ（カスタマーはモックのカスタマーリポジトリと共有）
+type mockErasureRepository struct {
+	customers *mockCustomerRepository
+	erased    []int64
+}
+
+func (m *mockErasureRepository) FindDueForErasure(now time.Time) ([]customer.Customer, error) {
+	var result []customer.Customer
+	for _, c := range m.customers.customers {
+		if c.IsDeletionDue(now) {
+			result = append(result, *c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockErasureRepository) Erase(c *customer.Customer) error {
+	m.erased = append(m.erased, c.ID)
+	return m.customers.Update(c)
+}
+
+// mockFavoriteRepository - テスト用モックリポジトリ
+type mockFavoriteRepository struct {
+	favorites []favorite.Favorite
+}
+
+func (m *mockFavoriteRepository) FindByCustomerID(customerID int64) ([]favorite.Favorite, error) {
+	var result []favorite.Favorite
+	for _, f := range m.favorites {
+		if f.CustomerID == customerID {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockFavoriteRepository) FindByCustomerIDAndProductID(_, _ int64) (*favorite.Favorite, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFavoriteRepository) Create(_ *favorite.Favorite) error { return nil }
+
+func (m *mockFavoriteRepository) Delete(_, _ int64) error { return nil }
+
+// mockCollectionRepository - テスト用モックリポジトリ
+type mockCollectionRepository struct {
+	collections []favorite.Collection
+}
+
+func (m *mockCollectionRepository) FindByCustomerID(customerID int64) ([]favorite.Collection, error) {
+	var result []favorite.Collection
+	for _, c := range m.collections {
+		if c.CustomerID == customerID {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCollectionRepository) FindByID(_ int64) (*favorite.Collection, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCollectionRepository) FindByShareToken(_ string) (*favorite.Collection, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCollectionRepository) FindDefault(_ int64) (*favorite.Collection, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCollectionRepository) Create(_ *favorite.Collection) error         { return nil }
+func (m *mockCollectionRepository) Update(_ *favorite.Collection) error         { return nil }
+func (m *mockCollectionRepository) Delete(_ int64) error                        { return nil }
+func (m *mockCollectionRepository) AddItem(_ *favorite.CollectionItem) error    { return nil }
+func (m *mockCollectionRepository) UpdateItem(_ *favorite.CollectionItem) error { return nil }
+func (m *mockCollectionRepository) RemoveItem(_, _ int64) error                 { return nil }
+func (m *mockCollectionRepository) RemoveProduct(_, _ int64) error              { return nil }
+func (m *mockCollectionRepository) ReorderItems(_ int64, _ []int64) error       { return nil }
+
+func newTestAccountUsecase(now time.Time) (*AccountUsecase, *mockCustomerRepository, *mockErasureRepository) {
+	customers := newMockCustomerRepo()
+	customers.customers[1].GoogleID = "google-1"
+	customers.customers[1].Email = "hanako@example.com"
+	erasure := &mockErasureRepository{customers: customers}
+	comment, _ := review.NewComment("とても美味しい大豆ミートでした")
+	reviews := &mockReviewRepository{reviews: []review.Review{
+		{ID: 10, ProductID: 100, CustomerID: 1, Rating: mustRating(5), Comment: comment},
+		{ID: 11, ProductID: 100, CustomerID: 2, Rating: mustRating(3), Comment: comment},
+	}}
+	favorites := &mockFavoriteRepository{favorites: []favorite.Favorite{{ID: 20, CustomerID: 1, ProductID: 100}}}
+	collections := &mockCollectionRepository{collections: []favorite.Collection{{ID: 30, CustomerID: 1, Name: "お気に入り", IsDefault: true}}}
+
+	uc := NewAccountUsecase(customers, erasure, reviews, favorites, collections)
+	uc.now = func() time.Time { return now }
+	return uc, customers, erasure
+}
+
+func TestExportData_Zip(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	uc, _, _ := newTestAccountUsecase(now)
+
+	export, err := uc.ExportData(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if export.Profile.ID != 1 || len(export.Reviews) != 1 || len(export.Favorites) != 1 || len(export.Collections) != 1 {
+		t.Fatalf("expected only customer 1's data, got %+v", export)
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteZip(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	for _, name := range []string{"profile.json", "reviews.json", "favorites.json", "collections.json"} {
+		if files[name] == nil {
+			t.Errorf("expected %s in archive", name)
+		}
+	}
+
+	rc, err := files["profile.json"].Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer rc.Close()
+	var profile customer.Customer
+	if err := json.NewDecoder(rc).Decode(&profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.Email != "hanako@example.com" {
+		t.Errorf("expected profile email in export, got %+v", profile)
+	}
+}
+
+func TestRequestDeletion_GracePeriod(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	uc, customers, erasure := newTestAccountUsecase(now)
+
+	c, err := uc.RequestDeletion(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.DeletionScheduledAt == nil || !c.DeletionScheduledAt.Equal(now.Add(customer.DeletionGracePeriod)) {
+		t.Errorf("expected deletion to be scheduled after the grace period, got %v", c.DeletionScheduledAt)
+	}
+	if _, err := uc.RequestDeletion(1); !errors.Is(err, customer.ErrDeletionAlreadyRequested) {
+		t.Errorf("expected ErrDeletionAlreadyRequested, got %v", err)
+	}
+
+	// 猶予期間中は匿名化しない
+	uc.now = func() time.Time { return now.Add(customer.DeletionGracePeriod - time.Minute) }
+	if erased, err := uc.EraseDueAccounts(); err != nil || erased != 0 {
+		t.Fatalf("expected nothing to be erased, got %d, %v", erased, err)
+	}
+
+	if _, err := uc.CancelDeletion(1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if customers.customers[1].DeletionScheduledAt != nil {
+		t.Error("expected deletion to be cancelled")
+	}
+	if _, err := uc.CancelDeletion(1); !errors.Is(err, customer.ErrDeletionNotRequested) {
+		t.Errorf("expected ErrDeletionNotRequested, got %v", err)
+	}
+
+	uc.now = func() time.Time { return now.Add(2 * customer.DeletionGracePeriod) }
+	if erased, err := uc.EraseDueAccounts(); err != nil || erased != 0 || len(erasure.erased) != 0 {
+		t.Errorf("expected cancelled account to be kept, got %d, %v", erased, err)
+	}
+}
+
+func TestEraseDueAccounts(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	uc, customers, erasure := newTestAccountUsecase(now)
+	if _, err := NewProfileUsecase(customers, &mockReviewRepository{}, newMockFollowRepo(nil)).UpdateProfile(1, UpdateProfileInput{Bio: strPtr("東京在住"), Avatar: strPtr("https://cdn.example.com/me.png")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.RequestDeletion(1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	later := now.Add(customer.DeletionGracePeriod)
+	uc.now = func() time.Time { return later }
+	erased, err := uc.EraseDueAccounts()
+	if err != nil || erased != 1 || len(erasure.erased) != 1 {
+		t.Fatalf("expected one erased account, got %d, %v", erased, err)
+	}
+
+	c := customers.customers[1]
+	if c.GoogleID != "" || c.Email != "" || c.Name != customer.DeletedName || c.Avatar != "" || c.Bio != "" {
+		t.Errorf("expected personal data to be removed, got %+v", c)
+	}
+	if c.AnonymizedAt == nil || !c.AnonymizedAt.Equal(later) || c.DeletionScheduledAt != nil {
+		t.Errorf("expected anonymized account, got %+v", c)
+	}
+
+	// 退会済みのアカウントは本人としても扱えない
+	if _, err := uc.ExportData(1); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+	if _, err := NewProfileUsecase(customers, &mockReviewRepository{}, newMockFollowRepo(nil)).GetProfile(1); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+	if erased, _ := uc.EraseDueAccounts(); erased != 0 {
+		t.Errorf("expected erasure to run once, got %d", erased)
+	}
+}
@@ -0,0 +1,87 @@
+package customerusecase
+
+import (
+	"backend/domain/click"
+	"backend/domain/product"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrClickProductNotFound = errors.New("product not found")
+	ErrClickLinkNotFound    = errors.New("store link not found")
+)
+
+// ClickUsecase - アフィリエイトリンクのクリック計測ユースケース
+type ClickUsecase struct {
+	clickRepo   click.ClickRepository
+	productRepo product.ProductRepository
+	secret      string
+	now         func() time.Time
+}
+
+// TrackClickInput - クリック計測の入力
+type TrackClickInput struct {
+	ProductID int64
+	Store     string
+	IPAddress string
+	UserAgent string
+	Referrer  string
+}
+
+// NewClickUsecase - クリック計測ユースケースの生成（secret は訪問者IDの匿名化に使用）
+func NewClickUsecase(clickRepo click.ClickRepository, productRepo product.ProductRepository, secret string) *ClickUsecase {
+	return &ClickUsecase{
+		clickRepo:   clickRepo,
+		productRepo: productRepo,
+		secret:      secret,
+		now:         time.Now,
+	}
+}
+
+// TrackClick - クリックを記録してリダイレクト先URLを返す
+// 記録に失敗してもリダイレクト先は返す（収益に直結するため遷移を優先する）
+func (u *ClickUsecase) TrackClick(input TrackClickInput) (string, error) {
+	p, err := u.productRepo.FindByID(input.ProductID)
+	if errors.Is(err, product.ErrProductNotFound) {
+		return "", ErrClickProductNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	store := strings.ToLower(strings.TrimSpace(input.Store))
+	target := u.resolveURL(p, store)
+	if target == "" {
+		return "", ErrClickLinkNotFound
+	}
+
+	now := u.now()
+	c := &click.Click{
+		ProductID: p.ID,
+		Store:     store,
+		VisitorID: click.NewVisitorID(u.secret, input.IPAddress, input.UserAgent, now),
+		Referrer:  click.NormalizeReferrer(input.Referrer),
+		ClickedAt: now,
+	}
+	if err := u.clickRepo.Create(c); err != nil {
+		return target, fmt.Errorf("record click: %w", err)
+	}
+	return target, nil
+}
+
+// resolveURL - ストアキーに対応する遷移先URLを取得（未登録なら空文字）
+func (u *ClickUsecase) resolveURL(p *product.Product, store string) string {
+	if store == click.StoreAffiliate {
+		if p.AffiliateURL != nil {
+			return *p.AffiliateURL
+		}
+		return ""
+	}
+	if url := p.StoreURL(store); url != nil {
+		return *url
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+package customerusecase
+
+import (
+	"backend/domain/click"
+	"backend/domain/product"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// mockClickRepository - テスト用モックリポジトリ
+type mockClickRepository struct {
+	clicks    []click.Click
+	createErr error
+}
+
+func (m *mockClickRepository) Create(c *click.Click) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.clicks = append(m.clicks, *c)
+	return nil
+}
+func (m *mockClickRepository) CountDaily(from, to time.Time, productID *int64) ([]click.DailyCount, error) {
+	return nil, nil
+}
+
+func newClickTestProducts() *mockProductRepository {
+	affiliate := "https://af.moshimo.com/af/c/click?a_id=1"
+	return &mockProductRepository{
+		findByIDFn: func(id int64) (*product.Product, error) {
+			if id == 3 {
+				return nil, errors.New("connection reset")
+			}
+			if id != 1 {
+				return nil, product.ErrProductNotFound
+			}
+			return &product.Product{
+				ID:           1,
+				AffiliateURL: &affiliate,
+				StoreLinks: []product.StoreLink{
+					{ProductID: 1, StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon"}, URL: "https://www.amazon.co.jp/dp/B000000001"},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestTrackClick_RecordsAndReturnsStoreURL(t *testing.T) {
+	clickRepo := &mockClickRepository{}
+	uc := NewClickUsecase(clickRepo, newClickTestProducts(), "secret")
+	uc.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	target, err := uc.TrackClick(TrackClickInput{
+		ProductID: 1,
+		Store:     "Amazon",
+		IPAddress: "203.0.113.10",
+		UserAgent: "Mozilla/5.0",
+		Referrer:  "https://veganbite.example/products/1?utm_source=x#reviews",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if target != "https://www.amazon.co.jp/dp/B000000001" {
+		t.Errorf("unexpected redirect target %q", target)
+	}
+	if len(clickRepo.clicks) != 1 {
+		t.Fatalf("expected 1 click, got %d", len(clickRepo.clicks))
+	}
+
+	c := clickRepo.clicks[0]
+	if c.Store != "amazon" {
+		t.Errorf("expected store 'amazon', got %q", c.Store)
+	}
+	if c.Referrer == nil || *c.Referrer != "https://veganbite.example/products/1" {
+		t.Errorf("expected referrer without query, got %v", c.Referrer)
+	}
+	if c.VisitorID == "" || c.VisitorID == "203.0.113.10" {
+		t.Errorf("expected anonymized visitor ID, got %q", c.VisitorID)
+	}
+}
+
+func TestTrackClick_AffiliateURL(t *testing.T) {
+	uc := NewClickUsecase(&mockClickRepository{}, newClickTestProducts(), "secret")
+
+	target, err := uc.TrackClick(TrackClickInput{ProductID: 1, Store: click.StoreAffiliate})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if target != "https://af.moshimo.com/af/c/click?a_id=1" {
+		t.Errorf("unexpected redirect target %q", target)
+	}
+}
+
+func TestTrackClick_NotFound(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   TrackClickInput
+		wantErr error
+	}{
+		{"存在しない商品", TrackClickInput{ProductID: 2, Store: "amazon"}, ErrClickProductNotFound},
+		{"リンク未登録のストア", TrackClickInput{ProductID: 1, Store: "rakuten"}, ErrClickLinkNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clickRepo := &mockClickRepository{}
+			uc := NewClickUsecase(clickRepo, newClickTestProducts(), "secret")
+
+			_, err := uc.TrackClick(tc.input)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected %v, got %v", tc.wantErr, err)
+			}
+			if len(clickRepo.clicks) != 0 {
+				t.Error("expected no click to be recorded")
+			}
+		})
+	}
+}
+
+func TestTrackClick_LookupError(t *testing.T) {
+	uc := NewClickUsecase(&mockClickRepository{}, newClickTestProducts(), "secret")
+
+	target, err := uc.TrackClick(TrackClickInput{ProductID: 3, Store: "amazon"})
+	if err == nil || errors.Is(err, ErrClickProductNotFound) || target != "" {
+		t.Errorf("expected the lookup error without a redirect target, got %q, %v", target, err)
+	}
+}
+
+func TestTrackClick_RedirectsEvenIfRecordingFails(t *testing.T) {
+	uc := NewClickUsecase(&mockClickRepository{createErr: errors.New("db down")}, newClickTestProducts(), "secret")
+
+	target, err := uc.TrackClick(TrackClickInput{ProductID: 1, Store: "amazon"})
+	if err == nil {
+		t.Error("expected recording error to be reported")
+	}
+	if target == "" {
+		t.Error("expected redirect target even when recording fails")
+	}
+}
+
+func TestNewVisitorID_RotatesDaily(t *testing.T) {
+	day1 := time.Date(2025, 6, 1, 23, 0, 0, 0, time.UTC)
+	day2 := day1.Add(2 * time.Hour)
+
+	a := click.NewVisitorID("secret", "203.0.113.10", "UA", day1)
+	b := click.NewVisitorID("secret", "203.0.113.10", "UA", day1.Add(-time.Hour))
+	c := click.NewVisitorID("secret", "203.0.113.10", "UA", day2)
+
+	if a != b {
+		t.Error("expected same visitor ID within a day")
+	}
+	if a == c {
+		t.Error("expected visitor ID to change on the next day")
+	}
+}
+
+func TestNormalizeReferrer_TruncatesOnRuneBoundary(t *testing.T) {
+	long := "https://" + strings.Repeat("あ", click.ReferrerMaxLength) + ".example/"
+
+	ref := click.NormalizeReferrer(long)
+	if ref == nil {
+		t.Fatal("expected referrer")
+	}
+	if !utf8.ValidString(*ref) {
+		t.Errorf("expected valid UTF-8, got %q", *ref)
+	}
+	if n := utf8.RuneCountInString(*ref); n != click.ReferrerMaxLength {
+		t.Errorf("expected %d characters, got %d", click.ReferrerMaxLength, n)
+	}
+}
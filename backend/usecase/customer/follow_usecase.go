@@ -0,0 +1,74 @@
+package customerusecase
+
+import (
+	"backend/domain/customer"
+	"backend/domain/follow"
+	"backend/domain/review"
+	"fmt"
+	"time"
+)
+
+var ErrFeedLimitInvalid = fmt.Errorf("limit must be between 1 and %d", follow.FeedMaxLimit)
+
+// FeedPage - フィードの1ページ（NextBefore を before に渡すと続きを取得、null なら最後）
+type FeedPage struct {
+	Reviews    []review.Review `json:"reviews"`
+	NextBefore *int64          `json:"nextBefore"`
+}
+
+// FollowUsecase - レビュアーのフォローとフィードユースケース
+type FollowUsecase struct {
+	followRepo   follow.FollowRepository
+	customerRepo customer.CustomerRepository
+	now          func() time.Time
+}
+
+// NewFollowUsecase - レビュアーのフォローとフィードユースケースの生成
+func NewFollowUsecase(followRepo follow.FollowRepository, customerRepo customer.CustomerRepository) *FollowUsecase {
+	return &FollowUsecase{
+		followRepo:   followRepo,
+		customerRepo: customerRepo,
+		now:          time.Now,
+	}
+}
+
+// Follow - カスタマーをフォロー（非公開・退会済みのカスタマーはフォローできない、フォロー済みなら何もしない）
+func (u *FollowUsecase) Follow(followerID, followeeID int64) error {
+	f, err := follow.NewFollow(followerID, followeeID, u.now())
+	if err != nil {
+		return err
+	}
+	followee, err := u.customerRepo.FindByID(followeeID)
+	if err != nil || !followee.IsProfileVisibleTo(followerID) {
+		return ErrProfileNotFound
+	}
+	return u.followRepo.Create(f)
+}
+
+// Unfollow - フォロー解除（フォローしていなければ何もしない）
+func (u *FollowUsecase) Unfollow(followerID, followeeID int64) error {
+	return u.followRepo.Delete(followerID, followeeID)
+}
+
+// GetFeed - フォロー中のカスタマーの新しいレビュー（limit が 0 なら既定の件数）
+func (u *FollowUsecase) GetFeed(followerID, beforeID int64, limit int) (*FeedPage, error) {
+	if limit == 0 {
+		limit = follow.FeedDefaultLimit
+	}
+	if limit < 0 || limit > follow.FeedMaxLimit {
+		return nil, ErrFeedLimitInvalid
+	}
+
+	// 1件多く取得して続きの有無を判定
+	reviews, err := u.followRepo.FindFeed(followerID, beforeID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &FeedPage{Reviews: reviews}
+	if len(reviews) > limit {
+		page.Reviews = reviews[:limit]
+		next := page.Reviews[limit-1].ID
+		page.NextBefore = &next
+	}
+	return page, nil
+}
@@ -0,0 +1,147 @@
+package customerusecase
+
+import (
+	"backend/domain/customer"
+	"backend/domain/follow"
+	"backend/domain/review"
+	"errors"
+	"sort"
+	"testing"
+)
+
+// mockFollowRepository - テスト用モックリポジトリ（FindFeed は reviews から組み立てる）
+type mockFollowRepository struct {
+	follows map[[2]int64]bool
+	reviews []review.Review
+}
+
+func newMockFollowRepo(reviews []review.Review) *mockFollowRepository {
+	return &mockFollowRepository{follows: make(map[[2]int64]bool), reviews: reviews}
+}
+
+func (m *mockFollowRepository) Create(f *follow.Follow) error {
+	m.follows[[2]int64{f.FollowerID, f.FolloweeID}] = true
+	return nil
+}
+
+func (m *mockFollowRepository) Delete(followerID, followeeID int64) error {
+	delete(m.follows, [2]int64{followerID, followeeID})
+	return nil
+}
+
+func (m *mockFollowRepository) Exists(followerID, followeeID int64) (bool, error) {
+	return m.follows[[2]int64{followerID, followeeID}], nil
+}
+
+func (m *mockFollowRepository) Counts(customerID int64) (follow.Counts, error) {
+	var counts follow.Counts
+	for key := range m.follows {
+		if key[1] == customerID {
+			counts.Followers++
+		}
+		if key[0] == customerID {
+			counts.Following++
+		}
+	}
+	return counts, nil
+}
+
+func (m *mockFollowRepository) FindFeed(followerID, beforeID int64, limit int) ([]review.Review, error) {
+	var result []review.Review
+	for _, r := range m.reviews {
+		if m.follows[[2]int64{followerID, r.CustomerID}] && (beforeID == 0 || r.ID < beforeID) {
+			result = append(result, r)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID > result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func TestFollow(t *testing.T) {
+	customers := newMockCustomerRepo()
+	customers.customers[2] = &customer.Customer{ID: 2, Name: "Taro", ProfileVisibility: customer.VisibilityPublic}
+	customers.customers[3] = &customer.Customer{ID: 3, Name: "Jiro", ProfileVisibility: customer.VisibilityPrivate}
+	follows := newMockFollowRepo(nil)
+	uc := NewFollowUsecase(follows, customers)
+
+	if err := uc.Follow(1, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 2回目のフォローは何もしない
+	if err := uc.Follow(1, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.Follow(1, 1); !errors.Is(err, follow.ErrCannotFollowSelf) {
+		t.Errorf("expected ErrCannotFollowSelf, got %v", err)
+	}
+	if err := uc.Follow(1, 3); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for private profile, got %v", err)
+	}
+	if err := uc.Follow(1, 999); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+
+	profiles := NewProfileUsecase(customers, &mockReviewRepository{}, follows)
+	p, err := profiles.GetPublicProfile(2, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.FollowerCount != 1 || p.FollowingCount != 0 || !p.IsFollowing {
+		t.Errorf("unexpected follow stats: %+v", p)
+	}
+	if p, _ := profiles.GetPublicProfile(1, 0); p.FollowingCount != 1 || p.IsFollowing {
+		t.Errorf("unexpected follow stats: %+v", p)
+	}
+
+	if err := uc.Unfollow(1, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p, _ := profiles.GetPublicProfile(2, 1); p.FollowerCount != 0 || p.IsFollowing {
+		t.Errorf("expected unfollowed, got %+v", p)
+	}
+}
+
+func TestGetFeed_Paginates(t *testing.T) {
+	customers := newMockCustomerRepo()
+	customers.customers[2] = &customer.Customer{ID: 2, Name: "Taro"}
+	customers.customers[3] = &customer.Customer{ID: 3, Name: "Jiro"}
+	var reviews []review.Review
+	for id := int64(1); id <= 5; id++ {
+		reviews = append(reviews, review.Review{ID: id, ProductID: id, CustomerID: 2, Rating: mustRating(4)})
+	}
+	reviews = append(reviews, review.Review{ID: 6, ProductID: 1, CustomerID: 3, Rating: mustRating(5)})
+	follows := newMockFollowRepo(reviews)
+	uc := NewFollowUsecase(follows, customers)
+	if err := uc.Follow(1, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	page, err := uc.GetFeed(1, 0, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Reviews) != 2 || page.Reviews[0].ID != 5 || page.Reviews[1].ID != 4 {
+		t.Fatalf("expected newest reviews of followed customers, got %+v", page.Reviews)
+	}
+	if page.NextBefore == nil || *page.NextBefore != 4 {
+		t.Fatalf("expected nextBefore 4, got %v", page.NextBefore)
+	}
+
+	page, err = uc.GetFeed(1, *page.NextBefore, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Reviews) != 3 || page.Reviews[0].ID != 3 || page.NextBefore != nil {
+		t.Errorf("expected last page, got %+v, next %v", page.Reviews, page.NextBefore)
+	}
+
+	if page, _ := uc.GetFeed(1, 0, 0); len(page.Reviews) != 5 {
+		t.Errorf("expected default limit to return all 5 reviews, got %d", len(page.Reviews))
+	}
+	if _, err := uc.GetFeed(1, 0, follow.FeedMaxLimit+1); !errors.Is(err, ErrFeedLimitInvalid) {
+		t.Errorf("expected ErrFeedLimitInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package customerusecase
+
+import (
+	"backend/domain/notification"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultNotificationLimit = 50
+	maxNotificationLimit     = 100
+)
+
+// NotificationUsecase - 通知受信箱・受信設定ユースケース
+type NotificationUsecase struct {
+	notificationRepo notification.NotificationRepository
+	now              func() time.Time
+}
+
+// NewNotificationUsecase - 通知受信箱ユースケースの生成
+func NewNotificationUsecase(notificationRepo notification.NotificationRepository) *NotificationUsecase {
+	return &NotificationUsecase{
+		notificationRepo: notificationRepo,
+		now:              time.Now,
+	}
+}
+
+// Inbox - 通知一覧と未読件数
+type Inbox struct {
+	Notifications []notification.Notification `json:"notifications"`
+	UnreadCount   int64                       `json:"unreadCount"`
+}
+
+// PreferenceInput - 受信設定の更新入力
+type PreferenceInput struct {
+	Type  string
+	InApp bool
+	Email bool
+}
+
+// GetInbox - 通知一覧取得（新しい順、limit は 1〜100、0 なら既定値）
+func (u *NotificationUsecase) GetInbox(customerID int64, unreadOnly bool, limit int) (*Inbox, error) {
+	if limit <= 0 {
+		limit = defaultNotificationLimit
+	}
+	if limit > maxNotificationLimit {
+		limit = maxNotificationLimit
+	}
+	notifications, err := u.notificationRepo.FindByCustomerID(customerID, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := u.notificationRepo.CountUnread(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if notifications == nil {
+		notifications = []notification.Notification{}
+	}
+	return &Inbox{Notifications: notifications, UnreadCount: unread}, nil
+}
+
+// MarkRead - 指定した通知を既読にする（他の顧客の通知は対象外）
+func (u *NotificationUsecase) MarkRead(customerID int64, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return u.notificationRepo.MarkRead(customerID, ids, u.now())
+}
+
+// MarkAllRead - 全ての未読通知を既読にする
+func (u *NotificationUsecase) MarkAllRead(customerID int64) (int64, error) {
+	return u.notificationRepo.MarkRead(customerID, nil, u.now())
+}
+
+// GetPreferences - 受信設定取得（未設定の種類は既定値）
+func (u *NotificationUsecase) GetPreferences(customerID int64) ([]notification.Preference, error) {
+	stored, err := u.notificationRepo.FindPreferences([]int64{customerID})
+	if err != nil {
+		return nil, err
+	}
+	return notification.ResolvePreferences(customerID, stored), nil
+}
+
+// UpdatePreferences - 受信設定更新（指定した種類のみ変更）
+func (u *NotificationUsecase) UpdatePreferences(customerID int64, inputs []PreferenceInput) ([]notification.Preference, error) {
+	prefs := make([]notification.Preference, 0, len(inputs))
+	for _, input := range inputs {
+		t, err := notification.NewType(input.Type)
+		if err != nil {
+			return nil, fmt.Errorf("type: %w", err)
+		}
+		prefs = append(prefs, notification.Preference{CustomerID: customerID, Type: t, InApp: input.InApp, Email: input.Email})
+	}
+	if err := u.notificationRepo.SavePreferences(prefs); err != nil {
+		return nil, err
+	}
+	return u.GetPreferences(customerID)
+}
@@ -0,0 +1,173 @@
+package customerusecase
+
+import (
+	"backend/domain/notification"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+)
+
+// mockNotificationRepository - テスト用モックリポジトリ
+type mockNotificationRepository struct {
+	notifications []notification.Notification
+	preferences   map[int64]map[notification.Type]notification.Preference
+}
+
+func newMockNotificationRepo(notifications ...notification.Notification) *mockNotificationRepository {
+	return &mockNotificationRepository{
+		notifications: notifications,
+		preferences:   map[int64]map[notification.Type]notification.Preference{},
+	}
+}
+
+func (m *mockNotificationRepository) FindSubscribers(productID int64) ([]notification.Recipient, error) {
+	return nil, nil
+}
+
+func (m *mockNotificationRepository) FindPreferences(customerIDs []int64) ([]notification.Preference, error) {
+	var result []notification.Preference
+	for _, id := range customerIDs {
+		for _, p := range m.preferences[id] {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockNotificationRepository) SavePreferences(prefs []notification.Preference) error {
+	for _, p := range prefs {
+		if m.preferences[p.CustomerID] == nil {
+			m.preferences[p.CustomerID] = map[notification.Type]notification.Preference{}
+		}
+		m.preferences[p.CustomerID][p.Type] = p
+	}
+	return nil
+}
+
+func (m *mockNotificationRepository) Create(n *notification.Notification) error {
+	m.notifications = append(m.notifications, *n)
+	return nil
+}
+
+func (m *mockNotificationRepository) FindByCustomerID(customerID int64, unreadOnly bool, limit int) ([]notification.Notification, error) {
+	var result []notification.Notification
+	for _, n := range m.notifications {
+		if n.CustomerID == customerID && (!unreadOnly || n.ReadAt == nil) {
+			result = append(result, n)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *mockNotificationRepository) CountUnread(customerID int64) (int64, error) {
+	var count int64
+	for _, n := range m.notifications {
+		if n.CustomerID == customerID && n.ReadAt == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockNotificationRepository) MarkRead(customerID int64, ids []int64, at time.Time) (int64, error) {
+	target := map[int64]bool{}
+	for _, id := range ids {
+		target[id] = true
+	}
+	var updated int64
+	for i := range m.notifications {
+		n := &m.notifications[i]
+		if n.CustomerID != customerID || n.ReadAt != nil || (len(ids) > 0 && !target[n.ID]) {
+			continue
+		}
+		n.ReadAt = &at
+		updated++
+	}
+	return updated, nil
+}
+
+func (m *mockNotificationRepository) FindDeliveries(eventID int64) ([]notification.Delivery, error) {
+	return nil, nil
+}
+
+func (m *mockNotificationRepository) SaveDelivery(d *notification.Delivery) error {
+	return nil
+}
+
+func TestNotificationUsecase_Inbox(t *testing.T) {
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	repo := newMockNotificationRepo(
+		notification.Notification{ID: 1, CustomerID: 1, Type: notification.TypeNewReview, CreatedAt: base},
+		notification.Notification{ID: 2, CustomerID: 1, Type: notification.TypePriceDrop, CreatedAt: base.Add(time.Hour)},
+		notification.Notification{ID: 3, CustomerID: 1, Type: notification.TypeBackInStock, CreatedAt: base.Add(2 * time.Hour)},
+		notification.Notification{ID: 4, CustomerID: 2, Type: notification.TypeNewReview, CreatedAt: base},
+	)
+	uc := NewNotificationUsecase(repo)
+	uc.now = func() time.Time { return base.Add(24 * time.Hour) }
+
+	inbox, err := uc.GetInbox(1, false, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inbox.Notifications) != 3 || inbox.UnreadCount != 3 || inbox.Notifications[0].ID != 3 {
+		t.Fatalf("expected 3 unread notifications newest first, got %+v", inbox)
+	}
+
+	// 他の顧客の通知は既読にできない
+	updated, err := uc.MarkRead(1, []int64{1, 4})
+	if err != nil || updated != 1 {
+		t.Fatalf("expected 1 updated notification, got %d (err %v)", updated, err)
+	}
+	if n, _ := uc.GetInbox(2, true, 0); n.UnreadCount != 1 {
+		t.Errorf("expected other customer's notification to stay unread")
+	}
+
+	inbox, _ = uc.GetInbox(1, true, 1)
+	if len(inbox.Notifications) != 1 || inbox.UnreadCount != 2 {
+		t.Errorf("expected 1 of 2 unread notifications, got %d of %d", len(inbox.Notifications), inbox.UnreadCount)
+	}
+
+	if updated, _ := uc.MarkAllRead(1); updated != 2 {
+		t.Errorf("expected 2 notifications marked read, got %d", updated)
+	}
+	if inbox, _ := uc.GetInbox(1, true, 0); len(inbox.Notifications) != 0 || inbox.UnreadCount != 0 {
+		t.Errorf("expected empty unread inbox, got %+v", inbox)
+	}
+}
+
+func TestNotificationUsecase_Preferences(t *testing.T) {
+	uc := NewNotificationUsecase(newMockNotificationRepo())
+
+	prefs, err := uc.GetPreferences(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prefs) != len(notification.Types()) {
+		t.Fatalf("expected preferences for every type, got %d", len(prefs))
+	}
+	for _, p := range prefs {
+		if !p.InApp || p.Email {
+			t.Errorf("expected default in-app only for %s, got %+v", p.Type, p)
+		}
+	}
+
+	prefs, err = uc.UpdatePreferences(1, []PreferenceInput{{Type: "price_drop", InApp: true, Email: true}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, p := range prefs {
+		wantEmail := p.Type == notification.TypePriceDrop
+		if p.Email != wantEmail {
+			t.Errorf("unexpected email setting for %s: %v", p.Type, p.Email)
+		}
+	}
+
+	if _, err := uc.UpdatePreferences(1, []PreferenceInput{{Type: "newsletter"}}); !errors.Is(err, notification.ErrTypeInvalid) {
+		t.Errorf("expected ErrTypeInvalid, got %v", err)
+	}
+}
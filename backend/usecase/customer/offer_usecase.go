@@ -0,0 +1,45 @@
+package customerusecase
+
+import (
+	"backend/domain/offer"
+	"time"
+)
+
+// OfferUsecase - 利用者向けストア価格ユースケース
+type OfferUsecase struct {
+	offerRepo offer.OfferRepository
+	now       func() time.Time
+}
+
+// ProductOffers - 商品詳細に表示する価格情報
+type ProductOffers struct {
+	Offers   []offer.Offer `json:"offers"`
+	Cheapest *offer.Offer  `json:"cheapest"`
+	Trend    offer.Trend   `json:"trend"`
+}
+
+// NewOfferUsecase - 利用者向けストア価格ユースケースの生成
+func NewOfferUsecase(offerRepo offer.OfferRepository) *OfferUsecase {
+	return &OfferUsecase{
+		offerRepo: offerRepo,
+		now:       time.Now,
+	}
+}
+
+// GetProductOffers - 商品のストア価格・最安値・価格推移を取得
+func (u *OfferUsecase) GetProductOffers(productID int64) (*ProductOffers, error) {
+	offers, err := u.offerRepo.FindByProductID(productID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := u.offerRepo.FindHistory(productID, u.now().Add(-offer.TrendWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductOffers{
+		Offers:   offers,
+		Cheapest: offer.Cheapest(offers),
+		Trend:    offer.BuildTrend(history),
+	}, nil
+}
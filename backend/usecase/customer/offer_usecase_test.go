@@ -0,0 +1,94 @@
+package customerusecase
+
+import (
+	"backend/domain/offer"
+	"testing"
+	"time"
+)
+
+type mockOfferRepository struct {
+	offers  []offer.Offer
+	history []offer.PricePoint
+}
+
+func (m *mockOfferRepository) FindByProductID(productID int64) ([]offer.Offer, error) {
+	return m.offers, nil
+}
+
+func (m *mockOfferRepository) FindByProductIDAndStore(productID int64, store string) (*offer.Offer, error) {
+	return nil, nil
+}
+
+func (m *mockOfferRepository) FindByProductIDAndStoreForUpdate(productID int64, store string) (*offer.Offer, error) {
+	return nil, nil
+}
+
+func (m *mockOfferRepository) Save(o *offer.Offer, history *offer.PricePoint) error {
+	return nil
+}
+
+func (m *mockOfferRepository) FindHistory(productID int64, since time.Time) ([]offer.PricePoint, error) {
+	return m.history, nil
+}
+
+func TestOfferUsecase_GetProductOffers(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 12, 0, 0, 0, time.UTC) }
+
+	repo := &mockOfferRepository{
+		offers: []offer.Offer{
+			{ID: 1, Store: "amazon", PriceAmount: 980, Currency: "JPY", Availability: offer.AvailabilityOutOfStock},
+			{ID: 2, Store: "rakuten", PriceAmount: 1100, Currency: "JPY", Availability: offer.AvailabilityInStock},
+			{ID: 3, Store: "yahoo", PriceAmount: 1050, Currency: "JPY", Availability: offer.AvailabilityInStock},
+		},
+		history: []offer.PricePoint{
+			{Store: "rakuten", PriceAmount: 1300, Currency: "JPY", Availability: offer.AvailabilityInStock, RecordedAt: day(1)},
+			{Store: "yahoo", PriceAmount: 1250, Currency: "JPY", Availability: offer.AvailabilityInStock, RecordedAt: day(1)},
+			{Store: "amazon", PriceAmount: 900, Currency: "JPY", Availability: offer.AvailabilityOutOfStock, RecordedAt: day(2)},
+			{Store: "yahoo", PriceAmount: 1050, Currency: "JPY", Availability: offer.AvailabilityInStock, RecordedAt: day(3)},
+		},
+	}
+	uc := NewOfferUsecase(repo)
+
+	result, err := uc.GetProductOffers(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("在庫ありの最安オファーを返す", func(t *testing.T) {
+		if result.Cheapest == nil || result.Cheapest.Store != "yahoo" {
+			t.Errorf("expected cheapest offer from yahoo, got %+v", result.Cheapest)
+		}
+	})
+
+	t.Run("日別最安値と値下がり傾向を返す", func(t *testing.T) {
+		if len(result.Trend.Points) != 2 {
+			t.Fatalf("expected 2 daily points (out of stock excluded), got %v", result.Trend.Points)
+		}
+		if result.Trend.Points[0].PriceAmount != 1250 {
+			t.Errorf("expected lowest price 1250 on first day, got %d", result.Trend.Points[0].PriceAmount)
+		}
+		if result.Trend.Direction != offer.TrendDown {
+			t.Errorf("expected trend down, got %s", result.Trend.Direction)
+		}
+	})
+}
+
+func TestOfferUsecase_NoInStockOffers(t *testing.T) {
+	repo := &mockOfferRepository{
+		offers: []offer.Offer{
+			{ID: 1, Store: "amazon", PriceAmount: 980, Currency: "JPY", Availability: offer.AvailabilityOutOfStock},
+		},
+	}
+	uc := NewOfferUsecase(repo)
+
+	result, err := uc.GetProductOffers(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Cheapest != nil {
+		t.Errorf("expected no cheapest offer, got %+v", result.Cheapest)
+	}
+	if result.Trend.Direction != offer.TrendFlat {
+		t.Errorf("expected flat trend, got %s", result.Trend.Direction)
+	}
+}
@@ -8,19 +8,21 @@ import (
 type ProductUsecase struct {
 	productRepo  product.ProductRepository
 	categoryRepo product.CategoryRepository
+	storeRepo    product.StoreRepository
 }
 
 // NewProductUsecase - 利用者向け商品ユースケースの生成
-func NewProductUsecase(productRepo product.ProductRepository, categoryRepo product.CategoryRepository) *ProductUsecase {
+func NewProductUsecase(productRepo product.ProductRepository, categoryRepo product.CategoryRepository, storeRepo product.StoreRepository) *ProductUsecase {
 	return &ProductUsecase{
 		productRepo:  productRepo,
 		categoryRepo: categoryRepo,
+		storeRepo:    storeRepo,
 	}
 }
 
 // GetAllProducts - 商品一覧取得
-func (u *ProductUsecase) GetAllProducts(categoryID int64, search string) ([]product.Product, error) {
-	return u.productRepo.FindAll(categoryID, search)
+func (u *ProductUsecase) GetAllProducts(filter product.ProductFilter) ([]product.Product, error) {
+	return u.productRepo.FindAll(filter)
 }
 
 // GetProduct - 商品詳細取得
@@ -32,3 +34,8 @@ func (u *ProductUsecase) GetProduct(id int64) (*product.Product, error) {
 func (u *ProductUsecase) GetAllCategories() ([]product.Category, error) {
 	return u.categoryRepo.FindAll()
 }
+
+// GetActiveStores - 有効なストア一覧取得
+func (u *ProductUsecase) GetActiveStores() ([]product.Store, error) {
+	return u.storeRepo.FindAll(true)
+}
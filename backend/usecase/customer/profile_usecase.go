@@ -0,0 +1,111 @@
+package customerusecase
+
+import (
+	"backend/domain/customer"
+	"backend/domain/follow"
+	"backend/domain/review"
+	"errors"
+)
+
+var ErrProfileNotFound = errors.New("customer not found")
+
+// UpdateProfileInput - プロフィール更新の入力（nil の項目は変更しない）
+type UpdateProfileInput struct {
+	Name              *string
+	Avatar            *string
+	Bio               *string
+	PreferredLanguage *string
+	DietaryPreference *string
+	ProfileVisibility *string
+}
+
+// ProfileUsecase - プロフィールユースケース（本人の編集と公開プロフィール）
+type ProfileUsecase struct {
+	customerRepo customer.CustomerRepository
+	reviewRepo   review.ReviewRepository
+	followRepo   follow.FollowRepository
+}
+
+// NewProfileUsecase - プロフィールユースケースの生成
+func NewProfileUsecase(customerRepo customer.CustomerRepository, reviewRepo review.ReviewRepository, followRepo follow.FollowRepository) *ProfileUsecase {
+	return &ProfileUsecase{customerRepo: customerRepo, reviewRepo: reviewRepo, followRepo: followRepo}
+}
+
+// GetProfile - 本人のプロフィール取得
+func (u *ProfileUsecase) GetProfile(customerID int64) (*customer.Customer, error) {
+	return findCustomer(u.customerRepo, customerID)
+}
+
+// UpdateProfile - 本人のプロフィール部分更新
+func (u *ProfileUsecase) UpdateProfile(customerID int64, input UpdateProfileInput) (*customer.Customer, error) {
+	c, err := findCustomer(u.customerRepo, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.ApplyProfile(customer.ProfileChanges{
+		Name:              input.Name,
+		Avatar:            input.Avatar,
+		Bio:               input.Bio,
+		PreferredLanguage: input.PreferredLanguage,
+		DietaryPreference: input.DietaryPreference,
+		ProfileVisibility: input.ProfileVisibility,
+	}); err != nil {
+		return nil, err
+	}
+	if err := u.customerRepo.Update(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetPublicProfile - 公開プロフィール取得（非公開・退会済みは本人以外には見つからない扱い、viewerID は未ログインなら 0）
+func (u *ProfileUsecase) GetPublicProfile(customerID, viewerID int64) (*customer.PublicProfile, error) {
+	c, err := u.findVisible(customerID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	reviewCount, err := u.reviewRepo.CountByCustomerID(customerID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := u.followRepo.Counts(customerID)
+	if err != nil {
+		return nil, err
+	}
+	stats := customer.ProfileStats{
+		ReviewCount:    reviewCount,
+		FollowerCount:  counts.Followers,
+		FollowingCount: counts.Following,
+	}
+	if viewerID != 0 && viewerID != customerID {
+		if stats.IsFollowing, err = u.followRepo.Exists(viewerID, customerID); err != nil {
+			return nil, err
+		}
+	}
+	return c.PublicProfile(stats), nil
+}
+
+// GetPublicReviews - カスタマーのレビュー一覧取得（公開範囲は GetPublicProfile と同じ）
+func (u *ProfileUsecase) GetPublicReviews(customerID, viewerID int64) ([]review.Review, error) {
+	if _, err := u.findVisible(customerID, viewerID); err != nil {
+		return nil, err
+	}
+	return u.reviewRepo.FindByCustomerID(customerID)
+}
+
+func (u *ProfileUsecase) findVisible(customerID, viewerID int64) (*customer.Customer, error) {
+	c, err := u.customerRepo.FindByID(customerID)
+	if err != nil || !c.IsProfileVisibleTo(viewerID) {
+		return nil, ErrProfileNotFound
+	}
+	return c, nil
+}
+
+// findCustomer - 本人のカスタマー取得（退会済みは見つからない扱い）
+func findCustomer(repo customer.CustomerRepository, customerID int64) (*customer.Customer, error) {
+	c, err := repo.FindByID(customerID)
+	if err != nil || c.IsAnonymized() {
+		return nil, ErrProfileNotFound
+	}
+	return c, nil
+}
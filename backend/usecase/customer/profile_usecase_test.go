@@ -0,0 +1,198 @@
+package customerusecase
+
+import (
+	"backend/domain/customer"
+	"backend/domain/review"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mockCustomerRepository - テスト用モックリポジトリ
+type mockCustomerRepository struct {
+	customers map[int64]*customer.Customer
+	updateErr error
+}
+
+func newMockCustomerRepo() *mockCustomerRepository {
+	return &mockCustomerRepository{customers: map[int64]*customer.Customer{
+		1: {ID: 1, Name: "Google Name", Avatar: "https://lh3.googleusercontent.com/a/1", PreferredLanguage: customer.LanguageJa},
+	}}
+}
+
+func (m *mockCustomerRepository) FindByID(id int64) (*customer.Customer, error) {
+	c, ok := m.customers[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *c
+	return &copy, nil
+}
+
+func (m *mockCustomerRepository) FindByIDForUpdate(id int64) (*customer.Customer, error) {
+	return m.FindByID(id)
+}
+
+func (m *mockCustomerRepository) FindByGoogleID(_ string) (*customer.Customer, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCustomerRepository) FindAllWithReviewCount() ([]customer.Customer, map[int64]int, error) {
+	return nil, nil, nil
+}
+
+func (m *mockCustomerRepository) FindSuspensionExpired(_ time.Time) ([]customer.Customer, error) {
+	return nil, nil
+}
+
+func (m *mockCustomerRepository) Create(c *customer.Customer) error {
+	m.customers[c.ID] = c
+	return nil
+}
+
+func (m *mockCustomerRepository) Update(c *customer.Customer) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	copy := *c
+	m.customers[c.ID] = &copy
+	return nil
+}
+
+func TestUpdateProfile(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc := NewProfileUsecase(repo, &mockReviewRepository{}, newMockFollowRepo(nil))
+
+	c, err := uc.UpdateProfile(1, UpdateProfileInput{
+		Name:              strPtr("  Hanako  "),
+		Bio:               strPtr("ビーガン歴3年"),
+		PreferredLanguage: strPtr("EN"),
+		DietaryPreference: strPtr("vegan"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Name != "Hanako" || c.Bio != "ビーガン歴3年" || c.PreferredLanguage != customer.LanguageEn || c.DietaryPreference != customer.DietVegan {
+		t.Errorf("unexpected profile: %+v", c)
+	}
+	if c.Avatar != "https://lh3.googleusercontent.com/a/1" {
+		t.Errorf("expected avatar to be unchanged, got %s", c.Avatar)
+	}
+	if !repo.customers[1].NameCustomized || repo.customers[1].AvatarCustomized {
+		t.Errorf("expected only the name to be marked as customized, got %+v", repo.customers[1])
+	}
+
+	// 省略した項目は変更しない
+	c, err = uc.UpdateProfile(1, UpdateProfileInput{DietaryPreference: strPtr("")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Name != "Hanako" || c.DietaryPreference != "" {
+		t.Errorf("expected only dietary preference to be cleared, got %+v", c)
+	}
+}
+
+func TestUpdateProfile_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input UpdateProfileInput
+		want  error
+	}{
+		{"empty name", UpdateProfileInput{Name: strPtr("  ")}, customer.ErrDisplayNameEmpty},
+		{"long name", UpdateProfileInput{Name: strPtr(strings.Repeat("名", customer.DisplayNameMaxLength+1))}, customer.ErrDisplayNameTooLong},
+		{"control character", UpdateProfileInput{Name: strPtr("Hana\nko")}, customer.ErrDisplayNameInvalid},
+		{"long bio", UpdateProfileInput{Bio: strPtr(strings.Repeat("a", customer.BioMaxLength+1))}, customer.ErrBioTooLong},
+		{"avatar scheme", UpdateProfileInput{Avatar: strPtr("javascript:alert(1)")}, customer.ErrAvatarURLInvalid},
+		{"language", UpdateProfileInput{PreferredLanguage: strPtr("fr")}, customer.ErrLanguageInvalid},
+		{"diet", UpdateProfileInput{DietaryPreference: strPtr("pescatarian")}, customer.ErrDietaryPreferenceValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockCustomerRepo()
+			uc := NewProfileUsecase(repo, &mockReviewRepository{}, newMockFollowRepo(nil))
+			// 有効な項目と一緒に送っても、1つでも不正なら何も保存しない
+			if tt.input.Bio == nil {
+				tt.input.Bio = strPtr("hello")
+			}
+			if _, err := uc.UpdateProfile(1, tt.input); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			if repo.customers[1].Bio != "" || repo.customers[1].Name != "Google Name" {
+				t.Errorf("expected no changes to be saved, got %+v", repo.customers[1])
+			}
+		})
+	}
+
+	if _, err := NewProfileUsecase(newMockCustomerRepo(), &mockReviewRepository{}, newMockFollowRepo(nil)).UpdateProfile(999, UpdateProfileInput{}); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+}
+
+func TestUpdateProfile_GoogleLoginKeepsEditedFields(t *testing.T) {
+	repo := newMockCustomerRepo()
+	uc := NewProfileUsecase(repo, &mockReviewRepository{}, newMockFollowRepo(nil))
+
+	if _, err := uc.UpdateProfile(1, UpdateProfileInput{Avatar: strPtr("https://cdn.example.com/me.png")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c := repo.customers[1]
+	c.SyncGoogleProfile("New Google Name", "https://lh3.googleusercontent.com/a/2")
+	if c.Name != "New Google Name" || c.Avatar != "https://cdn.example.com/me.png" {
+		t.Errorf("expected name to sync and edited avatar to be kept, got %+v", c)
+	}
+
+	// 空文字で Google のアバターに戻す
+	if _, err := uc.UpdateProfile(1, UpdateProfileInput{Avatar: strPtr("")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c = repo.customers[1]
+	c.SyncGoogleProfile("New Google Name", "https://lh3.googleusercontent.com/a/2")
+	if c.Avatar != "https://lh3.googleusercontent.com/a/2" {
+		t.Errorf("expected Google avatar after reset, got %s", c.Avatar)
+	}
+}
+
+func TestGetPublicProfile_Privacy(t *testing.T) {
+	repo := newMockCustomerRepo()
+	repo.customers[1].GoogleID = "google-1"
+	repo.customers[1].Email = "hanako@example.com"
+	reviews := &mockReviewRepository{reviews: []review.Review{
+		{ID: 1, ProductID: 1, CustomerID: 1, Rating: mustRating(5)},
+		{ID: 2, ProductID: 2, CustomerID: 1, Rating: mustRating(4)},
+		{ID: 3, ProductID: 1, CustomerID: 2, Rating: mustRating(3)},
+	}}
+	uc := NewProfileUsecase(repo, reviews, newMockFollowRepo(nil))
+
+	p, err := uc.GetPublicProfile(1, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Name != "Google Name" || p.ReviewCount != 2 {
+		t.Errorf("unexpected public profile: %+v", p)
+	}
+	body, _ := json.Marshal(p)
+	if strings.Contains(string(body), "hanako@example.com") || strings.Contains(string(body), "google-1") {
+		t.Errorf("expected no email or Google ID in public profile, got %s", body)
+	}
+	if got, err := uc.GetPublicReviews(1, 0); err != nil || len(got) != 2 {
+		t.Errorf("expected 2 public reviews, got %d, %v", len(got), err)
+	}
+
+	// 非公開にすると本人以外には見つからない
+	if _, err := uc.UpdateProfile(1, UpdateProfileInput{ProfileVisibility: strPtr("private")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.GetPublicProfile(1, 0); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for anonymous viewer, got %v", err)
+	}
+	if _, err := uc.GetPublicReviews(1, 2); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound for another customer, got %v", err)
+	}
+	if _, err := uc.GetPublicProfile(1, 1); err != nil {
+		t.Errorf("expected owner to see own profile, got %v", err)
+	}
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,180 @@
+package customerusecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/product"
+	"backend/domain/question"
+	"errors"
+	"time"
+)
+
+var ErrQuestionPermissionDenied = errors.New("you can only delete your own questions and answers")
+
+// QuestionUsecase - 商品Q&Aユースケース
+type QuestionUsecase struct {
+	productRepo  product.ProductRepository
+	questionRepo question.QuestionRepository
+	answerRepo   question.AnswerRepository
+	uow          event.UnitOfWork
+	now          func() time.Time
+}
+
+// NewQuestionUsecase - 商品Q&Aユースケースの生成
+func NewQuestionUsecase(productRepo product.ProductRepository, questionRepo question.QuestionRepository, answerRepo question.AnswerRepository, uow event.UnitOfWork) *QuestionUsecase {
+	return &QuestionUsecase{
+		productRepo:  productRepo,
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		uow:          uow,
+		now:          time.Now,
+	}
+}
+
+// GetProductQuestions - 商品への質問一覧（新しい順、回答数付き）
+func (u *QuestionUsecase) GetProductQuestions(productID int64) ([]question.Question, error) {
+	if _, err := u.productRepo.FindByID(productID); err != nil {
+		return nil, err
+	}
+	return u.questionRepo.FindByProductID(productID)
+}
+
+// GetQuestion - 質問と回答（採用された回答、賛成数の多い順）。viewerID が 0 なら未ログイン
+func (u *QuestionUsecase) GetQuestion(id, viewerID int64) (*question.Question, error) {
+	q, err := u.questionRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	answers, err := u.answerRepo.FindByQuestionID(id, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	q.Answers = q.RankAnswers(answers)
+	q.AnswerCount = len(answers)
+	return q, nil
+}
+
+// AskQuestion - 商品に質問を投稿
+func (u *QuestionUsecase) AskQuestion(productID, customerID int64, bodyValue string) (*question.Question, error) {
+	body, err := question.NewQuestionBody(bodyValue)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.productRepo.FindByID(productID); err != nil {
+		return nil, err
+	}
+
+	q := question.NewQuestion(productID, customerID, body)
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Questions().Create(q); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.QuestionCreated{QuestionID: q.ID, ProductID: productID, CustomerID: customerID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// DeleteQuestion - 自分の質問を削除（回答も削除される）
+func (u *QuestionUsecase) DeleteQuestion(id, customerID int64) error {
+	q, err := u.questionRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if !q.IsAskedBy(customerID) {
+		return ErrQuestionPermissionDenied
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Questions().Delete(id); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.QuestionDeleted{QuestionID: q.ID, ProductID: q.ProductID})
+	})
+}
+
+// AnswerQuestion - 質問に回答を投稿
+func (u *QuestionUsecase) AnswerQuestion(questionID, customerID int64, bodyValue string) (*question.Answer, error) {
+	body, err := question.NewAnswerBody(bodyValue)
+	if err != nil {
+		return nil, err
+	}
+	q, err := u.questionRepo.FindByID(questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	a := question.NewCustomerAnswer(q.ID, customerID, body)
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Answers().Create(a); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.AnswerCreated{AnswerID: a.ID, QuestionID: q.ID, ProductID: q.ProductID, CustomerID: a.CustomerID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// DeleteAnswer - 自分の回答を削除（採用されていた場合は採用も外れる）
+func (u *QuestionUsecase) DeleteAnswer(id, customerID int64) error {
+	a, err := u.answerRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if !a.IsWrittenBy(customerID) {
+		return ErrQuestionPermissionDenied
+	}
+	q, err := u.questionRepo.FindByID(a.QuestionID)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Answers().Delete(id); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.AnswerDeleted{AnswerID: a.ID, QuestionID: q.ID, ProductID: q.ProductID})
+	})
+}
+
+// AcceptAnswer - 質問者が回答を採用（answerID が nil なら採用を取り消す）
+func (u *QuestionUsecase) AcceptAnswer(questionID, customerID int64, answerID *int64) (*question.Question, error) {
+	q, err := u.questionRepo.FindByID(questionID)
+	if err != nil {
+		return nil, err
+	}
+	var a *question.Answer
+	if answerID != nil {
+		if a, err = u.answerRepo.FindByID(*answerID); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.AcceptAnswer(customerID, a); err != nil {
+		return nil, err
+	}
+	if err := u.questionRepo.SetAcceptedAnswer(q.ID, q.AcceptedAnswerID); err != nil {
+		return nil, err
+	}
+	return u.GetQuestion(q.ID, customerID)
+}
+
+// UpvoteAnswer - 回答に賛成（賛成済みなら何もしない、自分の回答には賛成できない）
+func (u *QuestionUsecase) UpvoteAnswer(answerID, customerID int64) error {
+	a, err := u.answerRepo.FindByID(answerID)
+	if err != nil {
+		return err
+	}
+	upvote, err := question.NewUpvote(a, customerID, u.now())
+	if err != nil {
+		return err
+	}
+	return u.answerRepo.Upvote(upvote)
+}
+
+// RemoveUpvote - 回答への賛成を取り消す（賛成していなければ何もしない）
+func (u *QuestionUsecase) RemoveUpvote(answerID, customerID int64) error {
+	return u.answerRepo.RemoveUpvote(answerID, customerID)
+}
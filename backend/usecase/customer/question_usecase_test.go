@@ -0,0 +1,238 @@
+package customerusecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/product"
+	"backend/domain/question"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockQuestionRepository - テスト用モックリポジトリ
+type mockQuestionRepository struct {
+	questions map[int64]*question.Question
+	nextID    int64
+	findErr   error
+}
+
+func (m *mockQuestionRepository) FindByProductID(productID int64) ([]question.Question, error) {
+	var result []question.Question
+	for _, q := range m.questions {
+		if q.ProductID == productID {
+			result = append(result, *q)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockQuestionRepository) FindByID(id int64) (*question.Question, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	if q, ok := m.questions[id]; ok {
+		copied := *q
+		return &copied, nil
+	}
+	return nil, question.ErrQuestionNotFound
+}
+
+func (m *mockQuestionRepository) Create(q *question.Question) error {
+	m.nextID++
+	q.ID = m.nextID
+	copied := *q
+	m.questions[q.ID] = &copied
+	return nil
+}
+
+func (m *mockQuestionRepository) SetAcceptedAnswer(questionID int64, answerID *int64) error {
+	m.questions[questionID].AcceptedAnswerID = answerID
+	return nil
+}
+
+func (m *mockQuestionRepository) Delete(id int64) error {
+	delete(m.questions, id)
+	return nil
+}
+
+// mockAnswerRepository - テスト用モックリポジトリ
+type mockAnswerRepository struct {
+	answers []question.Answer
+	upvotes map[[2]int64]bool
+	nextID  int64
+}
+
+func (m *mockAnswerRepository) FindByQuestionID(questionID, viewerID int64) ([]question.Answer, error) {
+	var result []question.Answer
+	for _, a := range m.answers {
+		if a.QuestionID != questionID {
+			continue
+		}
+		for key := range m.upvotes {
+			if key[0] == a.ID {
+				a.UpvoteCount++
+			}
+		}
+		a.HasUpvoted = m.upvotes[[2]int64{a.ID, viewerID}]
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+func (m *mockAnswerRepository) FindByID(id int64) (*question.Answer, error) {
+	for _, a := range m.answers {
+		if a.ID == id {
+			return &a, nil
+		}
+	}
+	return nil, question.ErrAnswerNotFound
+}
+
+func (m *mockAnswerRepository) Create(a *question.Answer) error {
+	m.nextID++
+	a.ID = m.nextID
+	m.answers = append(m.answers, *a)
+	return nil
+}
+
+func (m *mockAnswerRepository) Delete(id int64) error {
+	for i, a := range m.answers {
+		if a.ID == id {
+			m.answers = append(m.answers[:i], m.answers[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockAnswerRepository) Upvote(u *question.Upvote) error {
+	m.upvotes[[2]int64{u.AnswerID, u.CustomerID}] = true
+	return nil
+}
+
+func (m *mockAnswerRepository) RemoveUpvote(answerID, customerID int64) error {
+	delete(m.upvotes, [2]int64{answerID, customerID})
+	return nil
+}
+
+func newTestQuestionUsecase() (*QuestionUsecase, *mockQuestionRepository, *mockUnitOfWork) {
+	products := &mockProductRepository{findByIDFn: func(id int64) (*product.Product, error) {
+		if id != 100 {
+			return nil, product.ErrProductNotFound
+		}
+		return &product.Product{ID: id}, nil
+	}}
+	questions := &mockQuestionRepository{questions: make(map[int64]*question.Question)}
+	answers := &mockAnswerRepository{upvotes: make(map[[2]int64]bool)}
+	uow := &mockUnitOfWork{questions: questions, answers: answers}
+	uc := NewQuestionUsecase(products, questions, answers, uow)
+	uc.now = func() time.Time { return time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC) }
+	return uc, questions, uow
+}
+
+func TestQuestionAndAnswers(t *testing.T) {
+	uc, _, uow := newTestQuestionUsecase()
+
+	q, err := uc.AskQuestion(100, 1, " はちみつは入っていますか？ ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Body != "はちみつは入っていますか？" {
+		t.Errorf("expected trimmed body, got %q", q.Body)
+	}
+	if _, err := uc.AskQuestion(999, 1, "質問です"); !errors.Is(err, product.ErrProductNotFound) {
+		t.Errorf("expected ErrProductNotFound, got %v", err)
+	}
+	if _, err := uc.AskQuestion(100, 1, "  "); !errors.Is(err, question.ErrBodyEmpty) {
+		t.Errorf("expected ErrBodyEmpty, got %v", err)
+	}
+
+	first, _ := uc.AnswerQuestion(q.ID, 2, "入っていません")
+	second, _ := uc.AnswerQuestion(q.ID, 3, "原材料表示を見る限り入っていません")
+	third, _ := uc.AnswerQuestion(q.ID, 4, "わかりません")
+
+	if err := uc.UpvoteAnswer(second.ID, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.UpvoteAnswer(second.ID, 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.UpvoteAnswer(third.ID, 4); !errors.Is(err, question.ErrCannotUpvoteOwnAnswer) {
+		t.Errorf("expected ErrCannotUpvoteOwnAnswer, got %v", err)
+	}
+
+	// 賛成数の多い順、同数なら古い順
+	detail, err := uc.GetQuestion(q.ID, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if detail.AnswerCount != 3 || detail.Answers[0].ID != second.ID || detail.Answers[0].UpvoteCount != 2 || !detail.Answers[0].HasUpvoted || detail.Answers[1].ID != first.ID {
+		t.Errorf("unexpected answer order: %+v", detail.Answers)
+	}
+
+	// 採用された回答は先頭
+	if _, err := uc.AcceptAnswer(q.ID, 2, &third.ID); !errors.Is(err, question.ErrNotAsker) {
+		t.Errorf("expected ErrNotAsker, got %v", err)
+	}
+	detail, err = uc.AcceptAnswer(q.ID, 1, &third.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if detail.AcceptedAnswerID == nil || detail.Answers[0].ID != third.ID || !detail.Answers[0].IsAccepted {
+		t.Errorf("expected accepted answer first, got %+v", detail.Answers)
+	}
+	if detail, _ = uc.AcceptAnswer(q.ID, 1, nil); detail.AcceptedAnswerID != nil || detail.Answers[0].IsAccepted {
+		t.Errorf("expected acceptance to be cleared, got %+v", detail)
+	}
+
+	other, _ := uc.AskQuestion(100, 2, "開封後の保存方法は？")
+	if _, err := uc.AcceptAnswer(other.ID, 2, &first.ID); !errors.Is(err, question.ErrAnswerMismatch) {
+		t.Errorf("expected ErrAnswerMismatch, got %v", err)
+	}
+
+	if created, ok := uow.events[0].(event.QuestionCreated); !ok || created.ProductID != 100 {
+		t.Errorf("unexpected event: %+v", uow.events[0])
+	}
+	if answered, ok := uow.events[1].(event.AnswerCreated); !ok || answered.IsOfficial || answered.ProductID != 100 {
+		t.Errorf("unexpected event: %+v", uow.events[1])
+	}
+}
+
+func TestDeleteQuestion_OwnOnly(t *testing.T) {
+	uc, _, _ := newTestQuestionUsecase()
+	q, _ := uc.AskQuestion(100, 1, "はちみつは入っていますか？")
+	a, _ := uc.AnswerQuestion(q.ID, 2, "入っていません")
+
+	if err := uc.DeleteAnswer(a.ID, 1); !errors.Is(err, ErrQuestionPermissionDenied) {
+		t.Errorf("expected ErrQuestionPermissionDenied, got %v", err)
+	}
+	if err := uc.DeleteQuestion(q.ID, 2); !errors.Is(err, ErrQuestionPermissionDenied) {
+		t.Errorf("expected ErrQuestionPermissionDenied, got %v", err)
+	}
+	if err := uc.DeleteAnswer(a.ID, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.DeleteQuestion(q.ID, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.GetQuestion(q.ID, 0); !errors.Is(err, question.ErrQuestionNotFound) {
+		t.Errorf("expected ErrQuestionNotFound, got %v", err)
+	}
+}
+
+func TestQuestionLookupErrorIsReturned(t *testing.T) {
+	uc, questions, _ := newTestQuestionUsecase()
+	q, _ := uc.AskQuestion(100, 1, "はちみつは入っていますか？")
+	dbErr := errors.New("connection refused")
+	questions.findErr = dbErr
+
+	if _, err := uc.GetQuestion(q.ID, 0); !errors.Is(err, dbErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+	if _, err := uc.AnswerQuestion(q.ID, 2, "入っていません"); !errors.Is(err, dbErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+	if _, err := uc.AcceptAnswer(q.ID, 1, nil); !errors.Is(err, dbErr) {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+}
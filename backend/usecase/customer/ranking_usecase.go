@@ -0,0 +1,80 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/ranking"
+	"time"
+)
+
+// RankingUsecase - 利用者向けランキングユースケース
+type RankingUsecase struct {
+	rankingRepo ranking.RankingRepository
+	productRepo product.ProductRepository
+}
+
+// RankedProduct - ランキング内の商品
+type RankedProduct struct {
+	Rank    int              `json:"rank"`
+	Score   float64          `json:"score"`
+	Product *product.Product `json:"product"`
+}
+
+// Ranking - ランキング
+type Ranking struct {
+	Type       ranking.Type    `json:"type"`
+	CategoryID int64           `json:"categoryId"`
+	ComputedAt *time.Time      `json:"computedAt"`
+	Products   []RankedProduct `json:"products"`
+}
+
+// NewRankingUsecase - 利用者向けランキングユースケースの生成
+func NewRankingUsecase(rankingRepo ranking.RankingRepository, productRepo product.ProductRepository) *RankingUsecase {
+	return &RankingUsecase{
+		rankingRepo: rankingRepo,
+		productRepo: productRepo,
+	}
+}
+
+// GetRanking - 集計済みランキングを取得（categoryID が 0 なら全カテゴリ）
+func (u *RankingUsecase) GetRanking(typeValue string, categoryID int64) (*Ranking, error) {
+	t, err := ranking.NewType(typeValue)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := u.rankingRepo.Find(t, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Ranking{Type: t, CategoryID: categoryID, Products: make([]RankedProduct, 0, len(entries))}
+	if len(entries) == 0 {
+		return result, nil
+	}
+	computedAt := entries[0].ComputedAt
+	result.ComputedAt = &computedAt
+
+	// ランキング内の商品を1回のクエリで読み込む
+	ids := make([]int64, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ProductID
+	}
+	products, err := u.productRepo.FindAll(product.ProductFilter{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*product.Product, len(products))
+	for i := range products {
+		byID[products[i].ID] = &products[i]
+	}
+
+	for _, e := range entries {
+		// 集計後に削除された商品は詰めて表示
+		p, ok := byID[e.ProductID]
+		if !ok {
+			continue
+		}
+		result.Products = append(result.Products, RankedProduct{Rank: len(result.Products) + 1, Score: e.Score, Product: p})
+	}
+	return result, nil
+}
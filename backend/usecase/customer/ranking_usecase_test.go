@@ -0,0 +1,102 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/ranking"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockRankingRepository - テスト用モックリポジトリ
+type mockRankingRepository struct {
+	entries []ranking.Entry
+}
+
+func (m *mockRankingRepository) FindActivity(since time.Time) ([]ranking.Activity, error) {
+	return nil, nil
+}
+func (m *mockRankingRepository) Replace(t ranking.Type, entries []ranking.Entry) error {
+	return nil
+}
+func (m *mockRankingRepository) Find(t ranking.Type, categoryID int64) ([]ranking.Entry, error) {
+	var result []ranking.Entry
+	for _, e := range m.entries {
+		if e.Type == t && e.CategoryID == categoryID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func TestGetRanking(t *testing.T) {
+	computedAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	repo := &mockRankingRepository{entries: []ranking.Entry{
+		{Type: ranking.TypeTrending, CategoryID: 0, Rank: 1, ProductID: 2, Score: 6, ComputedAt: computedAt},
+		{Type: ranking.TypeTrending, CategoryID: 0, Rank: 2, ProductID: 99, Score: 5, ComputedAt: computedAt},
+		{Type: ranking.TypeTrending, CategoryID: 0, Rank: 3, ProductID: 1, Score: 1, ComputedAt: computedAt},
+		{Type: ranking.TypeTop, CategoryID: 7, Rank: 1, ProductID: 3, Score: 4.4, ComputedAt: computedAt},
+	}}
+	var queries int
+	productRepo := &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			queries++
+			var result []product.Product
+			for _, id := range filter.IDs {
+				if id != 99 {
+					result = append(result, product.Product{ID: id})
+				}
+			}
+			return result, nil
+		},
+	}
+	uc := NewRankingUsecase(repo, productRepo)
+
+	// 種類未指定はトレンド。削除済み商品は除いて順位を詰める
+	result, err := uc.GetRanking("", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Type != ranking.TypeTrending || len(result.Products) != 2 {
+		t.Fatalf("unexpected ranking: %+v", result)
+	}
+	if result.Products[1].Product.ID != 1 || result.Products[1].Rank != 2 {
+		t.Errorf("expected product 1 at rank 2, got %+v", result.Products[1])
+	}
+	if result.ComputedAt == nil || !result.ComputedAt.Equal(computedAt) {
+		t.Errorf("expected computedAt %v, got %v", computedAt, result.ComputedAt)
+	}
+	if queries != 1 {
+		t.Errorf("expected products to be loaded in one query, got %d", queries)
+	}
+
+	result, err = uc.GetRanking("top", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Products) != 1 || result.Products[0].Product.ID != 3 {
+		t.Errorf("unexpected top ranking: %+v", result.Products)
+	}
+}
+
+func TestGetRanking_ProductLoadError(t *testing.T) {
+	repo := &mockRankingRepository{entries: []ranking.Entry{{Type: ranking.TypeTrending, Rank: 1, ProductID: 1, Score: 1}}}
+	productRepo := &mockProductRepository{
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			return nil, errors.New("connection reset")
+		},
+	}
+	uc := NewRankingUsecase(repo, productRepo)
+
+	if _, err := uc.GetRanking("trending", 0); err == nil {
+		t.Error("expected the product load error to be returned")
+	}
+}
+
+func TestGetRanking_InvalidType(t *testing.T) {
+	uc := NewRankingUsecase(&mockRankingRepository{}, &mockProductRepository{})
+
+	if _, err := uc.GetRanking("weekly", 0); !errors.Is(err, ranking.ErrTypeInvalid) {
+		t.Errorf("expected ErrTypeInvalid, got %v", err)
+	}
+}
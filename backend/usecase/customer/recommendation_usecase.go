@@ -0,0 +1,118 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/recommendation"
+	"errors"
+)
+
+// RecommendationLimit - 1回に返す推薦商品数
+const RecommendationLimit = 10
+
+var ErrRecommendationProductNotFound = errors.New("product not found")
+
+// RecommendationUsecase - 利用者向け推薦ユースケース
+type RecommendationUsecase struct {
+	recommendationRepo recommendation.RecommendationRepository
+	productRepo        product.ProductRepository
+}
+
+// RecommendedProduct - 推薦商品と推薦理由
+type RecommendedProduct struct {
+	Product *product.Product      `json:"product"`
+	Reason  recommendation.Reason `json:"reason"`
+}
+
+// NewRecommendationUsecase - 利用者向け推薦ユースケースの生成
+func NewRecommendationUsecase(recommendationRepo recommendation.RecommendationRepository, productRepo product.ProductRepository) *RecommendationUsecase {
+	return &RecommendationUsecase{
+		recommendationRepo: recommendationRepo,
+		productRepo:        productRepo,
+	}
+}
+
+// GetSimilarProducts - 類似商品を取得（未計算の商品は同カテゴリの高評価商品）
+func (u *RecommendationUsecase) GetSimilarProducts(productID int64) ([]RecommendedProduct, error) {
+	p, err := u.productRepo.FindByID(productID)
+	if errors.Is(err, product.ErrProductNotFound) {
+		return nil, ErrRecommendationProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	similar, err := u.recommendationRepo.FindSimilar(productID, RecommendationLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(similar) > 0 {
+		ids := make([]int64, len(similar))
+		reasons := make([]recommendation.Reason, len(similar))
+		for i, s := range similar {
+			ids[i], reasons[i] = s.SimilarProductID, s.Reason
+		}
+		return u.loadProducts(ids, reasons)
+	}
+
+	filter := product.ProductFilter{Sort: product.ProductSortRating}
+	if len(p.Categories) > 0 {
+		filter.CategoryID = p.Categories[0].ID
+	}
+	return u.popular(filter, map[int64]bool{productID: true})
+}
+
+// GetRecommendations - 顧客向けの推薦商品を取得（シグナルがない顧客は全体の高評価商品）
+func (u *RecommendationUsecase) GetRecommendations(customerID int64) ([]RecommendedProduct, error) {
+	recommendations, err := u.recommendationRepo.FindByCustomerID(customerID, RecommendationLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(recommendations) > 0 {
+		ids := make([]int64, len(recommendations))
+		reasons := make([]recommendation.Reason, len(recommendations))
+		for i, r := range recommendations {
+			ids[i], reasons[i] = r.ProductID, r.Reason
+		}
+		return u.loadProducts(ids, reasons)
+	}
+	return u.popular(product.ProductFilter{Sort: product.ProductSortRating}, nil)
+}
+
+// loadProducts - 推薦順の商品を1回のクエリで読み込む（再計算後に削除された商品は除く）
+func (u *RecommendationUsecase) loadProducts(ids []int64, reasons []recommendation.Reason) ([]RecommendedProduct, error) {
+	products, err := u.productRepo.FindAll(product.ProductFilter{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*product.Product, len(products))
+	for i := range products {
+		byID[products[i].ID] = &products[i]
+	}
+
+	result := make([]RecommendedProduct, 0, len(ids))
+	for i, id := range ids {
+		if p, ok := byID[id]; ok {
+			result = append(result, RecommendedProduct{Product: p, Reason: reasons[i]})
+		}
+	}
+	return result, nil
+}
+
+// popular - 条件に合う高評価商品を推薦として返す
+func (u *RecommendationUsecase) popular(filter product.ProductFilter, exclude map[int64]bool) ([]RecommendedProduct, error) {
+	products, err := u.productRepo.FindAll(filter)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]RecommendedProduct, 0, RecommendationLimit)
+	for i := range products {
+		if exclude[products[i].ID] {
+			continue
+		}
+		result = append(result, RecommendedProduct{Product: &products[i], Reason: recommendation.ReasonPopular})
+		if len(result) == RecommendationLimit {
+			break
+		}
+	}
+	return result, nil
+}
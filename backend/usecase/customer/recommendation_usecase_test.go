@@ -0,0 +1,148 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/recommendation"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockRecommendationRepository - テスト用モックリポジトリ
+type mockRecommendationRepository struct {
+	similar         map[int64][]recommendation.SimilarProduct
+	recommendations map[int64][]recommendation.Recommendation
+}
+
+func (m *mockRecommendationRepository) FindInteractions() ([]recommendation.Interaction, error) {
+	return nil, nil
+}
+func (m *mockRecommendationRepository) ReplaceAll(similar []recommendation.SimilarProduct, recommendations []recommendation.Recommendation) error {
+	return nil
+}
+func (m *mockRecommendationRepository) FindSimilar(productID int64, limit int) ([]recommendation.SimilarProduct, error) {
+	return m.similar[productID], nil
+}
+func (m *mockRecommendationRepository) FindByCustomerID(customerID int64, limit int) ([]recommendation.Recommendation, error) {
+	return m.recommendations[customerID], nil
+}
+
+// newRecommendationTestProducts - 推薦テスト用の商品リポジトリ（IDs 指定の FindAll はIDの降順で返す）
+func newRecommendationTestProducts(lastFilter *product.ProductFilter) *mockProductRepository {
+	catalog := map[int64]*product.Product{
+		1: {ID: 1, Categories: []product.Category{{ID: 7}}},
+		2: {ID: 2, Categories: []product.Category{{ID: 7}}},
+		3: {ID: 3, Categories: []product.Category{{ID: 7}}},
+	}
+	return &mockProductRepository{
+		findByIDFn: func(id int64) (*product.Product, error) {
+			if id == 500 {
+				return nil, errors.New("connection reset")
+			}
+			p, ok := catalog[id]
+			if !ok {
+				return nil, product.ErrProductNotFound
+			}
+			return p, nil
+		},
+		findAllFn: func(filter product.ProductFilter) ([]product.Product, error) {
+			*lastFilter = filter
+			if len(filter.IDs) > 0 {
+				var result []product.Product
+				for id := int64(3); id >= 1; id-- {
+					for _, want := range filter.IDs {
+						if want == id {
+							result = append(result, *catalog[id])
+						}
+					}
+				}
+				return result, nil
+			}
+			return []product.Product{*catalog[3], *catalog[1], *catalog[2]}, nil
+		},
+	}
+}
+
+func TestGetSimilarProducts_FromComputedTable(t *testing.T) {
+	var filter product.ProductFilter
+	repo := &mockRecommendationRepository{similar: map[int64][]recommendation.SimilarProduct{
+		1: {
+			{ProductID: 1, SimilarProductID: 2, Rank: 1, Reason: recommendation.ReasonSimilar},
+			{ProductID: 1, SimilarProductID: 99, Rank: 2, Reason: recommendation.ReasonSimilar}, // 再計算後に削除された商品
+			{ProductID: 1, SimilarProductID: 3, Rank: 3, Reason: recommendation.ReasonPopular},
+		},
+	}}
+	uc := NewRecommendationUsecase(repo, newRecommendationTestProducts(&filter))
+
+	result, err := uc.GetSimilarProducts(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 || result[0].Product.ID != 2 || result[1].Product.ID != 3 {
+		t.Errorf("expected products 2 and 3 in rank order, got %+v", result)
+	}
+	if len(filter.IDs) != 3 {
+		t.Errorf("expected recommended products to be loaded in one query, got filter %+v", filter)
+	}
+}
+
+func TestGetSimilarProducts_FallsBackToCategory(t *testing.T) {
+	var filter product.ProductFilter
+	uc := NewRecommendationUsecase(&mockRecommendationRepository{}, newRecommendationTestProducts(&filter))
+
+	result, err := uc.GetSimilarProducts(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filter.CategoryID != 7 || filter.Sort != product.ProductSortRating {
+		t.Errorf("expected top-rated products in category 7, got %+v", filter)
+	}
+	for _, r := range result {
+		if r.Product.ID == 1 {
+			t.Error("expected the product itself to be excluded")
+		}
+		if r.Reason != recommendation.ReasonPopular {
+			t.Errorf("expected popular reason, got %s", r.Reason)
+		}
+	}
+	if len(result) != 2 {
+		t.Errorf("expected 2 products, got %d", len(result))
+	}
+}
+
+func TestGetSimilarProducts_NotFound(t *testing.T) {
+	var filter product.ProductFilter
+	uc := NewRecommendationUsecase(&mockRecommendationRepository{}, newRecommendationTestProducts(&filter))
+
+	if _, err := uc.GetSimilarProducts(404); !errors.Is(err, ErrRecommendationProductNotFound) {
+		t.Errorf("expected ErrRecommendationProductNotFound, got %v", err)
+	}
+	if _, err := uc.GetSimilarProducts(500); err == nil || errors.Is(err, ErrRecommendationProductNotFound) {
+		t.Errorf("expected the lookup error, got %v", err)
+	}
+}
+
+func TestGetRecommendations(t *testing.T) {
+	var filter product.ProductFilter
+	repo := &mockRecommendationRepository{recommendations: map[int64][]recommendation.Recommendation{
+		10: {{CustomerID: 10, ProductID: 3, Rank: 1, Reason: recommendation.ReasonSimilar, ComputedAt: time.Now()}},
+	}}
+	uc := NewRecommendationUsecase(repo, newRecommendationTestProducts(&filter))
+
+	result, err := uc.GetRecommendations(10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 || result[0].Product.ID != 3 {
+		t.Errorf("expected stored recommendation, got %+v", result)
+	}
+
+	// シグナルのない顧客は全体の高評価商品
+	result, err = uc.GetRecommendations(11)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 3 || filter.CategoryID != 0 || filter.Sort != product.ProductSortRating {
+		t.Errorf("expected overall top-rated fallback, got %d products with filter %+v", len(result), filter)
+	}
+}
@@ -0,0 +1,102 @@
+package customerusecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/review"
+	"errors"
+)
+
+var ErrReplyPermissionDenied = errors.New("you can only delete your own replies")
+
+// ReplyUsecase - レビューへの返信ユースケース
+type ReplyUsecase struct {
+	reviewRepo review.ReviewRepository
+	replyRepo  review.ReplyRepository
+	uow        event.UnitOfWork
+}
+
+// NewReplyUsecase - レビューへの返信ユースケースの生成
+func NewReplyUsecase(reviewRepo review.ReviewRepository, replyRepo review.ReplyRepository, uow event.UnitOfWork) *ReplyUsecase {
+	return &ReplyUsecase{
+		reviewRepo: reviewRepo,
+		replyRepo:  replyRepo,
+		uow:        uow,
+	}
+}
+
+// GetReplies - レビューへの返信をスレッド（入れ子）で取得
+func (u *ReplyUsecase) GetReplies(reviewID int64) ([]review.Reply, error) {
+	if _, err := u.reviewRepo.FindByID(reviewID); err != nil {
+		return nil, err
+	}
+	replies, err := u.replyRepo.FindByReviewID(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	return review.BuildThread(replies), nil
+}
+
+// CreateReply - レビューまたは返信への返信を投稿（parentID が nil ならレビューへの直接の返信）
+func (u *ReplyUsecase) CreateReply(reviewID, customerID int64, parentID *int64, bodyValue string) (*review.Reply, error) {
+	body, err := review.NewReplyBody(bodyValue)
+	if err != nil {
+		return nil, err
+	}
+	r, parent, err := u.findTarget(reviewID, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := review.NewCustomerReply(r, parent, customerID, body)
+	if err != nil {
+		return nil, err
+	}
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Replies().Create(reply); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReplyCreated{ReplyID: reply.ID, ReviewID: r.ID, ProductID: r.ProductID, CustomerID: reply.CustomerID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// DeleteReply - 自分の返信を削除（その返信への返信も削除される）
+func (u *ReplyUsecase) DeleteReply(id, customerID int64) error {
+	reply, err := u.replyRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if !reply.IsWrittenBy(customerID) {
+		return ErrReplyPermissionDenied
+	}
+	r, err := u.reviewRepo.FindByID(reply.ReviewID)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Replies().Delete(id); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReplyDeleted{ReplyID: id, ReviewID: r.ID, ProductID: r.ProductID})
+	})
+}
+
+// findTarget - 返信先のレビューと親の返信を取得
+func (u *ReplyUsecase) findTarget(reviewID int64, parentID *int64) (*review.Review, *review.Reply, error) {
+	r, err := u.reviewRepo.FindByID(reviewID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parentID == nil {
+		return r, nil, nil
+	}
+	parent, err := u.replyRepo.FindByID(*parentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, parent, nil
+}
@@ -0,0 +1,134 @@
+package customerusecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/review"
+	"errors"
+	"testing"
+)
+
+// mockReplyRepository - テスト用モックリポジトリ（Delete は子の返信も削除）
+type mockReplyRepository struct {
+	replies []review.Reply
+	nextID  int64
+}
+
+func (m *mockReplyRepository) FindByReviewID(reviewID int64) ([]review.Reply, error) {
+	var result []review.Reply
+	for _, r := range m.replies {
+		if r.ReviewID == reviewID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockReplyRepository) FindByID(id int64) (*review.Reply, error) {
+	for _, r := range m.replies {
+		if r.ID == id {
+			return &r, nil
+		}
+	}
+	return nil, review.ErrReplyNotFound
+}
+
+func (m *mockReplyRepository) Create(reply *review.Reply) error {
+	m.nextID++
+	reply.ID = m.nextID
+	m.replies = append(m.replies, *reply)
+	return nil
+}
+
+func (m *mockReplyRepository) Delete(id int64) error {
+	removed := map[int64]bool{id: true}
+	kept := m.replies[:0]
+	for _, r := range m.replies {
+		if removed[r.ID] || (r.ParentID != nil && removed[*r.ParentID]) {
+			removed[r.ID] = true
+			continue
+		}
+		kept = append(kept, r)
+	}
+	m.replies = kept
+	return nil
+}
+
+func newTestReplyUsecase() (*ReplyUsecase, *mockReplyRepository, *mockUnitOfWork) {
+	reviews := &mockReviewRepository{reviews: []review.Review{
+		{ID: 1, ProductID: 100, CustomerID: 1, Rating: mustRating(5)},
+		{ID: 2, ProductID: 100, CustomerID: 2, Rating: mustRating(4)},
+	}}
+	replies := &mockReplyRepository{}
+	uow := &mockUnitOfWork{reviews: reviews, replies: replies}
+	return NewReplyUsecase(reviews, replies, uow), replies, uow
+}
+
+func TestCreateReply_Thread(t *testing.T) {
+	uc, _, uow := newTestReplyUsecase()
+
+	first, err := uc.CreateReply(1, 2, nil, "  どこで買えますか？ ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Depth != 1 || first.ParentID != nil || first.Body != "どこで買えますか？" || first.IsOfficial {
+		t.Errorf("unexpected reply: %+v", first)
+	}
+	second, err := uc.CreateReply(1, 1, &first.ID, "近所のスーパーです")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	third, err := uc.CreateReply(1, 2, &second.ID, "ありがとうございます")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if third.Depth != review.ReplyMaxDepth {
+		t.Fatalf("expected depth %d, got %d", review.ReplyMaxDepth, third.Depth)
+	}
+	if _, err := uc.CreateReply(1, 1, &third.ID, "どういたしまして"); !errors.Is(err, review.ErrReplyTooDeep) {
+		t.Errorf("expected ErrReplyTooDeep, got %v", err)
+	}
+	if _, err := uc.CreateReply(2, 1, &first.ID, "別のレビューの返信"); !errors.Is(err, review.ErrReplyParentMismatch) {
+		t.Errorf("expected ErrReplyParentMismatch, got %v", err)
+	}
+	if _, err := uc.CreateReply(1, 1, nil, "   "); !errors.Is(err, review.ErrReplyBodyEmpty) {
+		t.Errorf("expected ErrReplyBodyEmpty, got %v", err)
+	}
+	if _, err := uc.CreateReply(999, 1, nil, "こんにちは"); !errors.Is(err, review.ErrReviewNotFound) {
+		t.Errorf("expected review.ErrReviewNotFound, got %v", err)
+	}
+	if len(uow.events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(uow.events))
+	}
+	if created, ok := uow.events[0].(event.ReplyCreated); !ok || created.ProductID != 100 || created.IsOfficial {
+		t.Errorf("unexpected event: %+v", uow.events[0])
+	}
+
+	thread, err := uc.GetReplies(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(thread) != 1 || len(thread[0].Replies) != 1 || len(thread[0].Replies[0].Replies) != 1 || thread[0].Replies[0].Replies[0].ID != third.ID {
+		t.Errorf("expected nested thread, got %+v", thread)
+	}
+}
+
+func TestDeleteReply_OwnOnly(t *testing.T) {
+	uc, replies, _ := newTestReplyUsecase()
+	first, _ := uc.CreateReply(1, 2, nil, "どこで買えますか？")
+	if _, err := uc.CreateReply(1, 1, &first.ID, "近所のスーパーです"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := uc.DeleteReply(first.ID, 1); !errors.Is(err, ErrReplyPermissionDenied) {
+		t.Errorf("expected ErrReplyPermissionDenied, got %v", err)
+	}
+	if err := uc.DeleteReply(first.ID, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(replies.replies) != 0 {
+		t.Errorf("expected child replies to be deleted, got %+v", replies.replies)
+	}
+	if err := uc.DeleteReply(first.ID, 2); !errors.Is(err, review.ErrReplyNotFound) {
+		t.Errorf("expected review.ErrReplyNotFound, got %v", err)
+	}
+}
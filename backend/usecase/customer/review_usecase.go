@@ -1,22 +1,22 @@
 package customerusecase
 
 import (
-	"backend/domain/product"
+	"backend/domain/event"
 	"backend/domain/review"
 	"errors"
 )
 
 // ReviewUsecase - レビューユースケース
 type ReviewUsecase struct {
-	reviewRepo  review.ReviewRepository
-	productRepo product.ProductRepository
+	reviewRepo review.ReviewRepository
+	uow        event.UnitOfWork
 }
 
 // NewReviewUsecase - レビューユースケースの生成
-func NewReviewUsecase(reviewRepo review.ReviewRepository, productRepo product.ProductRepository) *ReviewUsecase {
+func NewReviewUsecase(reviewRepo review.ReviewRepository, uow event.UnitOfWork) *ReviewUsecase {
 	return &ReviewUsecase{
-		reviewRepo:  reviewRepo,
-		productRepo: productRepo,
+		reviewRepo: reviewRepo,
+		uow:        uow,
 	}
 }
 
@@ -25,11 +25,6 @@ func (u *ReviewUsecase) GetProductReviews(productID int64) ([]review.Review, err
 	return u.reviewRepo.FindByProductID(productID)
 }
 
-// GetCustomerReviews - カスタマーのレビュー一覧取得
-func (u *ReviewUsecase) GetCustomerReviews(customerID int64) ([]review.Review, error) {
-	return u.reviewRepo.FindByCustomerID(customerID)
-}
-
 // CreateReview - レビュー作成
 func (u *ReviewUsecase) CreateReview(productID, customerID int64, ratingValue int, commentValue string) (*review.Review, error) {
 	// Value Object作成（バリデーション）
@@ -52,15 +47,19 @@ func (u *ReviewUsecase) CreateReview(productID, customerID int64, ratingValue in
 	// Entity作成
 	r := review.NewReview(productID, customerID, rating, comment)
 
-	if err := u.reviewRepo.Create(r); err != nil {
-		return nil, err
-	}
-
-	// 商品の評価を更新
-	if err := u.updateProductRating(productID); err != nil {
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Reviews().Create(r); err != nil {
+			return err
+		}
+		// 商品の評価を更新
+		if err := updateProductRating(tx, productID); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReviewCreated{ReviewID: r.ID, ProductID: productID, CustomerID: customerID, Rating: r.Rating.Int()})
+	})
+	if err != nil {
 		return nil, err
 	}
-
 	return r, nil
 }
 
@@ -76,13 +75,16 @@ func (u *ReviewUsecase) DeleteReview(id, customerID int64, isAdmin bool) error {
 		return errors.New("permission denied")
 	}
 
-	productID := r.ProductID
-	if err := u.reviewRepo.Delete(id); err != nil {
-		return err
-	}
-
-	// 商品の評価を更新
-	return u.updateProductRating(productID)
+	return u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Reviews().Delete(id); err != nil {
+			return err
+		}
+		// 商品の評価を更新
+		if err := updateProductRating(tx, r.ProductID); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReviewDeleted{ReviewID: r.ID, ProductID: r.ProductID, CustomerID: r.CustomerID, ByAdmin: r.CustomerID != customerID})
+	})
 }
 
 // UpdateReview - レビュー更新
@@ -112,23 +114,27 @@ func (u *ReviewUsecase) UpdateReview(id, customerID int64, ratingValue int, comm
 	r.Rating = rating
 	r.Comment = comment
 
-	if err := u.reviewRepo.Update(r); err != nil {
-		return nil, err
-	}
-
-	// 商品の評価を更新
-	if err := u.updateProductRating(r.ProductID); err != nil {
+	err = u.uow.Do(func(tx event.Tx) error {
+		if err := tx.Reviews().Update(r); err != nil {
+			return err
+		}
+		// 商品の評価を更新
+		if err := updateProductRating(tx, r.ProductID); err != nil {
+			return err
+		}
+		return tx.Outbox().Add(event.ReviewUpdated{ReviewID: r.ID, ProductID: r.ProductID, CustomerID: r.CustomerID, Rating: r.Rating.Int()})
+	})
+	if err != nil {
 		return nil, err
 	}
-
 	return r, nil
 }
 
 // updateProductRating - 商品の評価を更新
-func (u *ReviewUsecase) updateProductRating(productID int64) error {
-	avg, count, err := u.reviewRepo.GetProductRatingStats(productID)
+func updateProductRating(tx event.Tx, productID int64) error {
+	avg, count, err := tx.Reviews().GetProductRatingStats(productID)
 	if err != nil {
 		return err
 	}
-	return u.productRepo.UpdateRating(productID, avg, int(count))
+	return tx.Products().UpdateRating(productID, avg, int(count))
 }
@@ -1,14 +1,69 @@
 package customerusecase
 
 import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"backend/domain/customer"
+	"backend/domain/event"
+	"backend/domain/favorite"
+	"backend/domain/offer"
 	"backend/domain/product"
+	"backend/domain/question"
 	"backend/domain/review"
+	"backend/domain/submission"
+	"backend/domain/suggestion"
 	"errors"
 	"testing"
 )
 
 // ===== Mock Repositories =====
 
+// mockUnitOfWork - テスト用 UnitOfWork（コミットされたイベントを events に記録）
+type mockUnitOfWork struct {
+	reviews     review.ReviewRepository
+	replies     review.ReplyRepository
+	questions   question.QuestionRepository
+	answers     question.AnswerRepository
+	submissions submission.SubmissionRepository
+	suggestions suggestion.SuggestionRepository
+	products    product.ProductRepository
+	pending     []event.Event
+	events      []event.Event
+}
+
+func (m *mockUnitOfWork) Do(fn func(tx event.Tx) error) error {
+	m.pending = nil
+	if err := fn(m); err != nil {
+		return err
+	}
+	m.events = append(m.events, m.pending...)
+	return nil
+}
+
+func (m *mockUnitOfWork) Customers() customer.CustomerRepository       { return nil }
+func (m *mockUnitOfWork) Sanctions() customer.SanctionRepository       { return nil }
+func (m *mockUnitOfWork) Reviews() review.ReviewRepository             { return m.reviews }
+func (m *mockUnitOfWork) Replies() review.ReplyRepository              { return m.replies }
+func (m *mockUnitOfWork) Questions() question.QuestionRepository       { return m.questions }
+func (m *mockUnitOfWork) Answers() question.AnswerRepository           { return m.answers }
+func (m *mockUnitOfWork) Submissions() submission.SubmissionRepository { return m.submissions }
+func (m *mockUnitOfWork) Suggestions() suggestion.SuggestionRepository { return m.suggestions }
+func (m *mockUnitOfWork) Products() product.ProductRepository          { return m.products }
+func (m *mockUnitOfWork) Categories() product.CategoryRepository       { return nil }
+func (m *mockUnitOfWork) Stores() product.StoreRepository              { return nil }
+func (m *mockUnitOfWork) Offers() offer.OfferRepository                { return nil }
+func (m *mockUnitOfWork) Favorites() favorite.FavoriteRepository       { return nil }
+func (m *mockUnitOfWork) Collections() favorite.CollectionRepository   { return nil }
+func (m *mockUnitOfWork) Admins() admin.AdminRepository                { return nil }
+func (m *mockUnitOfWork) Roles() admin.RoleRepository                  { return nil }
+func (m *mockUnitOfWork) AuditLogs() audit.AuditLogRepository          { return nil }
+func (m *mockUnitOfWork) Outbox() event.Outbox                         { return m }
+
+func (m *mockUnitOfWork) Add(events ...event.Event) error {
+	m.pending = append(m.pending, events...)
+	return nil
+}
+
 type mockReviewRepository struct {
 	reviews                        []review.Review
 	findByIDFunc                   func(id int64) (*review.Review, error)
@@ -43,6 +98,11 @@ func (m *mockReviewRepository) FindByCustomerID(customerID int64) ([]review.Revi
 	return result, nil
 }
 
+func (m *mockReviewRepository) CountByCustomerID(customerID int64) (int64, error) {
+	reviews, _ := m.FindByCustomerID(customerID)
+	return int64(len(reviews)), nil
+}
+
 func (m *mockReviewRepository) FindByID(id int64) (*review.Review, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(id)
@@ -52,7 +112,7 @@ func (m *mockReviewRepository) FindByID(id int64) (*review.Review, error) {
 			return &r, nil
 		}
 	}
-	return nil, errors.New("not found")
+	return nil, review.ErrReviewNotFound
 }
 
 func (m *mockReviewRepository) FindByProductIDAndCustomerID(productID, customerID int64) (*review.Review, error) {
@@ -91,6 +151,8 @@ func (m *mockReviewRepository) GetProductRatingStats(productID int64) (float64,
 }
 
 type mockProductRepository struct {
+	findAllFn         func(filter product.ProductFilter) ([]product.Product, error)
+	findByIDFn        func(id int64) (*product.Product, error)
 	updateRatingFunc  func(productID int64, rating float64, count int) error
 	updateRatingCalls []struct {
 		productID int64
@@ -99,14 +161,24 @@ type mockProductRepository struct {
 	}
 }
 
-func (m *mockProductRepository) FindAll(categoryID int64, search string) ([]product.Product, error) {
+func (m *mockProductRepository) FindAll(filter product.ProductFilter) ([]product.Product, error) {
+	if m.findAllFn != nil {
+		return m.findAllFn(filter)
+	}
 	return nil, nil
 }
 
 func (m *mockProductRepository) FindByID(id int64) (*product.Product, error) {
+	if m.findByIDFn != nil {
+		return m.findByIDFn(id)
+	}
 	return nil, nil
 }
 
+func (m *mockProductRepository) FindByIDForUpdate(id int64) (*product.Product, error) {
+	return m.FindByID(id)
+}
+
 func (m *mockProductRepository) Create(p *product.Product) error {
 	return nil
 }
@@ -228,7 +300,7 @@ func TestReviewUsecase_CreateReview(t *testing.T) {
 				},
 			}
 			mockProductRepo := &mockProductRepository{}
-			uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
+			uc := NewReviewUsecase(mockReviewRepo, &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo})
 
 			_, err := uc.CreateReview(tc.productID, tc.customerID, tc.rating, tc.comment)
 
@@ -347,7 +419,7 @@ func TestReviewUsecase_DeleteReview(t *testing.T) {
 				},
 			}
 			mockProductRepo := &mockProductRepository{}
-			uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
+			uc := NewReviewUsecase(mockReviewRepo, &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo})
 
 			err := uc.DeleteReview(tc.reviewID, tc.requestCustomerID, tc.isAdmin)
 
@@ -386,7 +458,7 @@ func TestReviewUsecase_GetProductReviews(t *testing.T) {
 
 	mockReviewRepo := &mockReviewRepository{reviews: mockReviews}
 	mockProductRepo := &mockProductRepository{}
-	uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
+	uc := NewReviewUsecase(mockReviewRepo, &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo})
 
 	t.Run("商品のレビュー一覧を取得できる", func(t *testing.T) {
 		reviews, err := uc.GetProductReviews(1)
@@ -411,29 +483,6 @@ func TestReviewUsecase_GetProductReviews(t *testing.T) {
 	})
 }
 
-func TestReviewUsecase_GetCustomerReviews(t *testing.T) {
-	mockReviews := []review.Review{
-		{ID: 1, ProductID: 1, CustomerID: 1, Rating: mustRating(5)},
-		{ID: 2, ProductID: 2, CustomerID: 1, Rating: mustRating(4)},
-		{ID: 3, ProductID: 1, CustomerID: 2, Rating: mustRating(3)},
-	}
-
-	mockReviewRepo := &mockReviewRepository{reviews: mockReviews}
-	mockProductRepo := &mockProductRepository{}
-	uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
-
-	t.Run("カスタマーのレビュー一覧を取得できる", func(t *testing.T) {
-		reviews, err := uc.GetCustomerReviews(1)
-		if err != nil {
-			t.Errorf("unexpected error: %v", err)
-			return
-		}
-		if len(reviews) != 2 {
-			t.Errorf("expected 2 reviews, got %d", len(reviews))
-		}
-	})
-}
-
 func TestReviewUsecase_UpdateReview(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -545,7 +594,7 @@ func TestReviewUsecase_UpdateReview(t *testing.T) {
 				},
 			}
 			mockProductRepo := &mockProductRepository{}
-			uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
+			uc := NewReviewUsecase(mockReviewRepo, &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo})
 
 			r, err := uc.UpdateReview(tc.reviewID, tc.requestCustomerID, tc.rating, tc.comment)
 
@@ -591,7 +640,7 @@ func TestReviewUsecase_ValidationErrors(t *testing.T) {
 			},
 		}
 		mockProductRepo := &mockProductRepository{}
-		uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
+		uc := NewReviewUsecase(mockReviewRepo, &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo})
 
 		// 0 は無効
 		_, err := uc.CreateReview(1, 1, 0, "Valid comment text")
@@ -613,7 +662,7 @@ func TestReviewUsecase_ValidationErrors(t *testing.T) {
 			},
 		}
 		mockProductRepo := &mockProductRepository{}
-		uc := NewReviewUsecase(mockReviewRepo, mockProductRepo)
+		uc := NewReviewUsecase(mockReviewRepo, &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo})
 
 		// 空のコメント
 		_, err := uc.CreateReview(1, 1, 5, "")
@@ -628,3 +677,68 @@ func TestReviewUsecase_ValidationErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestReviewMutations_RecordEvents(t *testing.T) {
+	rating, _ := review.NewRating(4)
+	comment, _ := review.NewComment("This is a great product!")
+	mockReviewRepo := &mockReviewRepository{
+		findByProductIDAndCustomerFunc: func(productID, customerID int64) (*review.Review, error) {
+			return nil, errors.New("not found")
+		},
+		createFunc: func(r *review.Review) error {
+			r.ID = 5
+			return nil
+		},
+		findByIDFunc: func(id int64) (*review.Review, error) {
+			return &review.Review{ID: id, ProductID: 10, CustomerID: 7, Rating: rating, Comment: comment}, nil
+		},
+	}
+	mockProductRepo := &mockProductRepository{}
+	uow := &mockUnitOfWork{reviews: mockReviewRepo, products: mockProductRepo}
+	uc := NewReviewUsecase(mockReviewRepo, uow)
+
+	if _, err := uc.CreateReview(10, 7, 4, "This is a great product!"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.UpdateReview(5, 7, 5, "Even better the second time."); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 管理者による削除
+	if err := uc.DeleteReview(5, 99, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(uow.events) != 3 {
+		t.Fatalf("expected 3 events, got %+v", uow.events)
+	}
+	created, ok := uow.events[0].(event.ReviewCreated)
+	if !ok || created.ReviewID != 5 || created.ProductID != 10 || created.CustomerID != 7 || created.Rating != 4 {
+		t.Errorf("unexpected create event: %+v", uow.events[0])
+	}
+	if updated, ok := uow.events[1].(event.ReviewUpdated); !ok || updated.Rating != 5 {
+		t.Errorf("unexpected update event: %+v", uow.events[1])
+	}
+	if deleted, ok := uow.events[2].(event.ReviewDeleted); !ok || !deleted.ByAdmin || deleted.CustomerID != 7 {
+		t.Errorf("unexpected delete event: %+v", uow.events[2])
+	}
+}
+
+func TestCreateReview_RatingUpdateFailureRecordsNoEvent(t *testing.T) {
+	mockReviewRepo := &mockReviewRepository{
+		findByProductIDAndCustomerFunc: func(productID, customerID int64) (*review.Review, error) {
+			return nil, errors.New("not found")
+		},
+		getRatingStatsFunc: func(productID int64) (float64, int64, error) {
+			return 0, 0, errors.New("database error")
+		},
+	}
+	uow := &mockUnitOfWork{reviews: mockReviewRepo, products: &mockProductRepository{}}
+	uc := NewReviewUsecase(mockReviewRepo, uow)
+
+	if _, err := uc.CreateReview(10, 7, 4, "This is a great product!"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(uow.events) != 0 {
+		t.Errorf("expected no events after rollback, got %+v", uow.events)
+	}
+}
@@ -0,0 +1,59 @@
+package customerusecase
+
+import (
+	"backend/domain/submission"
+)
+
+// SubmitProductInput - 新商品の提案の入力
+type SubmitProductInput struct {
+	Name          string
+	NameJa        string
+	Description   string
+	DescriptionJa string
+	ImageURL      string
+	StoreURL      *string
+}
+
+// SubmissionUsecase - 新商品の提案ユースケース
+type SubmissionUsecase struct {
+	submissionRepo submission.SubmissionRepository
+}
+
+// NewSubmissionUsecase - 新商品の提案ユースケースの生成
+func NewSubmissionUsecase(submissionRepo submission.SubmissionRepository) *SubmissionUsecase {
+	return &SubmissionUsecase{submissionRepo: submissionRepo}
+}
+
+// SubmitProduct - 新商品を提案（同じ名前の商品が既にあれば提案できない）
+func (u *SubmissionUsecase) SubmitProduct(customerID int64, input SubmitProductInput) (*submission.Submission, error) {
+	s, err := submission.NewSubmission(customerID, input.Name, input.NameJa, input.Description, input.DescriptionJa, input.ImageURL, input.StoreURL)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := u.submissionRepo.CountPending(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= submission.MaxPendingPerCustomer {
+		return nil, submission.ErrTooManyPending
+	}
+
+	matches, err := u.submissionRepo.FindProductsByName(s.Name, s.NameJa)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) > 0 {
+		return nil, submission.ErrDuplicateProduct
+	}
+
+	if err := u.submissionRepo.Create(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetMySubmissions - 自分の提案一覧（新しい順、審査結果と却下理由を含む）
+func (u *SubmissionUsecase) GetMySubmissions(customerID int64) ([]submission.Submission, error) {
+	return u.submissionRepo.FindByCustomerID(customerID)
+}
@@ -0,0 +1,120 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/submission"
+	"errors"
+	"testing"
+)
+
+// mockSubmissionRepository - テスト用モックリポジトリ（FindProductsByName は products から正規化した名前で探す）
+type mockSubmissionRepository struct {
+	submissions []submission.Submission
+	products    []submission.ProductMatch
+}
+
+func (m *mockSubmissionRepository) FindByStatus(_ string) ([]submission.Submission, error) {
+	return nil, nil
+}
+
+func (m *mockSubmissionRepository) FindByCustomerID(customerID int64) ([]submission.Submission, error) {
+	var result []submission.Submission
+	for _, s := range m.submissions {
+		if s.CustomerID == customerID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockSubmissionRepository) FindByID(_ int64) (*submission.Submission, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockSubmissionRepository) FindByIDForUpdate(_ int64) (*submission.Submission, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockSubmissionRepository) CountPending(customerID int64) (int64, error) {
+	var count int64
+	for _, s := range m.submissions {
+		if s.CustomerID == customerID && s.IsPending() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockSubmissionRepository) Create(s *submission.Submission) error {
+	s.ID = int64(len(m.submissions) + 1)
+	m.submissions = append(m.submissions, *s)
+	return nil
+}
+
+func (m *mockSubmissionRepository) Update(_ *submission.Submission) error { return nil }
+
+func (m *mockSubmissionRepository) FindProductsByName(name, nameJa string) ([]submission.ProductMatch, error) {
+	var result []submission.ProductMatch
+	for _, p := range m.products {
+		if product.NormalizeName(p.Name) == product.NormalizeName(name) || product.NormalizeName(p.NameJa) == product.NormalizeName(nameJa) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockSubmissionRepository) BackfillNormalizedNames(_ int) (int, error) { return 0, nil }
+
+func validSubmitInput() SubmitProductInput {
+	return SubmitProductInput{
+		Name:          "Soy Meat",
+		NameJa:        "大豆ミート",
+		Description:   "Dried soy meat",
+		DescriptionJa: "乾燥大豆ミート",
+		ImageURL:      "https://example.com/soy.jpg",
+	}
+}
+
+func TestSubmitProduct(t *testing.T) {
+	repo := &mockSubmissionRepository{products: []submission.ProductMatch{{ProductID: 3, Name: "Oat Milk", NameJa: "オーツミルク"}}}
+	uc := NewSubmissionUsecase(repo)
+
+	s, err := uc.SubmitProduct(1, validSubmitInput())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Status != submission.StatusPending || s.CustomerID != 1 {
+		t.Errorf("expected pending submission, got %+v", s)
+	}
+
+	// 管理者の商品登録と同じ英語・日本語のバリデーション
+	input := validSubmitInput()
+	input.NameJa = "Soy Meat"
+	if _, err := uc.SubmitProduct(1, input); !errors.Is(err, product.ErrMustContainJapanese) {
+		t.Errorf("expected ErrMustContainJapanese, got %v", err)
+	}
+	input = validSubmitInput()
+	input.StoreURL = strPtr("not a url")
+	if _, err := uc.SubmitProduct(1, input); !errors.Is(err, product.ErrURLInvalid) {
+		t.Errorf("expected ErrURLInvalid, got %v", err)
+	}
+
+	// 大文字小文字・空白・記号の違いは同じ名前とみなす
+	input = validSubmitInput()
+	input.Name = " OAT-MILK "
+	if _, err := uc.SubmitProduct(1, input); !errors.Is(err, submission.ErrDuplicateProduct) {
+		t.Errorf("expected ErrDuplicateProduct, got %v", err)
+	}
+
+	for len(repo.submissions) < submission.MaxPendingPerCustomer {
+		if _, err := uc.SubmitProduct(1, validSubmitInput()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := uc.SubmitProduct(1, validSubmitInput()); !errors.Is(err, submission.ErrTooManyPending) {
+		t.Errorf("expected ErrTooManyPending, got %v", err)
+	}
+	if mine, _ := uc.GetMySubmissions(1); len(mine) != submission.MaxPendingPerCustomer {
+		t.Errorf("expected %d submissions, got %d", submission.MaxPendingPerCustomer, len(mine))
+	}
+}
@@ -0,0 +1,72 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/suggestion"
+	"errors"
+	"fmt"
+)
+
+var ErrSuggestionProductNotFound = errors.New("product not found")
+
+// SuggestEditInput - 商品情報の修正提案の入力
+type SuggestEditInput struct {
+	Changes map[string]string // 項目名と新しい値（"storeLinks.<ストアコード>" の空文字はリンクの削除）
+	Comment string
+}
+
+// SuggestionUsecase - 商品情報の修正提案ユースケース
+type SuggestionUsecase struct {
+	productRepo    product.ProductRepository
+	storeRepo      product.StoreRepository
+	suggestionRepo suggestion.SuggestionRepository
+}
+
+// NewSuggestionUsecase - 商品情報の修正提案ユースケースの生成
+func NewSuggestionUsecase(productRepo product.ProductRepository, storeRepo product.StoreRepository, suggestionRepo suggestion.SuggestionRepository) *SuggestionUsecase {
+	return &SuggestionUsecase{
+		productRepo:    productRepo,
+		storeRepo:      storeRepo,
+		suggestionRepo: suggestionRepo,
+	}
+}
+
+// SuggestEdit - 商品情報の修正を提案（現在の値との差分を保存）
+func (u *SuggestionUsecase) SuggestEdit(customerID, productID int64, input SuggestEditInput) (*suggestion.Suggestion, error) {
+	p, err := u.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, ErrSuggestionProductNotFound
+	}
+
+	s, err := suggestion.NewSuggestion(customerID, p, input.Changes, input.Comment)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range s.Changes {
+		code, ok := suggestion.StoreCodeOf(c.Field)
+		if !ok {
+			continue
+		}
+		if store, err := u.storeRepo.FindByCode(code); err != nil || !store.IsActive {
+			return nil, fmt.Errorf("%s: store %s not found", c.Field, code)
+		}
+	}
+
+	pending, err := u.suggestionRepo.CountPending(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= suggestion.MaxPendingPerCustomer {
+		return nil, suggestion.ErrTooManyPending
+	}
+
+	if err := u.suggestionRepo.Create(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetMySuggestions - 自分の修正提案一覧（新しい順、審査結果と反映された項目を含む）
+func (u *SuggestionUsecase) GetMySuggestions(customerID int64) ([]suggestion.Suggestion, error) {
+	return u.suggestionRepo.FindByCustomerID(customerID)
+}
@@ -0,0 +1,172 @@
+package customerusecase
+
+import (
+	"backend/domain/product"
+	"backend/domain/suggestion"
+	"errors"
+	"testing"
+)
+
+// mockSuggestionRepository - テスト用モックリポジトリ
+type mockSuggestionRepository struct {
+	suggestions []suggestion.Suggestion
+}
+
+func (m *mockSuggestionRepository) FindByStatus(_ string) ([]suggestion.Suggestion, error) {
+	return nil, nil
+}
+
+func (m *mockSuggestionRepository) FindByCustomerID(customerID int64) ([]suggestion.Suggestion, error) {
+	var result []suggestion.Suggestion
+	for _, s := range m.suggestions {
+		if s.CustomerID == customerID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockSuggestionRepository) FindByID(_ int64) (*suggestion.Suggestion, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockSuggestionRepository) FindByIDForUpdate(_ int64) (*suggestion.Suggestion, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockSuggestionRepository) CountPending(customerID int64) (int64, error) {
+	var count int64
+	for _, s := range m.suggestions {
+		if s.CustomerID == customerID && s.IsPending() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockSuggestionRepository) Create(s *suggestion.Suggestion) error {
+	s.ID = int64(len(m.suggestions) + 1)
+	m.suggestions = append(m.suggestions, *s)
+	return nil
+}
+
+func (m *mockSuggestionRepository) Update(_ *suggestion.Suggestion) error {
+	return nil
+}
+
+// mockStoreRepository - テスト用モックリポジトリ（FindByCode のみ）
+type mockStoreRepository struct {
+	stores map[string]*product.Store
+}
+
+func (m *mockStoreRepository) FindAll(_ bool) ([]product.Store, error) { return nil, nil }
+func (m *mockStoreRepository) FindByID(_ int64) (*product.Store, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockStoreRepository) FindByCode(code string) (*product.Store, error) {
+	if s, ok := m.stores[code]; ok {
+		return s, nil
+	}
+	return nil, errors.New("not found")
+}
+func (m *mockStoreRepository) Create(_ *product.Store) error            { return nil }
+func (m *mockStoreRepository) Update(_ *product.Store) error            { return nil }
+func (m *mockStoreRepository) Delete(_ int64) error                     { return nil }
+func (m *mockStoreRepository) CountProductLinks(_ int64) (int64, error) { return 0, nil }
+func (m *mockStoreRepository) CountOffers(_ string) (int64, error)      { return 0, nil }
+
+func newTestSuggestionUsecase() (*SuggestionUsecase, *mockSuggestionRepository) {
+	products := &mockProductRepository{findByIDFn: func(id int64) (*product.Product, error) {
+		if id != 10 {
+			return nil, errors.New("not found")
+		}
+		return &product.Product{
+			ID:            10,
+			Name:          "Soy Meat",
+			NameJa:        "大豆ミート",
+			Description:   "Contains wheat",
+			DescriptionJa: "小麦を含む",
+			ImageURL:      "https://example.com/soy.jpg",
+			StoreLinks: []product.StoreLink{
+				{StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon"}, URL: "https://www.amazon.co.jp/dp/B000000001"},
+			},
+		}, nil
+	}}
+	stores := &mockStoreRepository{stores: map[string]*product.Store{
+		"amazon": {ID: 1, Code: "amazon", IsActive: true},
+		"closed": {ID: 3, Code: "closed", IsActive: false},
+	}}
+	suggestions := &mockSuggestionRepository{}
+	return NewSuggestionUsecase(products, stores, suggestions), suggestions
+}
+
+func TestSuggestEdit_StoresDiff(t *testing.T) {
+	uc, repo := newTestSuggestionUsecase()
+
+	s, err := uc.SuggestEdit(7, 10, SuggestEditInput{
+		Changes: map[string]string{
+			suggestion.FieldName:                "Soy Meat",
+			suggestion.FieldDescription:         "  Gluten free  ",
+			suggestion.StoreLinkField("amazon"): "",
+		},
+		Comment: "パッケージが変わりました",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// 現在と同じ値の name は除かれ、項目名の順に並ぶ
+	if len(s.Changes) != 2 || s.Changes[0].Field != suggestion.FieldDescription || s.Changes[0].Original != "Contains wheat" || s.Changes[0].Proposed != "Gluten free" {
+		t.Fatalf("unexpected changes: %+v", s.Changes)
+	}
+	if s.Changes[1].Original != "https://www.amazon.co.jp/dp/B000000001" || s.Changes[1].Proposed != "" {
+		t.Errorf("expected amazon link removal, got %+v", s.Changes[1])
+	}
+	if s.Status != suggestion.StatusPending || len(repo.suggestions) != 1 {
+		t.Errorf("expected pending suggestion to be saved, got %+v", s)
+	}
+	mine, _ := uc.GetMySuggestions(7)
+	if len(mine) != 1 {
+		t.Errorf("expected 1 own suggestion, got %d", len(mine))
+	}
+}
+
+func TestSuggestEdit_Validation(t *testing.T) {
+	uc, _ := newTestSuggestionUsecase()
+
+	tests := []struct {
+		name    string
+		product int64
+		changes map[string]string
+		wantErr error
+	}{
+		{"product not found", 99, map[string]string{suggestion.FieldName: "Tofu"}, ErrSuggestionProductNotFound},
+		{"no changes", 10, map[string]string{suggestion.FieldName: "Soy Meat"}, suggestion.ErrNoChanges},
+		{"unknown field", 10, map[string]string{"rating": "5"}, suggestion.ErrFieldUnknown},
+		{"invalid image URL", 10, map[string]string{suggestion.FieldImageURL: "not-a-url"}, product.ErrURLInvalid},
+		{"empty name", 10, map[string]string{suggestion.FieldNameJa: " "}, nil},
+		{"inactive store", 10, map[string]string{suggestion.StoreLinkField("closed"): "https://example.com/soy"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.SuggestEdit(7, tt.product, SuggestEditInput{Changes: tt.changes})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSuggestEdit_TooManyPending(t *testing.T) {
+	uc, repo := newTestSuggestionUsecase()
+	for i := 0; i < suggestion.MaxPendingPerCustomer; i++ {
+		repo.suggestions = append(repo.suggestions, suggestion.Suggestion{CustomerID: 7, Status: suggestion.StatusPending})
+	}
+
+	_, err := uc.SuggestEdit(7, 10, SuggestEditInput{Changes: map[string]string{suggestion.FieldName: "Soy Mince"}})
+	if !errors.Is(err, suggestion.ErrTooManyPending) {
+		t.Errorf("expected ErrTooManyPending, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"backend/domain/event"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// eventBatchSize - 1回の配信で処理するイベント数の上限
+const eventBatchSize = 100
+
+// EventHandler - イベント購読者（少なくとも1回配信されるため冪等に実装する）
+type EventHandler func(ctx context.Context, e event.Event) error
+
+// EventBus - outbox のイベントを購読者へ配信する
+type EventBus struct {
+	outboxRepo event.OutboxRepository
+	handlers   map[string][]EventHandler
+	now        func() time.Time
+}
+
+// DispatchSummary - 配信結果
+type DispatchSummary struct {
+	Dispatched int `json:"dispatched"`
+	Retrying   int `json:"retrying"`
+	Dead       int `json:"dead"`
+}
+
+// NewEventBus - イベントバスの生成
+func NewEventBus(outboxRepo event.OutboxRepository) *EventBus {
+	return &EventBus{
+		outboxRepo: outboxRepo,
+		handlers:   map[string][]EventHandler{},
+		now:        time.Now,
+	}
+}
+
+// Subscribe - イベント名に購読者を登録
+func (b *EventBus) Subscribe(name string, handler EventHandler) {
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// DispatchPending - 配信時刻を過ぎたイベントを配信（購読者が1つでも失敗したらイベントごと再試行）
+func (b *EventBus) DispatchPending(ctx context.Context) (*DispatchSummary, error) {
+	messages, err := b.outboxRepo.FindDue(b.now(), eventBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &DispatchSummary{}
+	for i := range messages {
+		if ctx.Err() != nil {
+			return summary, ctx.Err()
+		}
+		m := &messages[i]
+		if err := b.dispatch(ctx, m); err != nil {
+			m.MarkFailed(err, b.now())
+			if m.Status == event.StatusDead {
+				summary.Dead++
+			} else {
+				summary.Retrying++
+			}
+		} else {
+			m.MarkProcessed(b.now())
+			summary.Dispatched++
+		}
+		if err := b.outboxRepo.Save(m); err != nil {
+			return summary, err
+		}
+	}
+	return summary, nil
+}
+
+func (b *EventBus) dispatch(ctx context.Context, m *event.OutboxMessage) error {
+	e, err := m.Event()
+	if err != nil {
+		return err
+	}
+	ctx = event.WithID(ctx, m.ID)
+	var errs []error
+	for _, handler := range b.handlers[m.EventName] {
+		if err := handler(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s #%d: %w", m.EventName, m.ID, errors.Join(errs...))
+	}
+	return nil
+}
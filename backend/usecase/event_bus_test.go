@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/notification"
+	"backend/domain/product"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// ===== Mock Repositories =====
+
+type mockOutboxRepository struct {
+	messages map[int64]*event.OutboxMessage
+	nextID   int64
+}
+
+func newMockOutboxRepo(events ...event.Event) *mockOutboxRepository {
+	m := &mockOutboxRepository{messages: map[int64]*event.OutboxMessage{}}
+	for _, e := range events {
+		msg, _ := event.NewOutboxMessage(e, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+		m.nextID++
+		msg.ID = m.nextID
+		m.messages[msg.ID] = msg
+	}
+	return m
+}
+
+func (m *mockOutboxRepository) FindDue(now time.Time, limit int) ([]event.OutboxMessage, error) {
+	var result []event.OutboxMessage
+	for id := int64(1); id <= m.nextID && len(result) < limit; id++ {
+		msg := m.messages[id]
+		if msg.Status == event.StatusPending && !msg.NextAttemptAt.After(now) {
+			result = append(result, *msg)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockOutboxRepository) Save(msg *event.OutboxMessage) error {
+	copy := *msg
+	m.messages[msg.ID] = &copy
+	return nil
+}
+
+type mockProductRepository struct {
+	products map[int64]*product.Product
+	findErr  error
+}
+
+func (m *mockProductRepository) FindAll(filter product.ProductFilter) ([]product.Product, error) {
+	return nil, nil
+}
+
+func (m *mockProductRepository) FindByID(id int64) (*product.Product, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	p, ok := m.products[id]
+	if !ok {
+		return nil, product.ErrProductNotFound
+	}
+	return p, nil
+}
+func (m *mockProductRepository) FindByIDForUpdate(id int64) (*product.Product, error) {
+	return m.FindByID(id)
+}
+
+func (m *mockProductRepository) Create(p *product.Product) error { return nil }
+func (m *mockProductRepository) Update(p *product.Product) error { return nil }
+func (m *mockProductRepository) Delete(id int64) error           { return nil }
+func (m *mockProductRepository) UpdateRating(productID int64, rating float64, count int) error {
+	return nil
+}
+
+// ===== Tests =====
+
+func TestEventBus_DispatchPending(t *testing.T) {
+	repo := newMockOutboxRepo(
+		event.ReviewCreated{ReviewID: 1, ProductID: 10, CustomerID: 7, Rating: 5},
+		event.CustomerBanned{CustomerID: 3, Reason: "spam"},
+		event.ProductDeleted{ProductID: 10},
+	)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	bus := NewEventBus(repo)
+	bus.now = func() time.Time { return now }
+
+	var received []event.Event
+	var ids []int64
+	record := func(ctx context.Context, e event.Event) error {
+		received = append(received, e)
+		ids = append(ids, event.IDFromContext(ctx))
+		return nil
+	}
+	bus.Subscribe(event.NameReviewCreated, record)
+	bus.Subscribe(event.NameReviewCreated, record)
+	bus.Subscribe(event.NameCustomerBanned, record)
+
+	summary, err := bus.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 購読者のいないイベントも処理済みになる
+	if summary.Dispatched != 3 {
+		t.Errorf("expected 3 dispatched events, got %+v", summary)
+	}
+	if len(received) != 3 {
+		t.Fatalf("expected 3 deliveries, got %d", len(received))
+	}
+	if e, ok := received[0].(event.ReviewCreated); !ok || e.ProductID != 10 || e.Rating != 5 {
+		t.Errorf("expected decoded ReviewCreated, got %+v", received[0])
+	}
+	// 購読者は context から outbox ID を受け取れる
+	for i, id := range ids {
+		if _, ok := repo.messages[id]; !ok {
+			t.Errorf("delivery %d: expected outbox id in context, got %d", i, id)
+		}
+	}
+	for id, msg := range repo.messages {
+		if msg.Status != event.StatusProcessed || msg.ProcessedAt == nil {
+			t.Errorf("message %d: expected processed, got %s", id, msg.Status)
+		}
+	}
+
+	// 処理済みのイベントは再配信しない
+	if summary, _ := bus.DispatchPending(context.Background()); summary.Dispatched != 0 {
+		t.Errorf("expected nothing to dispatch, got %+v", summary)
+	}
+}
+
+func TestEventBus_RetriesWithBackoffUntilDead(t *testing.T) {
+	repo := newMockOutboxRepo(event.ProductUpdated{ProductID: 10})
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	bus := NewEventBus(repo)
+	bus.now = func() time.Time { return now }
+
+	calls := 0
+	bus.Subscribe(event.NameProductUpdated, func(ctx context.Context, e event.Event) error {
+		calls++
+		return errors.New("search index unavailable")
+	})
+
+	summary, _ := bus.DispatchPending(context.Background())
+	if summary.Retrying != 1 {
+		t.Fatalf("expected 1 retrying event, got %+v", summary)
+	}
+	msg := repo.messages[1]
+	if msg.Attempts != 1 || msg.LastError == nil || !msg.NextAttemptAt.After(now) {
+		t.Fatalf("expected failure with a future retry, got %+v", msg)
+	}
+
+	// 再試行時刻前は配信しない
+	if summary, _ := bus.DispatchPending(context.Background()); summary.Retrying != 0 || calls != 1 {
+		t.Errorf("expected no retry before backoff, got %+v (calls %d)", summary, calls)
+	}
+
+	var prevDelay time.Duration
+	for attempt := 2; attempt <= event.MaxAttempts; attempt++ {
+		delay := repo.messages[1].NextAttemptAt.Sub(now)
+		if delay < prevDelay {
+			t.Errorf("attempt %d: expected non-decreasing backoff, got %v after %v", attempt, delay, prevDelay)
+		}
+		prevDelay = delay
+		now = repo.messages[1].NextAttemptAt
+		bus.DispatchPending(context.Background())
+	}
+
+	if repo.messages[1].Status != event.StatusDead {
+		t.Errorf("expected dead after %d attempts, got %s", event.MaxAttempts, repo.messages[1].Status)
+	}
+	if calls != event.MaxAttempts {
+		t.Errorf("expected %d handler calls, got %d", event.MaxAttempts, calls)
+	}
+}
+
+func TestEventBus_UndecodableEventFails(t *testing.T) {
+	repo := newMockOutboxRepo()
+	repo.nextID = 1
+	repo.messages[1] = &event.OutboxMessage{ID: 1, EventName: "legacy.removed", Payload: "{}", Status: event.StatusPending}
+	bus := NewEventBus(repo)
+
+	summary, err := bus.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Retrying != 1 || repo.messages[1].LastError == nil {
+		t.Errorf("expected unknown event to be retried with an error, got %+v", repo.messages[1])
+	}
+}
+
+func TestNotificationSubscriber(t *testing.T) {
+	notificationRepo := &mockNotificationRepository{
+		subscribers: []notification.Recipient{
+			{CustomerID: 1, Email: "reviewer@example.com"},
+			{CustomerID: 2, Email: "fan@example.com"},
+		},
+	}
+	productRepo := &mockProductRepository{products: map[int64]*product.Product{
+		10: {
+			ID:     10,
+			Name:   "Tempeh",
+			NameJa: "テンペ",
+			StoreLinks: []product.StoreLink{
+				{StoreID: 1, Store: &product.Store{ID: 1, Code: "amazon", Name: "Amazon", NameJa: "Amazon.co.jp"}},
+			},
+		},
+	}}
+	outbox := newMockOutboxRepo(
+		event.ReviewCreated{ReviewID: 1, ProductID: 10, CustomerID: 1, Rating: 5},
+		event.OfferPriceDropped{ProductID: 10, Store: "amazon", OldAmount: 1280, NewAmount: 980, Currency: "JPY"},
+		event.OfferBackInStock{ProductID: 10, Store: "amazon"},
+		// 削除済み商品は通知しない
+		event.OfferBackInStock{ProductID: 99, Store: "amazon"},
+	)
+	bus := NewEventBus(outbox)
+	NewNotificationSubscriber(NewNotificationDispatcher(notificationRepo), productRepo).Register(bus)
+
+	summary, err := bus.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Dispatched != 4 {
+		t.Fatalf("expected 4 dispatched events, got %+v", summary)
+	}
+
+	// レビュー投稿者本人には通知しない: 1 + 2 + 2 件
+	if len(notificationRepo.notifications) != 5 {
+		t.Fatalf("expected 5 notifications, got %d", len(notificationRepo.notifications))
+	}
+	drop := notificationRepo.notifications[1]
+	if drop.Type != notification.TypePriceDrop || drop.Body != "Amazon.co.jpでの価格が 1280 JPY から 980 JPY になりました。" {
+		t.Errorf("unexpected price drop notification: %+v", drop)
+	}
+	if drop.EventID == nil || *drop.EventID != 2 {
+		t.Errorf("expected notification to carry outbox event id 2, got %v", drop.EventID)
+	}
+}
+
+func TestNotificationSubscriber_RetriesOnProductLookupError(t *testing.T) {
+	notificationRepo := &mockNotificationRepository{
+		subscribers: []notification.Recipient{{CustomerID: 2, Email: "fan@example.com"}},
+	}
+	productRepo := &mockProductRepository{findErr: errors.New("connection refused")}
+	outbox := newMockOutboxRepo(event.OfferBackInStock{ProductID: 10, Store: "amazon"})
+	bus := NewEventBus(outbox)
+	NewNotificationSubscriber(NewNotificationDispatcher(notificationRepo), productRepo).Register(bus)
+
+	summary, err := bus.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Retrying != 1 || outbox.messages[1].LastError == nil {
+		t.Errorf("expected the event to be retried, got %+v", outbox.messages[1])
+	}
+}
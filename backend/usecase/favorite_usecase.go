@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"backend/domain/event"
 	"backend/domain/favorite"
 	"errors"
 )
@@ -8,11 +9,12 @@ import (
 // FavoriteUsecase - お気に入りユースケース
 type FavoriteUsecase struct {
 	favoriteRepo favorite.FavoriteRepository
+	uow          event.UnitOfWork
 }
 
 // NewFavoriteUsecase - お気に入りユースケースの生成
-func NewFavoriteUsecase(favoriteRepo favorite.FavoriteRepository) *FavoriteUsecase {
-	return &FavoriteUsecase{favoriteRepo: favoriteRepo}
+func NewFavoriteUsecase(favoriteRepo favorite.FavoriteRepository, uow event.UnitOfWork) *FavoriteUsecase {
+	return &FavoriteUsecase{favoriteRepo: favoriteRepo, uow: uow}
 }
 
 // GetCustomerFavorites - カスタマーのお気に入り一覧取得
@@ -29,13 +31,21 @@ func (u *FavoriteUsecase) AddFavorite(fav *favorite.Favorite, requestCustomerID
 		return errors.New("permission denied")
 	}
 
-	// 既に登録済みかチェック
-	existing, _ := u.favoriteRepo.FindByCustomerIDAndProductID(fav.CustomerID, fav.ProductID)
-	if existing != nil {
-		return errors.New("already in favorites")
-	}
-
-	return u.favoriteRepo.Create(fav)
+	return u.uow.Do(func(tx event.Tx) error {
+		// 既に登録済みかチェック
+		_, err := tx.Favorites().FindByCustomerIDAndProductID(fav.CustomerID, fav.ProductID)
+		if err == nil {
+			return errors.New("already in favorites")
+		}
+		if !errors.Is(err, favorite.ErrFavoriteNotFound) {
+			return err
+		}
+
+		if err := tx.Favorites().Create(fav); err != nil {
+			return err
+		}
+		return addToDefaultCollection(tx.Collections(), fav.CustomerID, fav.ProductID)
+	})
 }
 
 // RemoveFavorite - お気に入り削除
@@ -43,5 +53,7 @@ func (u *FavoriteUsecase) RemoveFavorite(customerID, productID, requestCustomerI
 	if customerID != requestCustomerID {
 		return errors.New("permission denied")
 	}
-	return u.favoriteRepo.Delete(customerID, productID)
+	return u.uow.Do(func(tx event.Tx) error {
+		return removeFavorite(tx, customerID, productID)
+	})
 }
@@ -1,19 +1,56 @@
 package usecase
 
 import (
+	"backend/domain/admin"
+	"backend/domain/audit"
+	"backend/domain/customer"
+	"backend/domain/event"
 	"backend/domain/favorite"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"backend/domain/question"
+	"backend/domain/review"
+	"backend/domain/submission"
+	"backend/domain/suggestion"
 	"errors"
 	"testing"
 )
 
 // ===== Mock Repository =====
 
+// mockUnitOfWork - テスト用 UnitOfWork（お気に入り・リストのリポジトリをそのまま渡す）
+type mockUnitOfWork struct {
+	favorites   favorite.FavoriteRepository
+	collections favorite.CollectionRepository
+}
+
+func (m *mockUnitOfWork) Do(fn func(tx event.Tx) error) error { return fn(m) }
+
+func (m *mockUnitOfWork) Customers() customer.CustomerRepository       { return nil }
+func (m *mockUnitOfWork) Sanctions() customer.SanctionRepository       { return nil }
+func (m *mockUnitOfWork) Reviews() review.ReviewRepository             { return nil }
+func (m *mockUnitOfWork) Replies() review.ReplyRepository              { return nil }
+func (m *mockUnitOfWork) Questions() question.QuestionRepository       { return nil }
+func (m *mockUnitOfWork) Answers() question.AnswerRepository           { return nil }
+func (m *mockUnitOfWork) Submissions() submission.SubmissionRepository { return nil }
+func (m *mockUnitOfWork) Suggestions() suggestion.SuggestionRepository { return nil }
+func (m *mockUnitOfWork) Products() product.ProductRepository          { return nil }
+func (m *mockUnitOfWork) Categories() product.CategoryRepository       { return nil }
+func (m *mockUnitOfWork) Stores() product.StoreRepository              { return nil }
+func (m *mockUnitOfWork) Offers() offer.OfferRepository                { return nil }
+func (m *mockUnitOfWork) Favorites() favorite.FavoriteRepository       { return m.favorites }
+func (m *mockUnitOfWork) Collections() favorite.CollectionRepository   { return m.collections }
+func (m *mockUnitOfWork) Admins() admin.AdminRepository                { return nil }
+func (m *mockUnitOfWork) Roles() admin.RoleRepository                  { return nil }
+func (m *mockUnitOfWork) AuditLogs() audit.AuditLogRepository          { return nil }
+func (m *mockUnitOfWork) Outbox() event.Outbox                         { return nil }
+
 type mockFavoriteRepository struct {
-	favorites                  []favorite.Favorite
-	findByCustomerIDFunc       func(customerID int64) ([]favorite.Favorite, error)
+	favorites                   []favorite.Favorite
+	findByCustomerIDFunc        func(customerID int64) ([]favorite.Favorite, error)
 	findByCustomerIDAndProdFunc func(customerID, productID int64) (*favorite.Favorite, error)
-	createFunc                 func(fav *favorite.Favorite) error
-	deleteFunc                 func(customerID, productID int64) error
+	createFunc                  func(fav *favorite.Favorite) error
+	deleteFunc                  func(customerID, productID int64) error
 }
 
 func (m *mockFavoriteRepository) FindByCustomerID(customerID int64) ([]favorite.Favorite, error) {
@@ -27,7 +64,7 @@ func (m *mockFavoriteRepository) FindByCustomerIDAndProductID(customerID, produc
 	if m.findByCustomerIDAndProdFunc != nil {
 		return m.findByCustomerIDAndProdFunc(customerID, productID)
 	}
-	return nil, errors.New("not found")
+	return nil, favorite.ErrFavoriteNotFound
 }
 
 func (m *mockFavoriteRepository) Create(fav *favorite.Favorite) error {
@@ -88,7 +125,7 @@ func TestFavoriteUsecase_GetCustomerFavorites(t *testing.T) {
 			mockRepo := &mockFavoriteRepository{
 				favorites: tc.mockFavorites,
 			}
-			usecase := NewFavoriteUsecase(mockRepo)
+			usecase := NewFavoriteUsecase(mockRepo, &mockUnitOfWork{favorites: mockRepo, collections: newMockCollectionRepo()})
 
 			favorites, err := usecase.GetCustomerFavorites(tc.customerID, tc.requestCustomerID)
 
@@ -177,13 +214,13 @@ func TestFavoriteUsecase_AddFavorite(t *testing.T) {
 					if tc.existingFav != nil {
 						return tc.existingFav, nil
 					}
-					return nil, errors.New("not found")
+					return nil, favorite.ErrFavoriteNotFound
 				},
 				createFunc: func(fav *favorite.Favorite) error {
 					return tc.repoErr
 				},
 			}
-			usecase := NewFavoriteUsecase(mockRepo)
+			usecase := NewFavoriteUsecase(mockRepo, &mockUnitOfWork{favorites: mockRepo, collections: newMockCollectionRepo()})
 
 			err := usecase.AddFavorite(tc.fav, tc.requestCustomerID)
 
@@ -245,7 +282,7 @@ func TestFavoriteUsecase_RemoveFavorite(t *testing.T) {
 					return tc.repoErr
 				},
 			}
-			usecase := NewFavoriteUsecase(mockRepo)
+			usecase := NewFavoriteUsecase(mockRepo, &mockUnitOfWork{favorites: mockRepo, collections: newMockCollectionRepo()})
 
 			err := usecase.RemoveFavorite(tc.customerID, tc.productID, tc.requestCustomerID)
 
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"backend/domain/notification"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NotificationDispatcher - イベントをお気に入り登録者の受信設定に従って配信する
+type NotificationDispatcher struct {
+	notificationRepo notification.NotificationRepository
+	senders          []notification.Sender
+	now              func() time.Time
+}
+
+// NewNotificationDispatcher - 通知配信の生成（senders はアプリ外の配信チャネル）
+func NewNotificationDispatcher(notificationRepo notification.NotificationRepository, senders ...notification.Sender) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		notificationRepo: notificationRepo,
+		senders:          senders,
+		now:              time.Now,
+	}
+}
+
+// deliveryKey - アプリ外チャネルの配信記録のキー
+type deliveryKey struct {
+	customerID int64
+	channel    notification.Channel
+}
+
+// Publish - イベントを購読者ごとの通知に展開して配信（一部の配信に失敗しても残りは配信する）
+// 再配信されてもアプリ内通知は重複せず、メール等は未送信・失敗した宛先にだけ送る
+func (d *NotificationDispatcher) Publish(event notification.Event) error {
+	if event.ID == 0 {
+		return errors.New("notification event has no id")
+	}
+	recipients, err := d.notificationRepo.FindSubscribers(event.ProductID)
+	if err != nil {
+		return err
+	}
+	var targets []notification.Recipient
+	var ids []int64
+	for _, r := range recipients {
+		if r.CustomerID == event.ActorCustomerID {
+			continue
+		}
+		targets = append(targets, r)
+		ids = append(ids, r.CustomerID)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	storedPrefs, err := d.notificationRepo.FindPreferences(ids)
+	if err != nil {
+		return err
+	}
+	prefs := make(map[int64]notification.Preference, len(targets))
+	for _, p := range storedPrefs {
+		if p.Type == event.Type {
+			prefs[p.CustomerID] = p
+		}
+	}
+
+	stored, err := d.notificationRepo.FindDeliveries(event.ID)
+	if err != nil {
+		return err
+	}
+	deliveries := make(map[deliveryKey]notification.Delivery, len(stored))
+	for _, delivery := range stored {
+		deliveries[deliveryKey{delivery.CustomerID, delivery.Channel}] = delivery
+	}
+
+	var errs []error
+	at := d.now()
+	for _, r := range targets {
+		pref, ok := prefs[r.CustomerID]
+		if !ok {
+			pref = notification.DefaultPreference(r.CustomerID, event.Type)
+		}
+		n := event.ToNotification(r.CustomerID, at)
+		if pref.Enabled(notification.ChannelInApp) {
+			if err := d.notificationRepo.Create(n); err != nil {
+				errs = append(errs, fmt.Errorf("in_app to customer %d: %w", r.CustomerID, err))
+			}
+		}
+		for _, s := range d.senders {
+			if !pref.Enabled(s.Channel()) {
+				continue
+			}
+			key := deliveryKey{r.CustomerID, s.Channel()}
+			delivery, ok := deliveries[key]
+			if ok && delivery.Status == notification.DeliverySent {
+				continue
+			}
+			if !ok {
+				delivery = notification.Delivery{EventID: event.ID, CustomerID: r.CustomerID, Channel: s.Channel()}
+			}
+			sendErr := s.Send(r, n)
+			delivery.Record(sendErr, d.now())
+			if sendErr != nil {
+				errs = append(errs, fmt.Errorf("%s to customer %d: %w", s.Channel(), r.CustomerID, sendErr))
+			}
+			if err := d.notificationRepo.SaveDelivery(&delivery); err != nil {
+				errs = append(errs, fmt.Errorf("%s delivery record for customer %d: %w", s.Channel(), r.CustomerID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"backend/domain/notification"
+	smtpsender "backend/infrastructure/notification"
+	"bufio"
+	"errors"
+	"mime"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ===== Mock Repository =====
+
+type mockNotificationRepository struct {
+	subscribers   []notification.Recipient
+	preferences   []notification.Preference
+	notifications []notification.Notification
+	deliveries    []notification.Delivery
+	createErr     error
+}
+
+func (m *mockNotificationRepository) FindSubscribers(productID int64) ([]notification.Recipient, error) {
+	return m.subscribers, nil
+}
+
+func (m *mockNotificationRepository) FindPreferences(customerIDs []int64) ([]notification.Preference, error) {
+	return m.preferences, nil
+}
+
+func (m *mockNotificationRepository) SavePreferences(prefs []notification.Preference) error {
+	m.preferences = append(m.preferences, prefs...)
+	return nil
+}
+
+func (m *mockNotificationRepository) Create(n *notification.Notification) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	// 同じイベントで同じ顧客への通知は作らない（一意インデックス + ON CONFLICT DO NOTHING）
+	for _, existing := range m.notifications {
+		if n.EventID != nil && existing.EventID != nil && *existing.EventID == *n.EventID && existing.CustomerID == n.CustomerID {
+			return nil
+		}
+	}
+	n.ID = int64(len(m.notifications) + 1)
+	m.notifications = append(m.notifications, *n)
+	return nil
+}
+
+func (m *mockNotificationRepository) FindByCustomerID(customerID int64, unreadOnly bool, limit int) ([]notification.Notification, error) {
+	return m.notifications, nil
+}
+
+func (m *mockNotificationRepository) CountUnread(customerID int64) (int64, error) {
+	return int64(len(m.notifications)), nil
+}
+
+func (m *mockNotificationRepository) MarkRead(customerID int64, ids []int64, at time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockNotificationRepository) FindDeliveries(eventID int64) ([]notification.Delivery, error) {
+	var result []notification.Delivery
+	for _, d := range m.deliveries {
+		if d.EventID == eventID {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockNotificationRepository) SaveDelivery(d *notification.Delivery) error {
+	for i := range m.deliveries {
+		if m.deliveries[i].EventID == d.EventID && m.deliveries[i].CustomerID == d.CustomerID && m.deliveries[i].Channel == d.Channel {
+			m.deliveries[i] = *d
+			return nil
+		}
+	}
+	m.deliveries = append(m.deliveries, *d)
+	return nil
+}
+
+// mockSender - 指定した宛先への送信だけ失敗するテスト用配信チャネル
+type mockSender struct {
+	failFor map[string]bool
+	sent    []string
+}
+
+func (m *mockSender) Channel() notification.Channel { return notification.ChannelEmail }
+
+func (m *mockSender) Send(to notification.Recipient, n *notification.Notification) error {
+	if m.failFor[to.Email] {
+		return errors.New("mailbox unavailable")
+	}
+	m.sent = append(m.sent, to.Email)
+	return nil
+}
+
+// ===== Fake SMTP Server =====
+
+// fakeMail - 偽SMTPサーバーが受信したメール
+type fakeMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTPServer - テスト用のローカルSMTPサーバー（認証・TLSなし）
+type fakeSMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	mails    []fakeMail
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := &fakeSMTPServer{listener: listener}
+	go s.serve()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *fakeSMTPServer) hostPort() (string, string) {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host, port
+}
+
+func (s *fakeSMTPServer) received() []fakeMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeMail(nil), s.mails...)
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost fake ESMTP")
+
+	var mail fakeMail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			// 8BITMIME を広告し、本文を 8bit のまま受け取る
+			tp.PrintfLine("250-localhost")
+			tp.PrintfLine("250 8BITMIME")
+		case "MAIL":
+			mail = fakeMail{from: line}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			mail.to = append(mail.to, strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">"))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.data = string(data)
+			s.mu.Lock()
+			s.mails = append(s.mails, mail)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 Bye")
+			return
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+// subjectOf - メールの件名をデコードして取得
+func subjectOf(t *testing.T, data string) string {
+	t.Helper()
+	header, err := textproto.NewReader(bufio.NewReader(strings.NewReader(data))).ReadMIMEHeader()
+	if err != nil {
+		t.Fatalf("failed to parse mail header: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("failed to decode subject: %v", err)
+	}
+	return subject
+}
+
+// ===== Tests =====
+
+func TestNotificationDispatcher_Publish(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	host, port := server.hostPort()
+	sender := smtpsender.NewSMTPSender(host, port, "", "", "noreply@veganbite.example", "https://veganbite.example")
+
+	repo := &mockNotificationRepository{
+		subscribers: []notification.Recipient{
+			{CustomerID: 1, Email: "reviewer@example.com", Name: "Reviewer"},
+			{CustomerID: 2, Email: "default@example.com", Name: "Default"},
+			{CustomerID: 3, Email: "email-only@example.com", Name: "花子"},
+			{CustomerID: 4, Email: "muted@example.com", Name: "Muted"},
+		},
+		preferences: []notification.Preference{
+			{CustomerID: 3, Type: notification.TypeNewReview, InApp: false, Email: true},
+			{CustomerID: 4, Type: notification.TypeNewReview, InApp: false, Email: false},
+			// 別の種類の設定は適用されない
+			{CustomerID: 2, Type: notification.TypePriceDrop, InApp: false, Email: true},
+		},
+	}
+	dispatcher := NewNotificationDispatcher(repo, sender)
+
+	event := notification.NewReviewEvent(10, "豆乳ヨーグルト", 1, 5)
+	event.ID = 1
+	if err := dispatcher.Publish(event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 投稿者本人には通知せず、既定設定の顧客はアプリ内のみ
+	if len(repo.notifications) != 1 || repo.notifications[0].CustomerID != 2 {
+		t.Fatalf("expected one in-app notification for customer 2, got %+v", repo.notifications)
+	}
+	if repo.notifications[0].Type != notification.TypeNewReview || repo.notifications[0].ProductID != 10 {
+		t.Errorf("unexpected notification: %+v", repo.notifications[0])
+	}
+
+	mails := server.received()
+	if len(mails) != 1 {
+		t.Fatalf("expected 1 email, got %d", len(mails))
+	}
+	if len(mails[0].to) != 1 || mails[0].to[0] != "email-only@example.com" {
+		t.Errorf("expected email to email-only@example.com, got %v", mails[0].to)
+	}
+	if got := subjectOf(t, mails[0].data); got != event.Title {
+		t.Errorf("expected subject %q, got %q", event.Title, got)
+	}
+	if !strings.Contains(mails[0].data, "花子 様") || !strings.Contains(mails[0].data, "https://veganbite.example/products/10") {
+		t.Errorf("expected greeting and product link in body, got:\n%s", mails[0].data)
+	}
+}
+
+func TestNotificationDispatcher_ContinuesAfterFailures(t *testing.T) {
+	// 接続できないSMTPサーバー
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	listener.Close()
+	sender := smtpsender.NewSMTPSender(host, port, "", "", "noreply@veganbite.example", "")
+
+	repo := &mockNotificationRepository{
+		subscribers: []notification.Recipient{
+			{CustomerID: 1, Email: "a@example.com"},
+			{CustomerID: 2, Email: "b@example.com"},
+		},
+		preferences: []notification.Preference{
+			{CustomerID: 1, Type: notification.TypeBackInStock, InApp: true, Email: true},
+		},
+	}
+	dispatcher := NewNotificationDispatcher(repo, sender)
+
+	event := notification.NewBackInStockEvent(10, "テンペ", "Amazon")
+	event.ID = 1
+	err := dispatcher.Publish(event)
+	if err == nil || !strings.Contains(err.Error(), "email to customer 1") {
+		t.Fatalf("expected email delivery error for customer 1, got %v", err)
+	}
+	if len(repo.notifications) != 2 {
+		t.Errorf("expected in-app notifications for both customers despite email failure, got %d", len(repo.notifications))
+	}
+
+	repo.notifications = nil
+	repo.createErr = errors.New("database error")
+	event.ID = 2
+	if err := dispatcher.Publish(event); err == nil {
+		t.Error("expected error when in-app notifications cannot be stored")
+	}
+}
+
+func TestNotificationDispatcher_RedeliveryIsIdempotent(t *testing.T) {
+	sender := &mockSender{failFor: map[string]bool{"b@example.com": true}}
+	repo := &mockNotificationRepository{
+		subscribers: []notification.Recipient{
+			{CustomerID: 1, Email: "a@example.com"},
+			{CustomerID: 2, Email: "b@example.com"},
+		},
+		preferences: []notification.Preference{
+			{CustomerID: 1, Type: notification.TypeBackInStock, InApp: true, Email: true},
+			{CustomerID: 2, Type: notification.TypeBackInStock, InApp: true, Email: true},
+		},
+	}
+	dispatcher := NewNotificationDispatcher(repo, sender)
+	event := notification.NewBackInStockEvent(10, "テンペ", "Amazon")
+	event.ID = 7
+
+	// 宛先ごとに失敗を記録し、イベントは再試行させる
+	if err := dispatcher.Publish(event); err == nil || !strings.Contains(err.Error(), "email to customer 2") {
+		t.Fatalf("expected email delivery error for customer 2, got %v", err)
+	}
+	failed, _ := repo.FindDeliveries(event.ID)
+	if len(failed) != 2 {
+		t.Fatalf("expected a delivery record per recipient, got %+v", failed)
+	}
+	for _, d := range failed {
+		wantStatus := notification.DeliverySent
+		if d.CustomerID == 2 {
+			wantStatus = notification.DeliveryFailed
+		}
+		if d.Status != wantStatus {
+			t.Errorf("expected customer %d delivery to be %s, got %+v", d.CustomerID, wantStatus, d)
+		}
+	}
+
+	// 再配信では通知を重複させず、失敗した宛先にだけ送る
+	sender.failFor = nil
+	if err := dispatcher.Publish(event); err != nil {
+		t.Fatalf("unexpected error on redelivery: %v", err)
+	}
+	if len(repo.notifications) != 2 {
+		t.Errorf("expected in-app notifications not to be duplicated, got %d", len(repo.notifications))
+	}
+	if len(sender.sent) != 2 || sender.sent[1] != "b@example.com" {
+		t.Errorf("expected only the failed recipient to be retried, got %v", sender.sent)
+	}
+	retried, _ := repo.FindDeliveries(event.ID)
+	for _, d := range retried {
+		if d.CustomerID == 2 && (d.Status != notification.DeliverySent || d.Attempts != 2) {
+			t.Errorf("expected customer 2 delivery to succeed on the second attempt, got %+v", d)
+		}
+	}
+
+	if err := dispatcher.Publish(notification.NewBackInStockEvent(10, "テンペ", "Amazon")); err == nil {
+		t.Error("expected error for an event without an id")
+	}
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"backend/domain/event"
+	"backend/domain/notification"
+	"backend/domain/offer"
+	"backend/domain/product"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// NotificationSubscriber - ドメインイベントをお気に入り登録者への通知に変換する購読者
+type NotificationSubscriber struct {
+	dispatcher  *NotificationDispatcher
+	productRepo product.ProductRepository
+}
+
+// NewNotificationSubscriber - 通知購読者の生成
+func NewNotificationSubscriber(dispatcher *NotificationDispatcher, productRepo product.ProductRepository) *NotificationSubscriber {
+	return &NotificationSubscriber{dispatcher: dispatcher, productRepo: productRepo}
+}
+
+// Register - イベントバスに購読を登録
+func (s *NotificationSubscriber) Register(bus *EventBus) {
+	bus.Subscribe(event.NameReviewCreated, s.handle)
+	bus.Subscribe(event.NameOfferPriceDropped, s.handle)
+	bus.Subscribe(event.NameOfferBackInStock, s.handle)
+}
+
+func (s *NotificationSubscriber) handle(ctx context.Context, e event.Event) error {
+	eventID := event.IDFromContext(ctx)
+	if eventID == 0 {
+		return fmt.Errorf("%s: missing event id", e.EventName())
+	}
+
+	var n notification.Event
+	switch e := e.(type) {
+	case event.ReviewCreated:
+		p, err := s.findProduct(e.ProductID)
+		if p == nil || err != nil {
+			return err
+		}
+		n = notification.NewReviewEvent(p.ID, p.DisplayName(), e.CustomerID, e.Rating)
+	case event.OfferPriceDropped:
+		p, err := s.findProduct(e.ProductID)
+		if p == nil || err != nil {
+			return err
+		}
+		oldPrice := offer.Money{Amount: e.OldAmount, Currency: e.Currency}
+		newPrice := offer.Money{Amount: e.NewAmount, Currency: e.Currency}
+		n = notification.NewPriceDropEvent(p.ID, p.DisplayName(), p.StoreDisplayName(e.Store), oldPrice.String(), newPrice.String())
+	case event.OfferBackInStock:
+		p, err := s.findProduct(e.ProductID)
+		if p == nil || err != nil {
+			return err
+		}
+		n = notification.NewBackInStockEvent(p.ID, p.DisplayName(), p.StoreDisplayName(e.Store))
+	default:
+		return fmt.Errorf("unexpected event %s", e.EventName())
+	}
+	n.ID = eventID
+	return s.dispatcher.Publish(n)
+}
+
+// findProduct - 通知対象の商品を取得（削除済みなら nil で通知しない、DBエラーは再試行のため返す）
+func (s *NotificationSubscriber) findProduct(id int64) (*product.Product, error) {
+	p, err := s.productRepo.FindByID(id)
+	if errors.Is(err, product.ErrProductNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}